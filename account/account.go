@@ -308,7 +308,7 @@ func (acc *DB) GetBalance(api client.QueueProtocolAPI, in *types.ReqBalance) ([]
 		addrs := in.GetAddresses()
 		var exaddrs []string
 		for _, addr := range addrs {
-			if err := address.CheckAddress(addr); err != nil {
+			if err := address.CheckAddress(addr); err != nil && address.CheckMultiSignAddress(addr) != nil {
 				addr = address.ExecAddress(addr)
 			}
 			exaddrs = append(exaddrs, addr)
@@ -390,6 +390,9 @@ func (acc *DB) GetExecBalance(api client.QueueProtocolAPI, in *types.ReqGetExecB
 	//log.Info("DB.GetExecBalance", "hash", common.ToHex(req.StateHash), "Prefix", string(req.Start), "End", string(req.End), "Addr", string(req.Suffix))
 
 	res, err := api.StoreList(&req)
+	if err == types.ErrStatePruned {
+		return nil, err
+	}
 	if err != nil {
 		err = types.ErrTypeAsset
 		return nil, err
@@ -1,11 +1,7 @@
 package blockchain
 
 import (
-	"bytes"
-	"compress/gzip"
-	"io/ioutil"
-	"net"
-	"net/http"
+	"math/rand"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -21,11 +17,13 @@ const (
 	pushBlockMaxSeq          = 10
 	pushTxReceiptMaxSeq      = 100
 	pushMaxSize              = 1 * 1024 * 1024
-	maxPushSubscriber        = int(100)
+	maxPushSubscriber        = int(5000)
+	pushWorkerNum            = 16
 	subscribeStatusActive    = int32(1)
 	subscribeStatusNotActive = int32(2)
-	postFail2Sleep           = int32(60) //一次发送失败，sleep的次数
-	chanBufCap               = int(10)
+	postFail2Sleep           = int32(60) //发送失败后sleep次数的上限
+	pushRetryBaseSleep       = int32(5)  //第一次发送失败后sleep的次数，之后每次翻倍
+	pushMaxContinueFail      = int32(10) //连续失败次数达到该值, 并且退避时间已经达到postFail2Sleep后停止推送
 )
 
 // Push types ID
@@ -66,17 +64,17 @@ type PostService interface {
 	PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) (err error)
 }
 
-//当前的实现是为每个订阅者单独启动一个协程goroute，然后单独为每个subscriber分别过滤指定类型的交易，
-//进行归类，这种方式集成了区块推送方式的处理机制，但是对于订阅者数量大的情况，势必会浪费cpu的开销，
-//数据库的读取开销不会额外增加明星，因为会有cach
-//TODO：后续需要考虑将区块推送和交易执行回执推送进行重构，提高并行推送效率
+//推送使用所有订阅者共享的分发流程：分发协程在有新的sequence时挑出需要推送的订阅者，交给固定数量的工作协程处理，
+//每个sequence对应的区块只从数据库加载解码一次，缓存后所有订阅者共享，交易回执按合约过滤时使用共享的合约索引，
+//每个订阅者的推送进度(cursor)在推送成功后持久化到数据库，节点重启后从该位置继续推送
 //pushNotify push Notify
 type pushNotify struct {
-	subscribe      *types.PushSubscribeReq
-	seqUpdateChan  chan int64
-	closechan      chan struct{}
-	status         int32
-	postFail2Sleep int32
+	subscribe         *types.PushSubscribeReq
+	status            int32
+	postFail2Sleep    int32
+	busy              int32
+	cursor            int64
+	continueFailCount int32
 }
 
 //Push ...
@@ -86,14 +84,18 @@ type Push struct {
 	tasks          map[string]*pushNotify
 	mu             sync.Mutex
 	postService    PostService
+	mux            *pushMux
 	cfg            *types.Chain33Config
 	postFail2Sleep int32
-	postwg         *sync.WaitGroup
-}
-
-//PushClient ...
-type PushClient struct {
-	client *http.Client
+	//连续失败次数的上限
+	maxContinueFail int32
+	postwg          *sync.WaitGroup
+	statMu          sync.Mutex
+	stats           map[string]*types.PushStat
+	cache           *pushCache
+	workChan        chan *pushNotify
+	wakeChan        chan struct{}
+	quit            chan struct{}
 }
 
 // PushType ...
@@ -103,47 +105,6 @@ func (pushType PushType) string() string {
 	return []string{"PushBlock", "PushBlockHeader", "PushTxReceipt", "NotSupported"}[pushType]
 }
 
-//PostData ...
-func (pushClient *PushClient) PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) (err error) {
-	//post data in body
-	chainlog.Info("postData begin", "seq", seq, "subscribe name", subscribe.Name)
-	var buf bytes.Buffer
-	g := gzip.NewWriter(&buf)
-	if _, err = g.Write(postdata); err != nil {
-		return err
-	}
-	if err = g.Close(); err != nil {
-		return err
-	}
-
-	req, err := http.NewRequest("POST", subscribe.URL, &buf)
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Content-Type", "text/plain")
-	req.Header.Set("Content-Encoding", "gzip")
-	resp, err := pushClient.client.Do(req)
-	if err != nil {
-		chainlog.Info("postData", "Do err", err)
-		return err
-	}
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		_ = resp.Body.Close()
-		return err
-	}
-	if string(body) != "ok" && string(body) != "OK" {
-		chainlog.Error("postData fail", "name:", subscribe.Name, "URL", subscribe.URL,
-			"Contract:", subscribe.Contract, "body", string(body))
-		_ = resp.Body.Close()
-		return types.ErrPushSeqPostData
-	}
-	chainlog.Debug("postData success", "name", subscribe.Name, "URL", subscribe.URL,
-		"Contract:", subscribe.Contract, "updateSeq", seq)
-	return resp.Body.Close()
-}
-
 //ProcAddBlockSeqCB 添加seq callback
 func (chain *BlockChain) procSubscribePush(subscribe *types.PushSubscribeReq) error {
 	if !chain.enablePushSubscribe {
@@ -189,6 +150,7 @@ func (chain *BlockChain) ProcListPush() (*types.PushSubscribes, error) {
 			return nil, err
 		}
 		listSeqCBs.Pushes = append(listSeqCBs.Pushes, onePush.Push)
+		listSeqCBs.Stats = append(listSeqCBs.Stats, chain.push.getPushStat(onePush.Push, onePush.Status))
 	}
 	return &listSeqCBs, nil
 }
@@ -221,26 +183,25 @@ func (chain *BlockChain) ProcGetLastPushSeq(name string) (int64, error) {
 func newpush(commonStore CommonStore, seqStore SequenceStore, cfg *types.Chain33Config) *Push {
 	tasks := make(map[string]*pushNotify)
 
-	pushClient := &PushClient{
-		client: &http.Client{Transport: &http.Transport{
-			Dial: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).Dial,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ResponseHeaderTimeout: 10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-		}},
-	}
+	bcfg := cfg.GetModuleConfig().BlockChain
+	mux := newPushMux(bcfg, NewPushSigner(bcfg.PushSignKey))
 	service := &Push{store: commonStore,
-		sequenceStore:  seqStore,
-		tasks:          tasks,
-		postService:    pushClient,
-		cfg:            cfg,
-		postFail2Sleep: postFail2Sleep,
-		postwg:         &sync.WaitGroup{},
+		sequenceStore:   seqStore,
+		tasks:           tasks,
+		postService:     mux,
+		mux:             mux,
+		cfg:             cfg,
+		postFail2Sleep:  postFail2Sleep,
+		maxContinueFail: pushMaxContinueFail,
+		postwg:          &sync.WaitGroup{},
+		stats:           make(map[string]*types.PushStat),
+		cache:           newPushCache(),
+		workChan:        make(chan *pushNotify, pushWorkerNum),
+		wakeChan:        make(chan struct{}, 1),
+		quit:            make(chan struct{}),
 	}
 	service.init()
+	service.start()
 
 	return service
 }
@@ -275,14 +236,19 @@ func (push *Push) init() {
 
 // Close ...
 func (push *Push) Close() {
-	push.mu.Lock()
-	for _, task := range push.tasks {
-		close(task.closechan)
-	}
-	push.mu.Unlock()
+	close(push.quit)
 	push.postwg.Wait()
 }
 
+// start 启动分发协程和工作协程
+func (push *Push) start() {
+	push.postwg.Add(pushWorkerNum + 1)
+	go push.dispatch()
+	for i := 0; i < pushWorkerNum; i++ {
+		go push.work()
+	}
+}
+
 func (push *Push) addSubscriber(subscribe *types.PushSubscribeReq) error {
 	if subscribe == nil {
 		chainlog.Error("addSubscriber input para is null")
@@ -365,6 +331,10 @@ func (push *Push) persisAndStart(subscribe *types.PushSubscribeReq) error {
 			"len(subscribe.URL)=", len(subscribe.URL), "len(subscribe.Contract)=", len(subscribe.Contract))
 		return types.ErrInvalidParam
 	}
+	if _, err := push.mux.transport(subscribe.URL); err != nil {
+		storeLog.Error("persisAndStart URL is not supported", "URL", subscribe.URL, "err", err)
+		return types.ErrPushTransportNotSupport
+	}
 	key := calcPushKey(subscribe.Name)
 	storeLog.Info("persisAndStart", "key", string(key), "subscribe", subscribe)
 	push.addTask(subscribe)
@@ -392,190 +362,229 @@ func (push *Push) check2ResumePush(subscribe *types.PushSubscribeReq) error {
 	notify := push.tasks[keyStr]
 	//有可能因为连续发送失败已经导致将其从推送任务中删除了
 	if nil == notify {
-		push.tasks[keyStr] = &pushNotify{
-			subscribe:     subscribe,
-			seqUpdateChan: make(chan int64, chanBufCap),
-			closechan:     make(chan struct{}),
-			status:        notRunning,
-		}
-		push.runTask(push.tasks[keyStr])
+		push.tasks[keyStr] = push.newTask(subscribe)
 		storeLog.Info("check2ResumePush new pushNotify created")
-		return nil
-	}
-
-	if running == atomic.LoadInt32(&notify.status) {
+	} else if running == atomic.LoadInt32(&notify.status) {
 		storeLog.Info("Is already in state:running", "postFail2Sleep", atomic.LoadInt32(&notify.postFail2Sleep))
 		atomic.StoreInt32(&notify.postFail2Sleep, 0)
-		return nil
+	} else {
+		storeLog.Info("check2ResumePush to resume a push", "name", subscribe.Name)
+		atomic.StoreInt32(&notify.status, running)
 	}
-	storeLog.Info("check2ResumePush to resume a push", "name", subscribe.Name)
+	push.wake()
 
-	push.runTask(push.tasks[keyStr])
-	return nil
+	//重新激活的推送需要更新数据库中的状态，节点重启后才能继续推送
+	pushWithStatus := &types.PushWithStatus{
+		Push:   subscribe,
+		Status: subscribeStatusActive,
+	}
+	return push.store.SetSync([]byte(keyStr), types.Encode(pushWithStatus))
 }
 
-//每次add一个新push时,发送最新的seq
-func (push *Push) updateLastSeq(name string) {
-	last, err := push.sequenceStore.LoadBlockLastSequence()
-	if err != nil {
-		chainlog.Error("LoadBlockLastSequence", "err", err)
-		return
+func (push *Push) newTask(subscribe *types.PushSubscribeReq) *pushNotify {
+	return &pushNotify{
+		subscribe: subscribe,
+		status:    running,
+		cursor:    push.getLastPushSeq(subscribe),
 	}
-
-	notify := push.tasks[string(calcPushKey(name))]
-	notify.seqUpdateChan <- last
-	chainlog.Debug("updateLastSeq", "last", last, "notify.seqUpdateChan", len(notify.seqUpdateChan))
 }
 
 // addTask 每个name 有一个task, 通知新增推送
 func (push *Push) addTask(subscribe *types.PushSubscribeReq) {
 	push.mu.Lock()
-	defer push.mu.Unlock()
-	keyStr := string(calcPushKey(subscribe.Name))
-	push.tasks[keyStr] = &pushNotify{
-		subscribe:      subscribe,
-		seqUpdateChan:  make(chan int64, chanBufCap),
-		closechan:      make(chan struct{}),
-		status:         notRunning,
-		postFail2Sleep: 0,
-	}
-
-	push.runTask(push.tasks[keyStr])
+	push.tasks[string(calcPushKey(subscribe.Name))] = push.newTask(subscribe)
+	push.mu.Unlock()
+	push.wake()
 }
 
-func trigeRun(run chan struct{}, sleep time.Duration, name string) {
-	chainlog.Info("trigeRun", name, "name", "sleep", sleep, "run len", len(run))
-	if sleep > 0 {
-		time.Sleep(sleep)
+// wake 唤醒分发协程, 已经有未处理的唤醒时直接返回
+func (push *Push) wake() {
+	select {
+	case push.wakeChan <- struct{}{}:
+	default:
 	}
-	go func() {
-		run <- struct{}{}
-	}()
 }
 
-func (push *Push) runTask(input *pushNotify) {
-	//触发goroutine运行
-	push.updateLastSeq(input.subscribe.Name)
-
-	go func(in *pushNotify) {
-		var lastesBlockSeq int64
-		var continueFailCount int32
-		var err error
-
-		subscribe := in.subscribe
-		lastProcessedseq := push.getLastPushSeq(subscribe)
-
-		atomic.StoreInt32(&in.status, running)
-
-		runChan := make(chan struct{}, 10)
-		pushMaxSeq := pushBlockMaxSeq
-		if subscribe.Type == PushTxReceipt {
-			pushMaxSeq = pushTxReceiptMaxSeq
+// dispatch 在有新的sequence、推送完成或者每秒定时检查时, 将需要推送的订阅者交给工作协程
+func (push *Push) dispatch() {
+	defer push.postwg.Done()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-push.wakeChan:
+		case <-ticker.C:
+			push.countdownSleep()
+		case <-push.quit:
+			return
 		}
-
-		chainlog.Debug("start push with info", "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).string())
-		for {
+		for _, notify := range push.readyTasks() {
 			select {
-			case <-runChan:
-				if atomic.LoadInt32(&input.postFail2Sleep) > 0 {
-					if postFail2SleepNew := atomic.AddInt32(&input.postFail2Sleep, -1); postFail2SleepNew > 0 {
-						chainlog.Debug("wait another ticker for post fail", "postFail2Sleep", postFail2SleepNew, "name", in.subscribe.Name)
-						trigeRun(runChan, time.Second, subscribe.Name)
-						continue
-					}
-				}
-
-			case lastestSeq := <-in.seqUpdateChan:
-				chainlog.Debug("runTask recv:", "lastestSeq", lastestSeq, "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).string())
-				//首先判断是否存在发送失败的情况，如果存在，则进行进行sleep操作
-				if atomic.LoadInt32(&input.postFail2Sleep) > 0 {
-					if postFail2SleepNew := atomic.AddInt32(&input.postFail2Sleep, -1); postFail2SleepNew > 0 {
-						chainlog.Debug("wait another ticker for post fail", "postFail2Sleep", postFail2SleepNew, "name", in.subscribe.Name)
-						trigeRun(runChan, time.Second, subscribe.Name)
-						continue
-					}
-				}
-				//获取当前最新的sequence,这样就可以一次性发送多个区块的信息，而不需要每次从通知chan中获取最新sequence
-				if lastesBlockSeq, err = push.sequenceStore.LoadBlockLastSequence(); err != nil {
-					chainlog.Error("LoadBlockLastSequence", "err", err)
-					return
-				}
-
-				//没有更新的区块，则不进行处理，同时等待一定的时间
-				if lastProcessedseq >= lastesBlockSeq {
-					continue
-				}
-				chainlog.Debug("another new block", "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).string(),
-					"last push sequence", lastProcessedseq, "lastest sequence", lastesBlockSeq,
-					"time second", time.Now().Second())
-				//确定一次推送的数量，如果需要更新的数量少于门限值，则一次只推送一个区块的交易数据
-				seqCount := pushMaxSeq
-				if seqCount > int(lastesBlockSeq-lastProcessedseq) {
-					seqCount = int(lastesBlockSeq - lastProcessedseq)
-				}
-
-				data, updateSeq, err := push.getPushData(subscribe, lastProcessedseq+1, seqCount, pushMaxSize)
-				if err != nil {
-					chainlog.Error("getPushData", "err", err, "seqCurrent", lastProcessedseq+1, "maxSeq", seqCount,
-						"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).string())
-					continue
-				}
-
-				if data != nil {
-					err = push.postService.PostData(subscribe, data, updateSeq)
-					if err != nil {
-						continueFailCount++
-						chainlog.Error("postdata failed", "err", err, "lastProcessedseq", lastProcessedseq,
-							"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).string(), "continueFailCount", continueFailCount)
-						if continueFailCount >= 3 {
-							atomic.StoreInt32(&in.status, notRunning)
-							chainlog.Error("postdata failed exceed 3 times", "Name", subscribe.Name, "in.status", atomic.LoadInt32(&in.status))
-
-							pushWithStatus := &types.PushWithStatus{
-								Push:   subscribe,
-								Status: subscribeStatusNotActive,
-							}
-
-							key := calcPushKey(subscribe.Name)
-							push.mu.Lock()
-							delete(push.tasks, string(key))
-							push.mu.Unlock()
-							_ = push.store.SetSync(key, types.Encode(pushWithStatus))
-							push.postwg.Done()
-							return
-						}
-						//sleep 60s，每次1s，总计60次，在每次结束时，等待接收方重新进行请求推送
-						atomic.StoreInt32(&input.postFail2Sleep, push.postFail2Sleep)
-						trigeRun(runChan, time.Second, subscribe.Name)
-						continue
-					}
-					_ = push.setLastPushSeq(subscribe.Name, updateSeq)
-				}
-				continueFailCount = 0
-				lastProcessedseq = updateSeq
-			case <-in.closechan:
-				push.postwg.Done()
-				chainlog.Info("getPushData", "push task closed for subscribe", subscribe.Name)
+			case push.workChan <- notify:
+			case <-push.quit:
 				return
 			}
 		}
+	}
+}
 
-	}(input)
-	push.postwg.Add(1)
+// readyTasks 返回有新的sequence需要推送并且不在失败等待中的订阅者, 同一个订阅者同时只会交给一个工作协程
+func (push *Push) readyTasks() []*pushNotify {
+	lastSeq, err := push.sequenceStore.LoadBlockLastSequence()
+	if err != nil {
+		chainlog.Error("LoadBlockLastSequence", "err", err)
+		return nil
+	}
+	push.mu.Lock()
+	defer push.mu.Unlock()
+	var ready []*pushNotify
+	for _, notify := range push.tasks {
+		if atomic.LoadInt32(&notify.status) != running || atomic.LoadInt32(&notify.postFail2Sleep) > 0 {
+			continue
+		}
+		if atomic.LoadInt64(&notify.cursor) >= lastSeq {
+			continue
+		}
+		if atomic.CompareAndSwapInt32(&notify.busy, 0, 1) {
+			ready = append(ready, notify)
+		}
+	}
+	return ready
 }
 
-// UpdateSeq sequence 更新通知
-func (push *Push) UpdateSeq(seq int64) {
+// countdownSleep 推送失败的订阅者每秒减少一次等待计数
+func (push *Push) countdownSleep() {
 	push.mu.Lock()
 	defer push.mu.Unlock()
 	for _, notify := range push.tasks {
-		//再写入seq（一定不会block，因为加了lock，不存在两个同时写channel的情况）
-		if len(notify.seqUpdateChan) < chanBufCap {
-			chainlog.Info("new block Update Seq notified", "subscribe", notify.subscribe.Name, "current sequence", seq, "length", len(notify.seqUpdateChan))
-			notify.seqUpdateChan <- seq
+		for {
+			sleep := atomic.LoadInt32(&notify.postFail2Sleep)
+			if sleep <= 0 || atomic.CompareAndSwapInt32(&notify.postFail2Sleep, sleep, sleep-1) {
+				break
+			}
+		}
+	}
+}
+
+func (push *Push) work() {
+	defer push.postwg.Done()
+	for {
+		select {
+		case notify := <-push.workChan:
+			done := push.pushOnce(notify)
+			atomic.StoreInt32(&notify.busy, 0)
+			//推送成功后可能还有没推送的sequence, 立即进行下一轮分发
+			if done {
+				push.wake()
+			}
+		case <-push.quit:
+			return
+		}
+	}
+}
+
+// pushOnce 从订阅者的cursor之后推送一批数据, 成功时返回true
+func (push *Push) pushOnce(notify *pushNotify) bool {
+	subscribe := notify.subscribe
+	pushMaxSeq := pushBlockMaxSeq
+	if subscribe.Type == PushTxReceipt {
+		pushMaxSeq = pushTxReceiptMaxSeq
+	}
+	//获取当前最新的sequence,这样就可以一次性发送多个区块的信息
+	lastesBlockSeq, err := push.sequenceStore.LoadBlockLastSequence()
+	if err != nil {
+		chainlog.Error("LoadBlockLastSequence", "err", err)
+		return false
+	}
+	lastProcessedseq := atomic.LoadInt64(&notify.cursor)
+	if lastProcessedseq >= lastesBlockSeq {
+		return false
+	}
+	chainlog.Debug("another new block", "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).string(),
+		"last push sequence", lastProcessedseq, "lastest sequence", lastesBlockSeq)
+	//确定一次推送的数量，如果需要更新的数量少于门限值，则一次只推送一个区块的交易数据
+	seqCount := pushMaxSeq
+	if seqCount > int(lastesBlockSeq-lastProcessedseq) {
+		seqCount = int(lastesBlockSeq - lastProcessedseq)
+	}
+
+	data, updateSeq, err := push.getPushData(subscribe, lastProcessedseq+1, seqCount, pushMaxSize)
+	if err != nil {
+		chainlog.Error("getPushData", "err", err, "seqCurrent", lastProcessedseq+1, "maxSeq", seqCount,
+			"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).string())
+		return false
+	}
+
+	if data != nil {
+		err = push.postService.PostData(subscribe, data, updateSeq)
+		if err != nil {
+			notify.continueFailCount++
+			chainlog.Error("postdata failed", "err", err, "lastProcessedseq", lastProcessedseq,
+				"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).string(), "continueFailCount", notify.continueFailCount)
+			//退避时间达到上限后仍然连续失败才停止推送, 避免接收方短暂不可用时被停用
+			if notify.continueFailCount >= push.maxContinueFail && push.retryBackoff(notify.continueFailCount-1) >= push.postFail2Sleep {
+				push.recordPostFail(subscribe.Name, err, notify.continueFailCount, 0)
+				push.stopTask(notify)
+				return false
+			}
+			//按照连续失败的次数指数退避，每次sleep 1s，等待期间接收方可以重新请求推送
+			sleep := push.retrySleep(notify.continueFailCount)
+			push.recordPostFail(subscribe.Name, err, notify.continueFailCount, types.Now().Unix()+int64(sleep))
+			atomic.StoreInt32(&notify.postFail2Sleep, sleep)
+			return false
+		}
+		push.recordPostSuccess(subscribe.Name, len(data))
+		if err = push.setLastPushSeq(subscribe.Name, updateSeq); err != nil {
+			chainlog.Error("setLastPushSeq", "Name", subscribe.Name, "err", err)
 		}
-		chainlog.Info("new block UpdateSeq", "subscribe", notify.subscribe.Name, "current sequence", seq, "length", len(notify.seqUpdateChan))
 	}
+	notify.continueFailCount = 0
+	atomic.StoreInt64(&notify.cursor, updateSeq)
+	return true
+}
+
+// stopTask 连续推送失败后停止推送, 等待接收方重新请求推送
+func (push *Push) stopTask(notify *pushNotify) {
+	subscribe := notify.subscribe
+	atomic.StoreInt32(&notify.status, notRunning)
+	chainlog.Error("postdata failed exceed max times", "Name", subscribe.Name, "continueFailCount", notify.continueFailCount)
+
+	pushWithStatus := &types.PushWithStatus{
+		Push:   subscribe,
+		Status: subscribeStatusNotActive,
+	}
+	key := calcPushKey(subscribe.Name)
+	push.mu.Lock()
+	defer push.mu.Unlock()
+	if push.tasks[string(key)] == notify {
+		delete(push.tasks, string(key))
+	}
+	_ = push.store.SetSync(key, types.Encode(pushWithStatus))
+}
+
+// retryBackoff 连续推送失败后指数退避的秒数, 最大不超过postFail2Sleep
+func (push *Push) retryBackoff(continueFailCount int32) int32 {
+	backoff := pushRetryBaseSleep
+	for i := int32(1); i < continueFailCount && backoff < push.postFail2Sleep; i++ {
+		backoff <<= 1
+	}
+	if backoff > push.postFail2Sleep {
+		backoff = push.postFail2Sleep
+	}
+	return backoff
+}
+
+// retrySleep 连续推送失败后等待的秒数, 在退避时间上加上随机抖动, 避免大量订阅者同时重试
+func (push *Push) retrySleep(continueFailCount int32) int32 {
+	backoff := push.retryBackoff(continueFailCount)
+	half := backoff / 2
+	return backoff - half + rand.Int31n(half+1)
+}
+
+// UpdateSeq sequence 更新通知
+func (push *Push) UpdateSeq(seq int64) {
+	chainlog.Debug("new block UpdateSeq", "current sequence", seq)
+	push.wake()
 }
 
 func (push *Push) getPushData(subscribe *types.PushSubscribeReq, startSeq int64, seqCount, maxSize int) ([]byte, int64, error) {
@@ -592,32 +601,29 @@ func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, startSeq int6
 	totalSize := 0
 	actualIterCount := 0
 	for i := startSeq; i < startSeq+int64(seqCount); i++ {
-		chainlog.Info("getTxReceipts", "startSeq:", i)
-		seqdata, err := push.sequenceStore.GetBlockSequence(i)
+		blk := push.cache.get(i)
+		blockSeq, _, err := blk.getBlockSeq(push.sequenceStore)
 		if err != nil {
 			return nil, -1, err
 		}
-		detail, _, err := push.sequenceStore.LoadBlockBySequence(i)
+		//通过共享的合约索引找到订阅的交易，不需要每个订阅者遍历整个区块
+		index, err := blk.getTxIndex(push.sequenceStore, subscribe.Contract)
 		if err != nil {
 			return nil, -1, err
 		}
 
+		detail := blockSeq.Detail
 		txReceiptsPerBlk := &types.TxReceipts4SubscribePerBlk{}
-		chainlog.Info("getTxReceipts", "height:", detail.Block.Height, "tx numbers:", len(detail.Block.Txs), "Receipts numbers:", len(detail.Receipts))
-		for txIndex, tx := range detail.Block.Txs {
-			if subscribe.Contract[string(tx.Execer)] {
-				chainlog.Info("getTxReceipts", "txIndex:", txIndex)
-				txReceiptsPerBlk.Tx = append(txReceiptsPerBlk.Tx, tx)
-				txReceiptsPerBlk.ReceiptData = append(txReceiptsPerBlk.ReceiptData, detail.Receipts[txIndex])
-				//txReceiptsPerBlk.KV = append(txReceiptsPerBlk.KV, detail.KV[txIndex])
-			}
+		for _, txIndex := range index {
+			txReceiptsPerBlk.Tx = append(txReceiptsPerBlk.Tx, detail.Block.Txs[txIndex])
+			txReceiptsPerBlk.ReceiptData = append(txReceiptsPerBlk.ReceiptData, detail.Receipts[txIndex])
 		}
 		if len(txReceiptsPerBlk.Tx) > 0 {
 			txReceiptsPerBlk.Height = detail.Block.Height
-			txReceiptsPerBlk.BlockHash = detail.Block.Hash(push.cfg)
+			txReceiptsPerBlk.BlockHash = blockSeq.Seq.Hash
 			txReceiptsPerBlk.ParentHash = detail.Block.ParentHash
 			txReceiptsPerBlk.PreviousHash = []byte{}
-			txReceiptsPerBlk.AddDelType = int32(seqdata.Type)
+			txReceiptsPerBlk.AddDelType = int32(blockSeq.Seq.Type)
 			txReceiptsPerBlk.SeqNum = i
 		}
 		size := types.Size(txReceiptsPerBlk)
@@ -653,15 +659,7 @@ func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, startSeq int6
 }
 
 func (push *Push) getBlockDataBySeq(seq int64) (*types.BlockSeq, int, error) {
-	seqdata, err := push.sequenceStore.GetBlockSequence(seq)
-	if err != nil {
-		return nil, 0, err
-	}
-	detail, blockSize, err := push.sequenceStore.LoadBlockBySequence(seq)
-	if err != nil {
-		return nil, 0, err
-	}
-	return &types.BlockSeq{Num: seq, Seq: seqdata, Detail: detail}, blockSize, nil
+	return push.cache.get(seq).getBlockSeq(push.sequenceStore)
 }
 
 func (push *Push) getBlockSeqs(encode string, seq int64, seqCount, maxSize int) ([]byte, int64, error) {
@@ -726,15 +724,49 @@ func (push *Push) getHeaderSeqs(encode string, seq int64, seqCount, maxSize int)
 }
 
 func (push *Push) getHeaderDataBySeq(seq int64) (*types.HeaderSeq, int, error) {
-	seqdata, err := push.sequenceStore.GetBlockSequence(seq)
-	if err != nil {
-		return nil, 0, err
-	}
-	header, err := push.sequenceStore.GetBlockHeaderByHash(seqdata.Hash)
-	if err != nil {
-		return nil, 0, err
-	}
-	return &types.HeaderSeq{Num: seq, Seq: seqdata, Header: header}, header.Size(), nil
+	return push.cache.get(seq).getHeaderSeq(push.sequenceStore)
+}
+
+func (push *Push) getStat(name string) *types.PushStat {
+	stat, ok := push.stats[name]
+	if !ok {
+		stat = &types.PushStat{Name: name}
+		push.stats[name] = stat
+	}
+	return stat
+}
+
+func (push *Push) recordPostSuccess(name string, size int) {
+	push.statMu.Lock()
+	defer push.statMu.Unlock()
+	stat := push.getStat(name)
+	stat.PostCount++
+	stat.PostBytes += int64(size)
+	stat.ContinueFailCount = 0
+	stat.LastSuccessTime = types.Now().Unix()
+	stat.NextRetryTime = 0
+}
+
+func (push *Push) recordPostFail(name string, err error, continueFailCount int32, nextRetryTime int64) {
+	push.statMu.Lock()
+	defer push.statMu.Unlock()
+	stat := push.getStat(name)
+	stat.PostCount++
+	stat.PostFailCount++
+	stat.ContinueFailCount = continueFailCount
+	stat.LastFailTime = types.Now().Unix()
+	stat.LastError = err.Error()
+	stat.NextRetryTime = nextRetryTime
+}
+
+// getPushStat 返回订阅者的推送统计, 统计只记录本次节点启动之后的推送
+func (push *Push) getPushStat(subscribe *types.PushSubscribeReq, status int32) *types.PushStat {
+	push.statMu.Lock()
+	stat := *push.getStat(subscribe.Name)
+	push.statMu.Unlock()
+	stat.Status = status
+	stat.LastPushSeq = push.getLastPushSeq(subscribe)
+	return &stat
 }
 
 // GetLastPushSeq Seq的合法值从0开始的，所以没有获取到或者获取失败都应该返回-1
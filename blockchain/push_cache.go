@@ -0,0 +1,137 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const pushCacheSize = 256
+
+// pushBlock 一个sequence对应的推送数据, 所有订阅者共享, 区块、区块头和合约索引都只加载解码一次
+type pushBlock struct {
+	num      int64
+	mu       sync.Mutex
+	sequence *types.BlockSequence
+	detail   *types.BlockDetail
+	size     int
+	header   *types.Header
+	//执行器名 -> 交易在区块中的下标, 交易回执订阅者按合约过滤时共享
+	execIdx map[string][]int
+}
+
+func (blk *pushBlock) getSequence(store SequenceStore) (*types.BlockSequence, error) {
+	blk.mu.Lock()
+	defer blk.mu.Unlock()
+	return blk.loadSequence(store)
+}
+
+func (blk *pushBlock) loadSequence(store SequenceStore) (*types.BlockSequence, error) {
+	if blk.sequence == nil {
+		sequence, err := store.GetBlockSequence(blk.num)
+		if err != nil {
+			return nil, err
+		}
+		blk.sequence = sequence
+	}
+	return blk.sequence, nil
+}
+
+func (blk *pushBlock) loadDetail(store SequenceStore) (*types.BlockDetail, error) {
+	if blk.detail == nil {
+		detail, size, err := store.LoadBlockBySequence(blk.num)
+		if err != nil {
+			return nil, err
+		}
+		blk.detail, blk.size = detail, size
+	}
+	return blk.detail, nil
+}
+
+// getBlockSeq 返回区块数据和区块大小
+func (blk *pushBlock) getBlockSeq(store SequenceStore) (*types.BlockSeq, int, error) {
+	blk.mu.Lock()
+	defer blk.mu.Unlock()
+	sequence, err := blk.loadSequence(store)
+	if err != nil {
+		return nil, 0, err
+	}
+	detail, err := blk.loadDetail(store)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &types.BlockSeq{Num: blk.num, Seq: sequence, Detail: detail}, blk.size, nil
+}
+
+func (blk *pushBlock) getHeaderSeq(store SequenceStore) (*types.HeaderSeq, int, error) {
+	blk.mu.Lock()
+	defer blk.mu.Unlock()
+	sequence, err := blk.loadSequence(store)
+	if err != nil {
+		return nil, 0, err
+	}
+	if blk.header == nil {
+		header, err := store.GetBlockHeaderByHash(sequence.Hash)
+		if err != nil {
+			return nil, 0, err
+		}
+		blk.header = header
+	}
+	return &types.HeaderSeq{Num: blk.num, Seq: sequence, Header: blk.header}, blk.header.Size(), nil
+}
+
+// getTxIndex 返回区块中属于指定合约的交易下标, 按在区块中的顺序排列
+func (blk *pushBlock) getTxIndex(store SequenceStore, contract map[string]bool) ([]int, error) {
+	blk.mu.Lock()
+	defer blk.mu.Unlock()
+	detail, err := blk.loadDetail(store)
+	if err != nil {
+		return nil, err
+	}
+	if blk.execIdx == nil {
+		blk.execIdx = make(map[string][]int)
+		for i, tx := range detail.Block.Txs {
+			execer := string(tx.Execer)
+			blk.execIdx[execer] = append(blk.execIdx[execer], i)
+		}
+	}
+	var index []int
+	for name, ok := range contract {
+		if ok {
+			index = append(index, blk.execIdx[name]...)
+		}
+	}
+	sort.Ints(index)
+	return index, nil
+}
+
+// pushCache 最近推送的sequence数据缓存, 订阅者推送进度接近时可以共享同一份数据
+type pushCache struct {
+	mu     sync.Mutex
+	blocks *lru.Cache
+}
+
+func newPushCache() *pushCache {
+	blocks, err := lru.New(pushCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &pushCache{blocks: blocks}
+}
+
+func (cache *pushCache) get(seq int64) *pushBlock {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if value, ok := cache.blocks.Get(seq); ok {
+		return value.(*pushBlock)
+	}
+	blk := &pushBlock{num: seq}
+	cache.blocks.Add(seq, blk)
+	return blk
+}
@@ -0,0 +1,53 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	bcMocks "github.com/33cn/chain33/blockchain/mocks"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushCacheShared(t *testing.T) {
+	store := &bcMocks.SequenceStore{}
+	txs := []*types.Transaction{
+		{Execer: []byte("coins")},
+		{Execer: []byte("token")},
+		{Execer: []byte("coins")},
+		{Execer: []byte("ticket")},
+	}
+	detail := &types.BlockDetail{
+		Block:    &types.Block{Height: 1, Txs: txs},
+		Receipts: make([]*types.ReceiptData, len(txs)),
+	}
+	sequence := &types.BlockSequence{Hash: []byte("hash"), Type: 1}
+	header := &types.Header{Height: 1}
+	//多个订阅者读取同一个sequence时只从数据库加载一次
+	store.On("GetBlockSequence", int64(1)).Return(sequence, nil).Once()
+	store.On("LoadBlockBySequence", int64(1)).Return(detail, 100, nil).Once()
+	store.On("GetBlockHeaderByHash", sequence.Hash).Return(header, nil).Once()
+
+	cache := newPushCache()
+	for i := 0; i < 3; i++ {
+		blockSeq, size, err := cache.get(1).getBlockSeq(store)
+		assert.Nil(t, err)
+		assert.Equal(t, 100, size)
+		assert.Equal(t, detail, blockSeq.Detail)
+
+		headerSeq, _, err := cache.get(1).getHeaderSeq(store)
+		assert.Nil(t, err)
+		assert.Equal(t, header, headerSeq.Header)
+	}
+
+	index, err := cache.get(1).getTxIndex(store, map[string]bool{"coins": true, "ticket": true, "token": false})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2, 3}, index)
+	index, err = cache.get(1).getTxIndex(store, map[string]bool{"none": true})
+	assert.Nil(t, err)
+	assert.Empty(t, index)
+	store.AssertExpectations(t)
+}
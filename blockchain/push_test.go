@@ -281,6 +281,18 @@ func Test_addSubscriber_WithSeqHashHeight(t *testing.T) {
 
 	pushes, _ := chain.ProcListPush()
 	assert.Equal(t, subscribe.Name, pushes.Pushes[0].Name)
+	assert.Equal(t, subscribe.Name, pushes.Stats[0].Name)
+	assert.Equal(t, subscribeStatusActive, pushes.Stats[0].Status)
+}
+
+func Test_addSubscriber_TransportNotSupport(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	subscribe := new(types.PushSubscribeReq)
+	subscribe.Name = "push-test"
+	subscribe.URL = "ftp://localhost"
+	err := chain.push.addSubscriber(subscribe)
+	assert.Equal(t, types.ErrPushTransportNotSupport, err)
 }
 
 func Test_PostBlockFail(t *testing.T) {
@@ -529,6 +541,7 @@ func Test_AddPush_PushNameShouldDiff(t *testing.T) {
 func Test_rmPushFailTask(t *testing.T) {
 	chain, mock33 := createBlockChain(t)
 	chain.push.postFail2Sleep = int32(1)
+	chain.push.maxContinueFail = int32(3)
 	ps := &bcMocks.PostService{}
 	ps.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("timeout"))
 	chain.push.postService = ps
@@ -621,6 +634,7 @@ func Test_ReactivePush(t *testing.T) {
 	mockpsFail.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("timeout"))
 	chain.push.postService = mockpsFail
 	chain.push.postFail2Sleep = int32(1)
+	chain.push.maxContinueFail = int32(3)
 	createBlocks(t, mock33, chain, 10)
 	time.Sleep(4 * time.Second)
 	assert.Equal(t, atomic.LoadInt32(&pushNotify.status), notRunning)
@@ -675,6 +689,7 @@ func Test_RecoverPush(t *testing.T) {
 	mockpsFail.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("timeout"))
 	chain.push.postService = mockpsFail
 	chain.push.postFail2Sleep = int32(1)
+	chain.push.maxContinueFail = int32(3)
 	createBlocks(t, mock33, chain, 10)
 	time.Sleep(3 * time.Second)
 	assert.Equal(t, atomic.LoadInt32(&pushNotifyInfo.status), notRunning)
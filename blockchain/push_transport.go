@@ -0,0 +1,425 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"google.golang.org/grpc"
+)
+
+// 推送的http头, 接收方通过签名验证推送来自本节点
+const (
+	PushHeaderName      = "X-Chain33-Push-Name"
+	PushHeaderSeq       = "X-Chain33-Push-Seq"
+	PushHeaderTimestamp = "X-Chain33-Push-Timestamp"
+	PushHeaderSignature = "X-Chain33-Push-Signature"
+)
+
+const (
+	grpcPushTimeout   = 30 * time.Second
+	pushLogMaxRecSize = 64 * 1024 * 1024
+)
+
+// PushSigner 使用HMAC-SHA256对推送数据签名
+type PushSigner struct {
+	key []byte
+}
+
+// NewPushSigner key为空时不签名
+func NewPushSigner(key string) *PushSigner {
+	return &PushSigner{key: []byte(key)}
+}
+
+// Sign 签名内容为 name、seq、timestamp 和推送数据(未压缩)
+func (signer *PushSigner) Sign(name string, seq, timestamp int64, data []byte) string {
+	if signer == nil || len(signer.key) == 0 {
+		return ""
+	}
+	mac := hmac.New(sha256.New, signer.key)
+	_, _ = fmt.Fprintf(mac, "%s\n%d\n%d\n", name, seq, timestamp)
+	_, _ = mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 验证推送数据的签名
+func (signer *PushSigner) Verify(name string, seq, timestamp int64, data []byte, signature string) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	expect, _ := hex.DecodeString(signer.Sign(name, seq, timestamp, data))
+	return hmac.Equal(sig, expect)
+}
+
+// PushTransportCreator 创建推送的传输方式, 返回nil表示当前配置下不支持该传输方式
+type PushTransportCreator func(cfg *types.BlockChain, signer *PushSigner) PostService
+
+var pushTransports = make(map[string]PushTransportCreator)
+
+// RegisterPushTransport 注册推送传输方式, scheme对应订阅URL的scheme
+func RegisterPushTransport(scheme string, creator PushTransportCreator) {
+	if creator == nil {
+		panic("RegisterPushTransport: creator is nil")
+	}
+	if _, dup := pushTransports[scheme]; dup {
+		panic("RegisterPushTransport: duplicate scheme " + scheme)
+	}
+	pushTransports[scheme] = creator
+}
+
+func init() {
+	RegisterPushTransport("http", newHTTPPushTransport)
+	RegisterPushTransport("https", newHTTPPushTransport)
+	RegisterPushTransport("grpc", newGrpcPushTransport)
+	RegisterPushTransport("file", newFilePushTransport)
+}
+
+// pushMux 根据订阅URL的scheme选择传输方式
+type pushMux struct {
+	transports map[string]PostService
+}
+
+func newPushMux(cfg *types.BlockChain, signer *PushSigner) *pushMux {
+	mux := &pushMux{transports: make(map[string]PostService)}
+	for scheme, creator := range pushTransports {
+		if transport := creator(cfg, signer); transport != nil {
+			mux.transports[scheme] = transport
+		}
+	}
+	return mux
+}
+
+// transport 订阅URL对应的传输方式
+func (mux *pushMux) transport(rawURL string) (PostService, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	transport, ok := mux.transports[strings.ToLower(u.Scheme)]
+	if !ok {
+		return nil, types.ErrPushTransportNotSupport
+	}
+	return transport, nil
+}
+
+// PostData ...
+func (mux *pushMux) PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) error {
+	transport, err := mux.transport(subscribe.URL)
+	if err != nil {
+		return err
+	}
+	return transport.PostData(subscribe, postdata, seq)
+}
+
+func newPushData(signer *PushSigner, subscribe *types.PushSubscribeReq, postdata []byte, seq int64) *types.PushData {
+	timestamp := types.Now().Unix()
+	return &types.PushData{
+		Name:      subscribe.Name,
+		Seq:       seq,
+		Type:      subscribe.Type,
+		Encode:    subscribe.Encode,
+		Data:      postdata,
+		Timestamp: timestamp,
+		Signature: signer.Sign(subscribe.Name, seq, timestamp, postdata),
+	}
+}
+
+// PushClient http方式推送, 数据gzip压缩后POST到订阅URL
+type PushClient struct {
+	client *http.Client
+	signer *PushSigner
+}
+
+func newHTTPPushTransport(cfg *types.BlockChain, signer *PushSigner) PostService {
+	return &PushClient{
+		client: &http.Client{Transport: &http.Transport{
+			Dial: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).Dial,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		}},
+		signer: signer,
+	}
+}
+
+// PostData ...
+func (pushClient *PushClient) PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) (err error) {
+	//post data in body
+	chainlog.Info("postData begin", "seq", seq, "subscribe name", subscribe.Name)
+	var buf bytes.Buffer
+	g := gzip.NewWriter(&buf)
+	if _, err = g.Write(postdata); err != nil {
+		return err
+	}
+	if err = g.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", subscribe.URL, &buf)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", pushContentType(subscribe.Encode))
+	req.Header.Set("Content-Encoding", "gzip")
+	pushData := newPushData(pushClient.signer, subscribe, postdata, seq)
+	req.Header.Set(PushHeaderName, subscribe.Name)
+	req.Header.Set(PushHeaderSeq, strconv.FormatInt(seq, 10))
+	req.Header.Set(PushHeaderTimestamp, strconv.FormatInt(pushData.Timestamp, 10))
+	if pushData.Signature != "" {
+		req.Header.Set(PushHeaderSignature, pushData.Signature)
+	}
+	resp, err := pushClient.client.Do(req)
+	if err != nil {
+		chainlog.Info("postData", "Do err", err)
+		return err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		_ = resp.Body.Close()
+		return err
+	}
+	if resp.StatusCode/100 != 2 || (string(body) != "ok" && string(body) != "OK") {
+		chainlog.Error("postData fail", "name:", subscribe.Name, "URL", subscribe.URL,
+			"Contract:", subscribe.Contract, "status", resp.StatusCode, "body", string(body))
+		_ = resp.Body.Close()
+		return types.ErrPushSeqPostData
+	}
+	chainlog.Debug("postData success", "name", subscribe.Name, "URL", subscribe.URL,
+		"Contract:", subscribe.Contract, "updateSeq", seq)
+	return resp.Body.Close()
+}
+
+// pushContentType 没有指定编码的老订阅者保持原来的text/plain, 指定编码时使用对应的Content-Type
+func pushContentType(encode string) string {
+	switch encode {
+	case "":
+		return "text/plain"
+	case "json":
+		return "application/json"
+	default:
+		return "application/x-protobuf"
+	}
+}
+
+// grpcPushStream 单个订阅者的推送流, mu保证同一订阅者的连接和推送串行进行, 不影响其他订阅者
+type grpcPushStream struct {
+	mu     sync.Mutex
+	target string
+	conn   *grpc.ClientConn
+	stream types.PushReceiver_PushClient
+	cancel context.CancelFunc
+}
+
+func (s *grpcPushStream) close() {
+	if s.conn == nil {
+		return
+	}
+	s.cancel()
+	_ = s.conn.Close()
+	s.conn = nil
+	s.stream = nil
+}
+
+// connect 连接目标变化或者流出错关闭后重新建立连接, 调用者需要持有s.mu
+func (s *grpcPushStream) connect(target string) error {
+	if s.conn != nil && s.target == target {
+		return nil
+	}
+	s.close()
+	dialCtx, dialCancel := context.WithTimeout(context.Background(), grpcPushTimeout)
+	defer dialCancel()
+	conn, err := grpc.DialContext(dialCtx, target, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := types.NewPushReceiverClient(conn).Push(ctx)
+	if err != nil {
+		cancel()
+		_ = conn.Close()
+		return err
+	}
+	s.target, s.conn, s.stream, s.cancel = target, conn, stream, cancel
+	return nil
+}
+
+// grpcPushTransport 通过订阅者实现的PushReceiver双向流推送, 每个订阅者保持一个流, 出错后下次推送时重连
+type grpcPushTransport struct {
+	signer  *PushSigner
+	mu      sync.Mutex
+	streams map[string]*grpcPushStream
+}
+
+func newGrpcPushTransport(cfg *types.BlockChain, signer *PushSigner) PostService {
+	return &grpcPushTransport{signer: signer, streams: make(map[string]*grpcPushStream)}
+}
+
+// getStream 只在t.mu下查找或者创建订阅者的流, 连接在流自己的锁下建立
+func (t *grpcPushTransport) getStream(name string) *grpcPushStream {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.streams[name]
+	if !ok {
+		s = &grpcPushStream{}
+		t.streams[name] = s
+	}
+	return s
+}
+
+// PostData 发送后等待订阅者确认
+func (t *grpcPushTransport) PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) error {
+	u, err := url.Parse(subscribe.URL)
+	if err != nil {
+		return err
+	}
+	s := t.getStream(subscribe.Name)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err = s.connect(u.Host); err != nil {
+		chainlog.Error("grpcPushTransport connect", "name", subscribe.Name, "URL", subscribe.URL, "err", err)
+		return err
+	}
+	stream := s.stream
+	done := make(chan error, 1)
+	go func() {
+		if err := stream.Send(newPushData(t.signer, subscribe, postdata, seq)); err != nil {
+			done <- err
+			return
+		}
+		ack, err := stream.Recv()
+		if err != nil {
+			done <- err
+			return
+		}
+		if !ack.IsOk || ack.Seq != seq {
+			chainlog.Error("grpcPushTransport ack fail", "name", subscribe.Name, "seq", seq, "ackSeq", ack.Seq, "msg", ack.Msg)
+			done <- types.ErrPushSeqPostData
+			return
+		}
+		done <- nil
+	}()
+	select {
+	case err = <-done:
+	case <-time.After(grpcPushTimeout):
+		chainlog.Error("grpcPushTransport wait ack timeout", "name", subscribe.Name, "seq", seq)
+		err = types.ErrPushSeqPostData
+	}
+	if err != nil {
+		//流出错后不能再使用, 关闭后下次重连
+		s.close()
+		if err == io.EOF {
+			err = types.ErrPushSeqPostData
+		}
+	}
+	return err
+}
+
+// filePushTransport 追加写入本地文件, 每条记录为4字节大端长度加上PushData的protobuf编码
+// 文件只能写在配置的pushFileDir目录下, 订阅URL的路径是相对于该目录的路径
+type filePushTransport struct {
+	signer *PushSigner
+	dir    string
+	mu     sync.Mutex
+	files  map[string]*os.File
+}
+
+func newFilePushTransport(cfg *types.BlockChain, signer *PushSigner) PostService {
+	if cfg == nil || cfg.PushFileDir == "" {
+		return nil
+	}
+	return &filePushTransport{signer: signer, dir: cfg.PushFileDir, files: make(map[string]*os.File)}
+}
+
+// path 清理掉路径中的.., 保证文件不会超出配置的目录
+func (t *filePushTransport) path(u *url.URL) (string, error) {
+	name := filepath.Clean("/" + u.Host + u.Path)
+	if name == "/" {
+		return "", types.ErrInvalidParam
+	}
+	return filepath.Join(t.dir, name), nil
+}
+
+// PostData 写入并同步到磁盘后返回
+func (t *filePushTransport) PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) error {
+	u, err := url.Parse(subscribe.URL)
+	if err != nil {
+		return err
+	}
+	path, err := t.path(u)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	file, ok := t.files[path]
+	if !ok {
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		file, err = os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		t.files[path] = file
+	}
+	data := types.Encode(newPushData(t.signer, subscribe, postdata, seq))
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+	if _, err = file.Write(record); err == nil {
+		err = file.Sync()
+	}
+	if err != nil {
+		_ = file.Close()
+		delete(t.files, path)
+		return err
+	}
+	return nil
+}
+
+// ReadPushData 从文件方式推送的日志中读取一条记录, 读完时返回io.EOF
+func ReadPushData(r io.Reader) (*types.PushData, error) {
+	var head [4]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(head[:])
+	if size > pushLogMaxRecSize {
+		return nil, types.ErrSize
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	var pushData types.PushData
+	if err := types.Decode(data, &pushData); err != nil {
+		return nil, err
+	}
+	return &pushData, nil
+}
@@ -0,0 +1,188 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestPushSigner(t *testing.T) {
+	signer := NewPushSigner("key")
+	sig := signer.Sign("push-test", 1, 100, []byte("data"))
+	assert.NotEqual(t, "", sig)
+	assert.True(t, signer.Verify("push-test", 1, 100, []byte("data"), sig))
+	assert.False(t, signer.Verify("push-test", 2, 100, []byte("data"), sig))
+	assert.False(t, NewPushSigner("other").Verify("push-test", 1, 100, []byte("data"), sig))
+	assert.Equal(t, "", NewPushSigner("").Sign("push-test", 1, 100, []byte("data")))
+}
+
+func TestPushRetrySleep(t *testing.T) {
+	push := &Push{postFail2Sleep: postFail2Sleep}
+	for i := int32(1); i <= 40; i++ {
+		sleep := push.retrySleep(i)
+		assert.True(t, sleep >= 1 && sleep <= postFail2Sleep, "sleep=%d", sleep)
+	}
+	sleep := push.retrySleep(1)
+	assert.True(t, sleep >= pushRetryBaseSleep/2 && sleep <= pushRetryBaseSleep)
+	push.postFail2Sleep = 1
+	assert.Equal(t, int32(1), push.retrySleep(10))
+
+	//默认配置下退避时间要经过5次失败才达到上限
+	push.postFail2Sleep = postFail2Sleep
+	assert.Equal(t, pushRetryBaseSleep, push.retryBackoff(1))
+	assert.True(t, push.retryBackoff(4) < postFail2Sleep)
+	assert.Equal(t, postFail2Sleep, push.retryBackoff(5))
+	assert.Equal(t, postFail2Sleep, push.retryBackoff(pushMaxContinueFail-1))
+}
+
+func TestPushMux(t *testing.T) {
+	mux := newPushMux(&types.BlockChain{}, NewPushSigner(""))
+	subscribe := &types.PushSubscribeReq{Name: "push-test", URL: "ftp://localhost"}
+	assert.Equal(t, types.ErrPushTransportNotSupport, mux.PostData(subscribe, []byte("data"), 1))
+	//没有配置目录时不支持file方式
+	_, err := mux.transport("file:///push.log")
+	assert.Equal(t, types.ErrPushTransportNotSupport, err)
+	_, err = mux.transport("http://localhost")
+	assert.Nil(t, err)
+	mux = newPushMux(&types.BlockChain{PushFileDir: "pushlog"}, NewPushSigner(""))
+	_, err = mux.transport("file:///push.log")
+	assert.Nil(t, err)
+}
+
+func TestHTTPPushTransport(t *testing.T) {
+	signer := NewPushSigner("key")
+	reply := "ok"
+	contentType := "application/json"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, contentType, r.Header.Get("Content-Type"))
+		gz, err := gzip.NewReader(r.Body)
+		assert.Nil(t, err)
+		data, err := ioutil.ReadAll(gz)
+		assert.Nil(t, err)
+		seq, _ := strconv.ParseInt(r.Header.Get(PushHeaderSeq), 10, 64)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(PushHeaderTimestamp), 10, 64)
+		assert.True(t, signer.Verify(r.Header.Get(PushHeaderName), seq, timestamp, data, r.Header.Get(PushHeaderSignature)))
+		_, _ = w.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	transport := newHTTPPushTransport(&types.BlockChain{}, signer)
+	subscribe := &types.PushSubscribeReq{Name: "push-test", URL: server.URL, Encode: "json"}
+	assert.Nil(t, transport.PostData(subscribe, []byte(`{"seqs":[]}`), 1))
+	reply = "fail"
+	assert.Equal(t, types.ErrPushSeqPostData, transport.PostData(subscribe, []byte(`{"seqs":[]}`), 2))
+
+	//没有指定编码的老订阅者保持text/plain
+	reply = "ok"
+	contentType = "text/plain"
+	subscribe.Encode = ""
+	assert.Nil(t, transport.PostData(subscribe, []byte("data"), 3))
+	contentType = "application/x-protobuf"
+	subscribe.Encode = "proto"
+	assert.Nil(t, transport.PostData(subscribe, []byte("data"), 4))
+}
+
+func TestFilePushTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pushlog")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sub", "push.log")
+
+	signer := NewPushSigner("key")
+	transport := newFilePushTransport(&types.BlockChain{PushFileDir: dir}, signer)
+	subscribe := &types.PushSubscribeReq{Name: "push-test", URL: "file:///sub/push.log", Type: PushBlock}
+	assert.Nil(t, transport.PostData(subscribe, []byte("data1"), 1))
+	//..不能跳出配置的目录
+	subscribe.URL = "file:///../sub/push.log"
+	assert.Nil(t, transport.PostData(subscribe, []byte("data2"), 2))
+	subscribe.URL = "file:///"
+	assert.Equal(t, types.ErrInvalidParam, transport.PostData(subscribe, []byte("data3"), 3))
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	r := bytes.NewReader(data)
+	for i := int64(1); i <= 2; i++ {
+		pushData, err := ReadPushData(r)
+		assert.Nil(t, err)
+		assert.Equal(t, i, pushData.Seq)
+		assert.Equal(t, PushBlock, pushData.Type)
+		assert.True(t, signer.Verify(pushData.Name, pushData.Seq, pushData.Timestamp, pushData.Data, pushData.Signature))
+	}
+	_, err = ReadPushData(r)
+	assert.Equal(t, io.EOF, err)
+}
+
+type testPushReceiver struct {
+	recv chan *types.PushData
+}
+
+func (r *testPushReceiver) Push(stream types.PushReceiver_PushServer) error {
+	for {
+		data, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		r.recv <- data
+		if err := stream.Send(&types.PushAck{Seq: data.Seq, IsOk: data.Seq != 3}); err != nil {
+			return err
+		}
+	}
+}
+
+func TestGrpcPushTransport(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer()
+	receiver := &testPushReceiver{recv: make(chan *types.PushData, 10)}
+	types.RegisterPushReceiverServer(server, receiver)
+	go server.Serve(l)
+	defer server.Stop()
+
+	transport := newGrpcPushTransport(&types.BlockChain{}, NewPushSigner("key"))
+	subscribe := &types.PushSubscribeReq{Name: "push-test", URL: "grpc://" + l.Addr().String()}
+	assert.Nil(t, transport.PostData(subscribe, []byte("data1"), 1))
+	assert.Equal(t, int64(1), (<-receiver.recv).Seq)
+	assert.Nil(t, transport.PostData(subscribe, []byte("data2"), 2))
+	assert.Equal(t, int64(2), (<-receiver.recv).Seq)
+	//订阅者确认失败后重新建立连接
+	assert.Equal(t, types.ErrPushSeqPostData, transport.PostData(subscribe, []byte("data3"), 3))
+	<-receiver.recv
+	assert.Nil(t, transport.PostData(subscribe, []byte("data4"), 4))
+	assert.Equal(t, int64(4), (<-receiver.recv).Seq)
+
+	//连接不上的订阅者不阻塞其他订阅者的推送
+	blackhole, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer blackhole.Close()
+	blocked := &types.PushSubscribeReq{Name: "push-blocked", URL: "grpc://" + blackhole.Addr().String()}
+	go transport.PostData(blocked, []byte("data"), 1)
+	time.Sleep(100 * time.Millisecond)
+	done := make(chan error, 1)
+	go func() {
+		done <- transport.PostData(subscribe, []byte("data5"), 5)
+	}()
+	select {
+	case err = <-done:
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), (<-receiver.recv).Seq)
+	case <-time.After(5 * time.Second):
+		t.Error("push blocked by unreachable subscriber")
+	}
+}
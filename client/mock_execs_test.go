@@ -21,6 +21,8 @@ func (m *mockExecs) SetQueueClient(q queue.Queue) {
 			switch msg.Ty {
 			case types.EventBlockChainQuery:
 				msg.Reply(client.NewMessage(topic, types.EventBlockChainQuery, &types.Reply{}))
+			case types.EventExecTxList:
+				msg.Reply(client.NewMessage(topic, types.EventReceipts, &types.Receipts{Receipts: []*types.Receipt{{Ty: types.ExecOk}}}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
@@ -40,6 +40,9 @@ func (m *mockStore) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventStoreGetProof:
+				req := msg.GetData().(*types.StoreGet)
+				msg.Reply(client.NewMessage("store", types.EventStoreGetProofReply, &types.ReplyStateProof{StateHash: req.StateHash}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
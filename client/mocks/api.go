@@ -65,6 +65,29 @@ func (_m *QueueProtocolAPI) CloseQueue() (*types.Reply, error) {
 	return r0, r1
 }
 
+// ExecTxList provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) ExecTxList(param *types.ExecTxList) (*types.Receipts, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Receipts
+	if rf, ok := ret.Get(0).(func(*types.ExecTxList) *types.Receipts); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Receipts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ExecTxList) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecWallet provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) ExecWallet(param *types.ChainExecutor) (types.Message, error) {
 	ret := _m.Called(param)
@@ -1179,6 +1202,29 @@ func (_m *QueueProtocolAPI) StoreGet(_a0 *types.StoreGet) (*types.StoreReplyValu
 	return r0, r1
 }
 
+// StoreGetProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) StoreGetProof(param *types.StoreGet) (*types.ReplyStateProof, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyStateProof
+	if rf, ok := ret.Get(0).(func(*types.StoreGet) *types.ReplyStateProof); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyStateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.StoreGet) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreGetTotalCoins provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) StoreGetTotalCoins(_a0 *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	ret := _m.Called(_a0)
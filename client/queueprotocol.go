@@ -757,6 +757,19 @@ func (q *QueueProtocol) StoreList(param *types.StoreList) (*types.StoreListReply
 		return nil, err
 	}
 
+	if len(param.StateHash) == 0 && param.Height > 0 {
+		headers, err := q.GetHeaders(&types.ReqBlocks{Start: param.Height, End: param.Height})
+		if err != nil {
+			log.Error("StoreList", "height", param.Height, "Error", err.Error())
+			return nil, err
+		}
+		if len(headers.GetItems()) == 0 {
+			return nil, types.ErrBlockNotFound
+		}
+		req := *param
+		req.StateHash = headers.Items[0].StateHash
+		param = &req
+	}
 	msg, err := q.send(storeKey, types.EventStoreList, param)
 	if err != nil {
 		log.Error("StoreList", "Error", err.Error())
@@ -768,6 +781,28 @@ func (q *QueueProtocol) StoreList(param *types.StoreList) (*types.StoreListReply
 	return nil, types.ErrTypeAsset
 }
 
+//StoreGetProof get values and mavl proofs of keys from statedb
+func (q *QueueProtocol) StoreGetProof(param *types.StoreGet) (*types.ReplyStateProof, error) {
+	if param == nil || len(param.Keys) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("StoreGetProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(storeKey, types.EventStoreGetProof, param)
+	if err != nil {
+		log.Error("StoreGetProof", "Error", err.Error())
+		return nil, err
+	}
+	switch reply := msg.GetData().(type) {
+	case *types.ReplyStateProof:
+		return reply, nil
+	case *types.Reply:
+		//不支持证明的store通过ReplyErr返回
+		return nil, fmt.Errorf("%s", reply.GetMsg())
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // StoreGetTotalCoins get total coins from statedb
 func (q *QueueProtocol) StoreGetTotalCoins(param *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	if param == nil {
@@ -904,6 +939,29 @@ func (q *QueueProtocol) QueryChain(param *types.ChainExecutor) (types.Message, e
 	return nil, err
 }
 
+// ExecTxList 在指定状态上执行交易, 只返回回执, 不修改状态
+func (q *QueueProtocol) ExecTxList(param *types.ExecTxList) (*types.Receipts, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("ExecTxList", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(executorKey, types.EventExecTxList, param)
+	if err != nil {
+		log.Error("ExecTxList", "Error", err)
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Receipts); ok {
+		return reply, nil
+	}
+	if err, ok := msg.GetData().(error); ok {
+		return nil, err
+	}
+	err = types.ErrTypeAsset
+	log.Error("ExecTxList", "Error", err)
+	return nil, err
+}
+
 // AddPushSubscribe Add Seq CallBack
 func (q *QueueProtocol) AddPushSubscribe(param *types.PushSubscribeReq) (*types.ReplySubscribePush, error) {
 	msg, err := q.send(blockchainKey, types.EventSubscribePush, param)
@@ -100,6 +100,26 @@ func TestQueueProtocol(t *testing.T) {
 	testQueryConsensus(t, api)
 	testExecWalletFunc(t, api)
 	testGetSequenceByHash(t, api)
+	testExecTxList(t, api)
+	testStoreGetProof(t, api)
+}
+
+func testStoreGetProof(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.StoreGetProof(nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = api.StoreGetProof(&types.StoreGet{StateHash: []byte("hash")})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	res, err := api.StoreGetProof(&types.StoreGet{StateHash: []byte("hash"), Keys: [][]byte{[]byte("k1")}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hash"), res.StateHash)
+}
+
+func testExecTxList(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.ExecTxList(nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	res, err := api.ExecTxList(&types.ExecTxList{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res.Receipts))
 }
 
 func testGetSequenceByHash(t *testing.T, api client.QueueProtocolAPI) {
@@ -34,6 +34,8 @@ type QueueProtocolAPI interface {
 	QueryChain(param *types.ChainExecutor) (types.Message, error)
 	ExecWalletFunc(driver string, funcname string, param types.Message) (types.Message, error)
 	ExecWallet(param *types.ChainExecutor) (types.Message, error)
+	// types.EventExecTxList
+	ExecTxList(param *types.ExecTxList) (*types.Receipts, error)
 	// --------------- execs interfaces end
 
 	// +++++++++++++++ p2p interfaces begin
@@ -112,6 +114,7 @@ type QueueProtocolAPI interface {
 	StoreDel(param *types.StoreDel) (*types.ReplyHash, error)
 	StoreGetTotalCoins(*types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error)
 	StoreList(param *types.StoreList) (*types.StoreListReply, error)
+	StoreGetProof(param *types.StoreGet) (*types.ReplyStateProof, error)
 	// --------------- store interfaces end
 
 	// +++++++++++++++ other interfaces begin
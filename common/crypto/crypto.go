@@ -14,6 +14,9 @@ import (
 //ErrNotSupportAggr 不支持聚合签名
 var ErrNotSupportAggr = errors.New("AggregateCrypto not support")
 
+//ErrNotSupportBatch 不支持批量验证签名
+var ErrNotSupportBatch = errors.New("BatchCrypto not support")
+
 //PrivKey 私钥
 type PrivKey interface {
 	Bytes() []byte
@@ -62,6 +65,19 @@ func ToAggregate(c Crypto) (AggregateCrypto, error) {
 	return nil, ErrNotSupportAggr
 }
 
+//BatchCrypto 批量验证签名, 所有签名都正确时返回true, 返回false时需要逐个验证找出错误的签名
+type BatchCrypto interface {
+	VerifyBatch(pubs []PubKey, msgs [][]byte, sigs []Signature) bool
+}
+
+//ToBatch 判断签名是否支持批量验证，并且返回批量验证的接口
+func ToBatch(c Crypto) (BatchCrypto, error) {
+	if batch, ok := c.(BatchCrypto); ok {
+		return batch, nil
+	}
+	return nil, ErrNotSupportBatch
+}
+
 var (
 	drivers     = make(map[string]Crypto)
 	driversCGO  = make(map[string]Crypto)
@@ -41,6 +41,8 @@ type executor struct {
 	currDriver drivers.Driver
 	cfg        *types.Chain33Config
 	exec       *Executor
+	//资源计量, ForkExecGas之前为nil
+	gas *gasMeter
 }
 
 type executorCtx struct {
@@ -76,6 +78,7 @@ func newExecutor(ctx *executorCtx, exec *Executor, localdb dbm.KVDB, txs []*type
 		currTxIdx:    -1,
 		cfg:          cfg,
 		exec:         exec,
+		gas:          newGasMeter(cfg, ctx.height),
 	}
 	e.coinsAccount.SetDB(e.stateDB)
 	return e
@@ -198,7 +201,7 @@ func (e *executor) execCheckTx(tx *types.Transaction, index int) error {
 		return err
 	}
 	//检查地址的有效性
-	if err := address.CheckAddress(tx.To); err != nil {
+	if err := types.CheckToAddress(e.cfg, e.height, tx.To); err != nil {
 		return err
 	}
 	var exec drivers.Driver
@@ -359,6 +362,7 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	//开启内存事务处理，假设系统只有一个thread 执行
 	//如果系统执行失败，回滚到这个状态
 	rollbackLog := copyReceipt(feelog)
+	e.gas.setFee(txs[0].Fee)
 	e.begin()
 	receipts := make([]*types.Receipt, len(txs))
 	for i := 1; i < len(txs); i++ {
@@ -370,6 +374,12 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 		if api.IsAPIEnvError(err) {
 			return nil, err
 		}
+		//区块gas不够, 交易组不打包
+		if err == types.ErrBlockGasLimit {
+			e.rollback()
+			e.refundFee(rollbackLog)
+			return nil, err
+		}
 		//状态数据库回滚
 		if e.cfg.IsFork(e.height, "ForkExecRollback") {
 			e.rollback()
@@ -384,6 +394,11 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 			if api.IsAPIEnvError(err) {
 				return nil, err
 			}
+			if err == types.ErrBlockGasLimit {
+				e.rollback()
+				e.refundFee(rollbackLog)
+				return nil, err
+			}
 			for k := 1; k < i; k++ {
 				receipts[k] = &types.Receipt{Ty: types.ExecPack}
 			}
@@ -441,6 +456,21 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 	return feelog, nil
 }
 
+//refundFee 区块gas不够的交易不打包, 恢复已经扣除的手续费
+func (e *executor) refundFee(feelog *types.Receipt) {
+	for _, l := range feelog.GetLogs() {
+		if l.Ty != types.TyLogFee {
+			continue
+		}
+		var transfer types.ReceiptAccountTransfer
+		if err := types.Decode(l.Log, &transfer); err != nil {
+			panic(err)
+		}
+		e.coinsAccount.SaveKVSet(e.coinsAccount.GetKVSet(transfer.Prev))
+		return
+	}
+}
+
 func copyReceipt(feelog *types.Receipt) *types.Receipt {
 	receipt := types.Receipt{}
 	receipt = *feelog
@@ -477,18 +507,31 @@ func (e *executor) execTxOne(feelog *types.Receipt, tx *types.Transaction, index
 	if err != nil {
 		return feelog, err
 	}
-	err = e.execLocalSameTime(tx, receipt, index)
+	localset, err := e.execLocalSameTime(tx, receipt, index)
 	if err != nil {
 		elog.Error("execLocalSameTime", "err", err)
 		errlog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(err.Error())}
 		feelog.Logs = append(feelog.Logs, errlog)
 		return feelog, err
 	}
+	var gaslog *types.ReceiptLog
+	if e.gas != nil {
+		gaslog, err = e.meterTx(tx, receipt, localset)
+		if err != nil {
+			elog.Error("exec tx gas", "err", err, "exec", string(tx.Execer), "action", tx.ActionName())
+			errlog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(err.Error())}
+			feelog.Logs = append(feelog.Logs, gaslog, errlog)
+			return feelog, err
+		}
+	}
 	if receipt != nil {
 		feelog.KV = append(feelog.KV, receipt.KV...)
 		feelog.Logs = append(feelog.Logs, receipt.Logs...)
 		feelog.Ty = receipt.Ty
 	}
+	if gaslog != nil {
+		feelog.Logs = append(feelog.Logs, gaslog)
+	}
 	if e.cfg.IsFork(e.height, "ForkStateDBSet") {
 		for _, v := range feelog.KV {
 			if err := e.stateDB.Set(v.Key, v.Value); err != nil {
@@ -531,6 +574,7 @@ func (e *executor) checkKeyAllow(feelog *types.Receipt, tx *types.Transaction, i
 }
 
 func (e *executor) begin() {
+	e.gas.begin()
 	if e.cfg.IsFork(e.height, "ForkExecRollback") {
 		if e.stateDB != nil {
 			e.stateDB.Begin()
@@ -567,6 +611,7 @@ func (e *executor) startTx() {
 }
 
 func (e *executor) rollback() {
+	e.gas.rollback()
 	if e.cfg.IsFork(e.height, "ForkExecRollback") {
 		if e.stateDB != nil {
 			e.stateDB.Rollback()
@@ -606,9 +651,15 @@ func (e *executor) execTx(exec *Executor, tx *types.Transaction, index int) (*ty
 	if err != nil {
 		return nil, err
 	}
+	e.gas.setFee(tx.Fee)
 	//ignore err
 	e.begin()
 	feelog, err = e.execTxOne(feelog, tx, index)
+	if err == types.ErrBlockGasLimit {
+		e.rollback()
+		e.refundFee(feelog)
+		return nil, err
+	}
 	if err != nil {
 		e.rollback()
 	} else {
@@ -655,17 +706,16 @@ func (e *executor) checkPrefix(execer []byte, kvs []*types.KeyValue) error {
 	return nil
 }
 
-func (e *executor) execLocalSameTime(tx *types.Transaction, receipt *types.Receipt, index int) error {
+func (e *executor) execLocalSameTime(tx *types.Transaction, receipt *types.Receipt, index int) (*types.LocalDBSet, error) {
 	if e.isExecLocalSameTime(tx, index) {
 		var r = &types.ReceiptData{}
 		if receipt != nil {
 			r.Ty = receipt.Ty
 			r.Logs = receipt.Logs
 		}
-		_, err := e.execLocalTx(tx, r, index)
-		return err
+		return e.execLocalTx(tx, r, index)
 	}
-	return nil
+	return nil, nil
 }
 
 func (e *executor) execLocalTx(tx *types.Transaction, r *types.ReceiptData, index int) (*types.LocalDBSet, error) {
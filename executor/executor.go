@@ -7,10 +7,12 @@ package executor
 
 //store package store the world - state data
 import (
+	"bytes"
 	"strings"
 	"sync"
 
 	"github.com/33cn/chain33/client/api"
+	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
@@ -46,6 +48,7 @@ type Executor struct {
 	pluginEnable   map[string]bool
 	alias          map[string]string
 	noneDriverPool *sync.Pool
+	parallel       bool
 }
 
 func execInit(cfg *typ.Chain33Config) {
@@ -68,6 +71,7 @@ func New(cfg *typ.Chain33Config) *Executor {
 	exec.pluginEnable["addrindex"] = !mcfg.DisableAddrIndex
 	exec.pluginEnable["txindex"] = true
 	exec.pluginEnable["fee"] = true
+	exec.parallel = mcfg.EnableParallelExec
 	exec.noneDriverPool = &sync.Pool{
 		New: func() interface{} {
 			none, err := drivers.LoadDriver("none", 0)
@@ -197,30 +201,34 @@ func (exec *Executor) procExecQuery(msg *queue.Message) {
 			return
 		}
 	}()
-	header, err := exec.qclient.GetLastHeader()
+	data := msg.GetData().(*types.ChainExecutor)
+	header, stateHash, history, err := exec.queryState(data)
 	if err != nil {
 		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
 		return
 	}
-	data := msg.GetData().(*types.ChainExecutor)
 	driver, err := drivers.LoadDriverWithClient(exec.qclient, data.Driver, header.GetHeight())
 	if err != nil {
 		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
 		return
 	}
-	if data.StateHash == nil {
-		data.StateHash = header.StateHash
-	}
 	var localdb dbm.KVDB
 	if !exec.disableLocal {
 		//query 只需要读取localdb
 		localdb = NewLocalDB(exec.client, true)
 		defer localdb.(*LocalDB).Close()
 		driver.SetLocalDB(localdb)
+		//localdb 只有最新的数据, 查询历史状态时禁止合约读取localdb
+		if history {
+			nohistory := NewLocalDB(exec.client, true)
+			defer nohistory.(*LocalDB).Close()
+			nohistory.(*LocalDB).DisableRead()
+			driver.SetLocalDB(nohistory)
+		}
 	}
 	opt := &StateDBOption{EnableMVCC: exec.pluginEnable["mvcc"], Height: header.GetHeight()}
 
-	db := NewStateDB(exec.client, data.StateHash, localdb, opt)
+	db := NewStateDB(exec.client, stateHash, localdb, opt)
 	db.(*StateDB).enableMVCC(nil)
 	driver.SetStateDB(db)
 	driver.SetAPI(exec.qclient)
@@ -229,6 +237,9 @@ func (exec *Executor) procExecQuery(msg *queue.Message) {
 	//查询的情况下下，执行器不做严格校验，allow，尽可能的加载执行器，并且做查询
 
 	ret, err := driver.Query(data.FuncName, data.Param)
+	if history && err == types.ErrDisableRead {
+		err = types.ErrLocalDBNotHistory
+	}
 	if err != nil {
 		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
 		return
@@ -236,6 +247,41 @@ func (exec *Executor) procExecQuery(msg *queue.Message) {
 	msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, ret))
 }
 
+//queryState 查询使用的区块头和状态, 指定了高度时使用该高度的区块, 否则使用最新的区块
+//查询历史状态时先检查状态是否已经被裁剪, 返回的history表示查询的不是最新状态
+func (exec *Executor) queryState(data *types.ChainExecutor) (*types.Header, []byte, bool, error) {
+	header, err := exec.qclient.GetLastHeader()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	stateHash := data.StateHash
+	if stateHash == nil && (data.Height > 0 || data.ByHeight) && data.Height != header.GetHeight() {
+		if data.Height < 0 || data.Height > header.GetHeight() {
+			return nil, nil, false, types.ErrBlockNotFound
+		}
+		headers, err := exec.qclient.GetHeaders(&types.ReqBlocks{Start: data.Height, End: data.Height})
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if len(headers.GetItems()) == 0 {
+			return nil, nil, false, types.ErrBlockNotFound
+		}
+		header = headers.Items[0]
+	} else if stateHash == nil || bytes.Equal(stateHash, header.StateHash) {
+		return header, header.StateHash, false, nil
+	}
+	if stateHash == nil {
+		stateHash = header.StateHash
+	}
+	//count 为0的列表查询只用来检查状态是否存在
+	_, err = exec.qclient.StoreList(&types.StoreList{StateHash: stateHash, Mode: 1})
+	if err != nil {
+		elog.Error("procExecQuery", "stateHash", common.ToHex(stateHash), "err", err)
+		return nil, nil, false, err
+	}
+	return header, stateHash, true, nil
+}
+
 func (exec *Executor) procExecCheckTx(msg *queue.Message) {
 	//panic 处理
 	defer func() {
@@ -308,6 +354,7 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 	}
 	execute := newExecutor(ctx, exec, localdb, datas.Txs, nil)
 	execute.enableMVCC(nil)
+	parallel := exec.isParallel(execute.cfg, datas.Height)
 	var receipts []*types.Receipt
 	index := 0
 	for i := 0; i < len(datas.Txs); i++ {
@@ -317,6 +364,23 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
 			continue
 		}
+		if parallel && execute.isParallelTx(tx, index) {
+			end := i + 1
+			for end < len(datas.Txs) && execute.isParallelTx(datas.Txs[end], index+end-i) {
+				end++
+			}
+			if end-i > 1 {
+				receiptlist, next, err := execute.execTxsParallel(exec, datas.Txs[i:end], index)
+				if err != nil {
+					msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
+					return
+				}
+				receipts = append(receipts, receiptlist...)
+				index = next
+				i = end - 1
+				continue
+			}
+		}
 		if tx.GroupCount == 0 {
 			receipt, err := execute.execTx(exec, tx, index)
 			if api.IsAPIEnvError(err) {
@@ -364,6 +428,15 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 		&types.Receipts{Receipts: receipts}))
 }
 
+//isParallel 是否并行执行区块中的交易, 需要顺序执行的状态写入和回滚规则都已经生效
+func (exec *Executor) isParallel(cfg *types.Chain33Config, height int64) bool {
+	return exec.parallel && height > 0 &&
+		cfg.IsFork(height, "ForkParallelExec") &&
+		cfg.IsFork(height, "ForkExecRollback") &&
+		cfg.IsFork(height, "ForkStateDBSet") &&
+		cfg.IsFork(height, "ForkLocalDBAccess")
+}
+
 func (exec *Executor) procExecAddBlock(msg *queue.Message) {
 	//panic 处理
 	defer func() {
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
+	"reflect"
+	"strings"
 	"testing"
 
 	"sync"
@@ -44,13 +46,17 @@ func TestExecGenesisBlock(t *testing.T) {
 func newMockNode() *testnode.Chain33Mock {
 	cfg := testnode.GetDefaultConfig()
 	cfg.GetModuleConfig().Consensus.Minerstart = false
-	runonce.Do(func() {
-		drivers.Register(cfg, "demo2", newdemoApp, 1)
-	})
+	registerDemo(cfg)
 	mock33 := testnode.NewWithConfig(cfg, nil)
 	return mock33
 }
 
+func registerDemo(cfg *types.Chain33Config) {
+	runonce.Do(func() {
+		drivers.Register(cfg, "demo2", newdemoApp, 0)
+	})
+}
+
 func TestTxGroup(t *testing.T) {
 	mock33 := newMockNode()
 	defer mock33.Close()
@@ -310,6 +316,21 @@ func (demo *demoApp) GetDriverName() string {
 	return "demo2"
 }
 
+func (demo *demoApp) GetFuncMap() map[string]reflect.Method {
+	return types.ListMethod(demo)
+}
+
+//Query_GetAccount 从statedb中读取账户, 用来测试历史状态查询
+func (demo *demoApp) Query_GetAccount(in *types.ReqString) (types.Message, error) {
+	value, err := demo.GetStateDB().Get([]byte("mavl-coins-bty-" + in.Data))
+	if err != nil {
+		return nil, err
+	}
+	var acc types.Account
+	err = types.Decode(value, &acc)
+	return &acc, err
+}
+
 var orderflag = drivers.ExecLocalSameTime
 
 func (demo *demoApp) ExecutorOrder() int64 {
@@ -486,3 +507,143 @@ func TestExecLocalSameTimeSetErrKey(t *testing.T) {
 		}
 	}
 }
+
+func TestExecBlockGas(t *testing.T) {
+	mock33 := newMockNode()
+	defer mock33.Close()
+	cfg := mock33.GetClient().GetConfig()
+	mock33.WaitHeight(0)
+	block0 := mock33.GetBlock(0)
+	block := util.CreateCoinsBlock(cfg, mock33.GetGenesisKey(), 10)
+	detail, _, err := util.ExecBlock(mock33.GetClient(), block0.StateHash, block, false, true, false)
+	assert.Nil(t, err)
+	for _, receipt := range detail.Receipts {
+		assert.Equal(t, int32(types.ExecOk), receipt.Ty)
+		gaslog := receipt.Logs[len(receipt.Logs)-1]
+		assert.Equal(t, int32(types.TyLogGas), gaslog.Ty)
+		var gas types.ReceiptExecGas
+		assert.Nil(t, types.Decode(gaslog.Log, &gas))
+		assert.True(t, gas.GasUsed > 0)
+		assert.True(t, gas.StateWrite > 0)
+	}
+}
+
+func TestExecBlockGasLimit(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "blockGasLimit = 100000000", "blockGasLimit = 25000", 1))
+	cfg.GetModuleConfig().Consensus.Minerstart = false
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	mock33.WaitHeight(0)
+	block0 := mock33.GetBlock(0)
+	genaddr := mock33.GetGenesisAddress()
+	block := util.CreateCoinsBlock(cfg, mock33.GetGenesisKey(), 10)
+	detail, deltx, err := util.ExecBlock(mock33.GetClient(), block0.StateHash, block, false, true, false)
+	assert.Nil(t, err)
+	n := len(detail.Block.Txs)
+	assert.True(t, n > 0 && n < 10, "txs in block %d", n)
+	//区块gas不够的交易不打包, 也不从mempool删除
+	assert.Equal(t, 0, len(deltx))
+	var spent int64
+	for i, tx := range detail.Block.Txs {
+		assert.Equal(t, int32(types.ExecOk), detail.Receipts[i].Ty)
+		spent += tx.Fee + 11
+	}
+	//没有打包的交易不扣手续费
+	assert.Equal(t, 100000000*types.Coin-spent, mock33.GetAccount(detail.Block.StateHash, genaddr).Balance)
+}
+
+func TestQueryHistoryState(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	registerDemo(cfg)
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	api := mock33.GetAPI()
+	hot := mock33.GetHotAddress()
+	assert.Nil(t, mock33.SendHot())
+	mock33.SendTx(util.CreateCoinsTx(cfg, mock33.GetGenesisKey(), hot, types.Coin))
+	_, err := mock33.WaitTx(mock33.GetLastSendTx())
+	assert.Nil(t, err)
+	header, err := api.GetLastHeader()
+	assert.Nil(t, err)
+	assert.True(t, header.Height > 1)
+
+	//statedb中的数据可以按照高度查询历史状态
+	query := &types.ChainExecutor{Driver: "demo2", FuncName: "GetAccount", Param: types.Encode(&types.ReqString{Data: hot})}
+	reply, err := api.QueryChain(query)
+	assert.Nil(t, err)
+	assert.Equal(t, 10001*types.Coin, reply.(*types.Account).Balance)
+	query.Height = header.Height - 1
+	reply, err = api.QueryChain(query)
+	assert.Nil(t, err)
+	assert.Equal(t, 10000*types.Coin, reply.(*types.Account).Balance)
+	//height为0并且ByHeight时查询创世区块
+	query.Height = 0
+	query.ByHeight = true
+	_, err = api.QueryChain(query)
+	assert.Equal(t, types.ErrNotFound, err)
+	query.ByHeight = false
+	reply, err = api.QueryChain(query)
+	assert.Nil(t, err)
+	assert.Equal(t, 10001*types.Coin, reply.(*types.Account).Balance)
+	query.Height = header.Height + 10000
+	_, err = api.QueryChain(query)
+	assert.Equal(t, types.ErrBlockNotFound, err)
+
+	//localdb 不是历史数据, 查询历史状态时不能读取localdb
+	query = &types.ChainExecutor{Driver: "coins", FuncName: "GetTxsByAddr", Param: types.Encode(&types.ReqAddr{Addr: hot, Count: 10, Height: -1})}
+	_, err = api.QueryChain(query)
+	assert.NotEqual(t, types.ErrLocalDBNotHistory, err)
+	query.Height = header.Height - 1
+	_, err = api.QueryChain(query)
+	assert.Equal(t, types.ErrLocalDBNotHistory, err)
+
+	//不存在或者被裁剪掉的状态
+	query.Height = 0
+	query.StateHash = common.Sha256([]byte("pruned"))
+	_, err = api.QueryChain(query)
+	assert.Equal(t, types.ErrStatePruned, err)
+
+	list := &types.StoreList{Height: header.Height, Start: []byte("mavl-coins-bty-"), End: []byte("mavl-coins-bty-~"), Count: 10, Mode: 1}
+	storeReply, err := api.StoreList(list)
+	assert.Nil(t, err)
+	assert.True(t, len(storeReply.Keys) > 0)
+	_, err = api.StoreList(&types.StoreList{StateHash: query.StateHash, Mode: 1})
+	assert.Equal(t, types.ErrStatePruned, err)
+}
+
+func TestMultiSignAddressTransfer(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	cfg := mock33.GetClient().GetConfig()
+	_, priv0 := util.Genaddress()
+	_, priv1 := util.Genaddress()
+	pub, err := types.NewMultiSignPubKey(2, []*types.MultiSignKey{
+		{Ty: types.SECP256K1, Pubkey: priv0.PubKey().Bytes()},
+		{Ty: types.SECP256K1, Pubkey: priv1.PubKey().Bytes()},
+	})
+	assert.Nil(t, err)
+	multiAddr := pub.Address()
+
+	//向多重签名地址转账
+	mock33.SendTx(util.CreateCoinsTx(cfg, mock33.GetGenesisKey(), multiAddr, 10*types.Coin))
+	detail, err := mock33.WaitTx(mock33.GetLastSendTx())
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	assert.Equal(t, 10*types.Coin, mock33.GetAccount(mock33.GetLastBlock().StateHash, multiAddr).Balance)
+
+	//2-of-2多重签名从多重签名地址转出
+	to, _ := util.Genaddress()
+	tx := util.CreateCoinsTx(cfg, priv0, to, types.Coin)
+	assert.Nil(t, tx.SignMulti(pub, types.SECP256K1, priv0))
+	assert.Nil(t, tx.SignMulti(pub, types.SECP256K1, priv1))
+	assert.Equal(t, multiAddr, tx.From())
+	mock33.SendTx(tx)
+	detail, err = mock33.WaitTx(mock33.GetLastSendTx())
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	stateHash := mock33.GetLastBlock().StateHash
+	assert.Equal(t, types.Coin, mock33.GetAccount(stateHash, to).Balance)
+	assert.Equal(t, 9*types.Coin-tx.Fee, mock33.GetAccount(stateHash, multiAddr).Balance)
+}
@@ -0,0 +1,120 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+/*
+交易执行资源计量(ForkExecGas之后生效):
+1. 交易的字节数, 读取状态的次数, 写入状态和localdb的数量以及字节数, 按照 mver.exec.gas 中的参数折算成gas
+   localdb只计量ExecLocalSameTime执行器在执行交易时写入的数据, 普通执行器的ExecLocal在区块加入时执行,
+   只在本节点生效, 不属于共识数据, 不计量
+2. 单笔交易和整个区块消耗的gas有上限, 交易(组)的手续费需要足够支付 gas * gasPrice
+3. 超过单笔交易限制或者手续费不足时交易执行失败, 手续费照常扣除, 回执中记录资源消耗
+4. 区块剩余的gas不够时交易返回ExecErr, 不扣手续费也不打包, 留在mempool中等待下一个区块;
+   单独一笔交易(组)就超过区块上限时永远不能打包, 按照超过单笔交易限制处理
+*/
+
+//gasMeter 单个区块执行期间的资源计量
+type gasMeter struct {
+	param *types.GasParam
+	//区块中已经消耗的gas
+	blockGas int64
+	//当前交易(组)剩余可以支付gas的手续费
+	fee int64
+	//交易组开始执行时的状态, 回滚时恢复
+	savedGas int64
+	savedFee int64
+}
+
+func newGasMeter(cfg *types.Chain33Config, height int64) *gasMeter {
+	if height == 0 || !cfg.IsFork(height, "ForkExecGas") {
+		return nil
+	}
+	param := cfg.GetGasParam(height)
+	//免手续费的链只计量和限制gas, 不检查手续费
+	if cfg.GetMinTxFeeRate() == 0 {
+		param.GasPrice = 0
+	}
+	return &gasMeter{param: param}
+}
+
+//setFee 交易组的手续费由第一笔交易支付
+func (m *gasMeter) setFee(fee int64) {
+	if m != nil {
+		m.fee = fee
+	}
+}
+
+func (m *gasMeter) begin() {
+	if m != nil {
+		m.savedGas = m.blockGas
+		m.savedFee = m.fee
+	}
+}
+
+func (m *gasMeter) rollback() {
+	if m != nil {
+		m.blockGas = m.savedGas
+		m.fee = m.savedFee
+	}
+}
+
+//measure 计算交易消耗的资源, local为ExecLocalSameTime写入的localdb数据
+func (m *gasMeter) measure(tx *types.Transaction, reads int64, kvs []*types.KeyValue, local *types.LocalDBSet) *types.ReceiptExecGas {
+	gas := &types.ReceiptExecGas{
+		StateRead:  reads,
+		StateWrite: int64(len(kvs)),
+		LocalWrite: int64(len(local.GetKV())),
+		TxBytes:    int64(tx.Size()),
+	}
+	for _, kv := range kvs {
+		gas.WriteBytes += int64(len(kv.Key) + len(kv.Value))
+	}
+	for _, kv := range local.GetKV() {
+		gas.WriteBytes += int64(len(kv.Key) + len(kv.Value))
+	}
+	p := m.param
+	gas.GasUsed = p.TxByteGas*gas.TxBytes + p.StateReadGas*gas.StateRead + p.StateWriteGas*gas.StateWrite +
+		p.SameTimeLocalWriteGas*gas.LocalWrite + p.WriteByteGas*gas.WriteBytes
+	return gas
+}
+
+//consume 检查限制, 并从区块和手续费中扣除gas
+func (m *gasMeter) consume(gas int64) error {
+	p := m.param
+	if p.TxGasLimit > 0 && gas > p.TxGasLimit {
+		return types.ErrTxGasLimit
+	}
+	if p.BlockGasLimit > 0 && m.blockGas+gas > p.BlockGasLimit {
+		//savedGas为交易(组)开始执行时区块已经消耗的gas
+		if m.blockGas-m.savedGas+gas > p.BlockGasLimit {
+			return types.ErrTxGasLimit
+		}
+		return types.ErrBlockGasLimit
+	}
+	if p.GasPrice > 0 {
+		if gas > m.fee/p.GasPrice {
+			return types.ErrGasFeeNotEnough
+		}
+		m.fee -= gas * p.GasPrice
+	}
+	m.blockGas += gas
+	return nil
+}
+
+//exceed 并行执行的结果加入区块后是否超过区块的限制
+func (m *gasMeter) exceed(gas int64) bool {
+	return m.param.BlockGasLimit > 0 && m.blockGas+gas > m.param.BlockGasLimit
+}
+
+//meterTx 计量交易消耗的资源, 返回记录资源消耗的回执日志
+func (e *executor) meterTx(tx *types.Transaction, receipt *types.Receipt, local *types.LocalDBSet) (*types.ReceiptLog, error) {
+	gas := e.gas.measure(tx, e.stateDB.(*StateDB).nread, receipt.GetKV(), local)
+	gaslog := &types.ReceiptLog{Ty: types.TyLogGas, Log: types.Encode(gas)}
+	return gaslog, e.gas.consume(gas.GasUsed)
+}
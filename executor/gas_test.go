@@ -0,0 +1,68 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasMeter(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	assert.Nil(t, newGasMeter(cfg, 0))
+	meter := newGasMeter(cfg, 1)
+	assert.NotNil(t, meter)
+	assert.Equal(t, int64(10), meter.param.GasPrice)
+	assert.Equal(t, int64(500), meter.param.SameTimeLocalWriteGas)
+
+	meter.param = &types.GasParam{
+		TxGasLimit:            10000,
+		BlockGasLimit:         15000,
+		GasPrice:              10,
+		TxByteGas:             1,
+		StateReadGas:          100,
+		StateWriteGas:         1000,
+		SameTimeLocalWriteGas: 500,
+		WriteByteGas:          10,
+	}
+	tx := util.CreateNoneTx(cfg, nil)
+	kvs := []*types.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}, {Key: []byte("k2"), Value: []byte("v2")}}
+	local := &types.LocalDBSet{KV: []*types.KeyValue{{Key: []byte("l1"), Value: []byte("v1")}}}
+	gas := meter.measure(tx, 3, kvs, local)
+	assert.Equal(t, int64(2), gas.StateWrite)
+	assert.Equal(t, int64(1), gas.LocalWrite)
+	assert.Equal(t, int64(12), gas.WriteBytes)
+	assert.Equal(t, int64(tx.Size())+300+2000+500+120, gas.GasUsed)
+	//非ExecLocalSameTime的执行器没有执行时写入的localdb, 不计量
+	gas = meter.measure(tx, 3, kvs, nil)
+	assert.Equal(t, int64(0), gas.LocalWrite)
+	assert.Equal(t, int64(tx.Size())+300+2000+80, gas.GasUsed)
+
+	meter.setFee(100000)
+	meter.begin()
+	assert.Equal(t, types.ErrTxGasLimit, meter.consume(10001))
+	assert.Nil(t, meter.consume(9000))
+	assert.Equal(t, int64(9000), meter.blockGas)
+	assert.Equal(t, int64(10000), meter.fee)
+	assert.Equal(t, types.ErrGasFeeNotEnough, meter.consume(1001))
+	assert.Nil(t, meter.consume(1000))
+	assert.True(t, meter.exceed(5001))
+	meter.rollback()
+	assert.Equal(t, int64(0), meter.blockGas)
+	assert.Equal(t, int64(100000), meter.fee)
+
+	//区块剩余gas不足时返回ErrBlockGasLimit, 交易(组)本身超过区块上限时按交易超限处理
+	meter.blockGas = 10000
+	meter.begin()
+	assert.Equal(t, types.ErrBlockGasLimit, meter.consume(5001))
+	meter.rollback()
+	meter.blockGas = 0
+	meter.begin()
+	assert.Nil(t, meter.consume(9000))
+	assert.Equal(t, types.ErrTxGasLimit, meter.consume(7000))
+}
@@ -0,0 +1,164 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/33cn/chain33/client/api"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+乐观并行执行:
+1. 区块中连续的普通交易(非交易组, 且执行器不在执行时同时写localdb)作为一段, 并行执行
+2. 每笔交易在独立的 StateDB 上执行, 读取时穿透到段开始时的状态, 并记录读集合, 写集合即回执中的KV
+3. 按区块顺序校验, 交易读取的key被前面的交易写过, 或者预设的index不正确时, 在当前状态上顺序重新执行
+校验通过的交易回执和顺序执行完全一致, 直接把回执的KV写入状态
+*/
+
+//parallelResult 单笔交易并行执行的结果
+type parallelResult struct {
+	receipt *types.Receipt
+	err     error
+	//执行时假设的交易index
+	index int
+	reads map[string]bool
+	//计入区块的gas
+	gas int64
+	//执行过程中panic或者接口错误, 需要重新执行
+	invalid bool
+}
+
+//isParallelTx 可以并行执行的交易
+func (e *executor) isParallelTx(tx *types.Transaction, index int) bool {
+	return tx.GroupCount == 0 && !e.isExecLocalSameTime(tx, index)
+}
+
+//conflict 是否需要在当前状态上重新执行
+func (r *parallelResult) conflict(index int, written map[string]bool) bool {
+	if r.invalid || r.index != index {
+		return true
+	}
+	for key := range r.reads {
+		if written[key] {
+			return true
+		}
+	}
+	if r.receipt != nil {
+		for _, kv := range r.receipt.KV {
+			//删除key后的读取会穿透到父状态, 和顺序执行的行为不一致
+			if kv.Value == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//execTxsParallel 并行执行一段普通交易, 返回和顺序执行一致的回执以及执行后的index
+func (e *executor) execTxsParallel(exec *Executor, txs []*types.Transaction, index int) ([]*types.Receipt, int, error) {
+	results := make([]*parallelResult, len(txs))
+	workers := runtime.NumCPU()
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	base := e.stateDB.(*StateDB)
+	jobs := make(chan int, len(txs))
+	for i := range txs {
+		jobs <- i
+	}
+	close(jobs)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			var localdb dbm.KVDB
+			if e.localDB != nil {
+				localdb = NewLocalDB(exec.client, false)
+				defer localdb.(*LocalDB).Close()
+			}
+			worker := newExecutor(e.ctx, exec, localdb, e.txs, e.receipts)
+			for i := range jobs {
+				results[i] = worker.execTxSnapshot(exec, base, e.gas, txs[i], index+i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var receipts []*types.Receipt
+	written := make(map[string]bool)
+	for i, tx := range txs {
+		r := results[i]
+		if r.conflict(index, written) || (e.gas != nil && e.gas.exceed(r.gas)) {
+			elog.Debug("execTxsParallel re-exec", "txhash", common.ToHex(tx.Hash()), "index", index)
+			receipt, err := e.execTx(exec, tx, index)
+			if api.IsAPIEnvError(err) {
+				return nil, index, err
+			}
+			r = &parallelResult{receipt: receipt, err: err}
+		} else if r.err == nil {
+			e.applyReceipt(r.receipt)
+			if e.gas != nil {
+				e.gas.blockGas += r.gas
+			}
+		}
+		if r.err != nil {
+			receipts = append(receipts, types.NewErrReceipt(r.err))
+			continue
+		}
+		for _, kv := range r.receipt.KV {
+			written[string(kv.Key)] = true
+		}
+		receipts = append(receipts, r.receipt)
+		index++
+	}
+	return receipts, index, nil
+}
+
+//execTxSnapshot 在段开始时的状态快照上执行交易
+func (e *executor) execTxSnapshot(exec *Executor, base *StateDB, gas *gasMeter, tx *types.Transaction, index int) (r *parallelResult) {
+	r = &parallelResult{index: index}
+	defer func() {
+		if p := recover(); p != nil {
+			elog.Debug("execTxSnapshot panic", "txhash", common.ToHex(tx.Hash()), "err", p)
+			r.invalid = true
+		}
+	}()
+	snapshot := NewStateDB(exec.client, e.ctx.stateHash, e.localDB, &StateDBOption{Height: e.height}).(*StateDB)
+	snapshot.base = base
+	snapshot.reads = make(map[string]bool)
+	e.stateDB = snapshot
+	e.coinsAccount.SetDB(snapshot)
+	//更换StateDB后, 执行器需要重新设置环境
+	e.currExecTx = nil
+	e.currTxIdx = -1
+	//区块gas从段开始时计算, 加入区块时再按顺序检查
+	if gas != nil {
+		e.gas.blockGas = gas.blockGas
+	}
+	r.receipt, r.err = e.execTx(exec, tx, index)
+	if gas != nil {
+		r.gas = e.gas.blockGas - gas.blockGas
+	}
+	if api.IsAPIEnvError(r.err) {
+		r.invalid = true
+	}
+	r.reads = snapshot.reads
+	return r
+}
+
+//applyReceipt 把并行执行的结果写入状态, 和顺序执行对状态的修改一致
+func (e *executor) applyReceipt(receipt *types.Receipt) {
+	for _, kv := range receipt.KV {
+		if err := e.stateDB.Set(kv.Key, kv.Value); err != nil {
+			panic(err)
+		}
+	}
+}
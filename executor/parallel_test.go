@@ -0,0 +1,80 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newParallelMockNode(parallel bool) *testnode.Chain33Mock {
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().Consensus.Minerstart = false
+	cfg.GetModuleConfig().Exec.EnableParallelExec = parallel
+	return testnode.NewWithConfig(cfg, nil)
+}
+
+func TestExecBlockParallel(t *testing.T) {
+	seq := newParallelMockNode(false)
+	defer seq.Close()
+	par := newParallelMockNode(true)
+	defer par.Close()
+	cfg := seq.GetClient().GetConfig()
+	seq.WaitHeight(0)
+	par.WaitHeight(0)
+	block0 := seq.GetBlock(0)
+	assert.Equal(t, block0.StateHash, par.GetBlock(0).StateHash)
+
+	//同一个发送者的连续转账, 后面的交易都需要重新执行
+	var addrs []string
+	var privs []crypto.PrivKey
+	var txs []*types.Transaction
+	for i := 0; i < 8; i++ {
+		addr, priv := util.Genaddress()
+		addrs = append(addrs, addr)
+		privs = append(privs, priv)
+		txs = append(txs, util.CreateCoinsTx(cfg, seq.GetGenesisKey(), addr, 10*types.Coin))
+	}
+	block1 := util.CreateNewBlock(cfg, block0, txs)
+	detail1 := execParallelBlock(t, seq, par, block0, block1)
+
+	//不同发送者的转账相互独立, 包含余额不足和互相转账的冲突交易
+	txs = nil
+	for i := 0; i < 8; i++ {
+		txs = append(txs, util.CreateCoinsTx(cfg, privs[i], addrs[(i+1)%8], types.Coin))
+	}
+	txs = append(txs, util.CreateCoinsTx(cfg, privs[0], addrs[1], 100*types.Coin))
+	to, _ := util.Genaddress()
+	txs = append(txs, util.CreateCoinsTx(cfg, seq.GetGenesisKey(), to, types.Coin))
+	block2 := util.CreateNewBlock(cfg, detail1.Block, txs)
+	detail2 := execParallelBlock(t, seq, par, detail1.Block, block2)
+	for i := 0; i < 8; i++ {
+		assert.Equal(t, seq.GetAccount(detail2.Block.StateHash, addrs[i]).Balance,
+			par.GetAccount(detail2.Block.StateHash, addrs[i]).Balance)
+	}
+}
+
+func execParallelBlock(t *testing.T, seq, par *testnode.Chain33Mock, parent, block *types.Block) *types.BlockDetail {
+	seqBlock := types.Clone(block).(*types.Block)
+	parBlock := types.Clone(block).(*types.Block)
+	seqDetail, _, err := util.ExecBlock(seq.GetClient(), parent.StateHash, seqBlock, false, true, false)
+	require.Nil(t, err)
+	parDetail, _, err := util.ExecBlock(par.GetClient(), parent.StateHash, parBlock, false, true, false)
+	require.Nil(t, err)
+	assert.Equal(t, seqDetail.Block.StateHash, parDetail.Block.StateHash)
+	assert.Equal(t, len(seqDetail.Block.Txs), len(parDetail.Block.Txs))
+	require.Equal(t, len(seqDetail.Receipts), len(parDetail.Receipts))
+	for i := range seqDetail.Receipts {
+		assert.Equal(t, types.Encode(seqDetail.Receipts[i]), types.Encode(parDetail.Receipts[i]))
+	}
+	assert.Equal(t, len(seqDetail.KV), len(parDetail.KV))
+	return seqDetail
+}
@@ -6,6 +6,7 @@ package executor
 
 import (
 	"encoding/hex"
+	"sync"
 
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/queue"
@@ -24,6 +25,13 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	//并行执行时, 从父状态读取, 并记录读取过的key
+	base  *StateDB
+	reads map[string]bool
+	//作为父状态被并行读取时保护cache
+	mu sync.RWMutex
+	//当前交易读取状态的次数, 用于资源计量
+	nread int64
 }
 
 // StateDBOption state db option enable mvcc
@@ -107,6 +115,7 @@ func (s *StateDB) resetTx() {
 
 // Get get value from state db
 func (s *StateDB) Get(key []byte) ([]byte, error) {
+	s.nread++
 	v, err := s.get(key)
 	debugAccount("==get==", key, v)
 	return v, err
@@ -122,6 +131,15 @@ func (s *StateDB) get(key []byte) ([]byte, error) {
 	if value, ok := s.cache[skey]; ok {
 		return value, nil
 	}
+	if s.base != nil {
+		s.reads[string(key)] = true
+		value, err := s.base.getShared(key)
+		if err != nil {
+			return nil, err
+		}
+		s.cache[string(key)] = value
+		return value, nil
+	}
 	//mvcc 是有效的情况下，直接从mvcc中获取
 	if s.version >= 0 {
 		data, err := s.local.GetV(key, s.version)
@@ -155,6 +173,19 @@ func (s *StateDB) get(key []byte) ([]byte, error) {
 	return value, nil
 }
 
+//getShared 并行执行时多个StateDB共享读取父状态
+func (s *StateDB) getShared(key []byte) ([]byte, error) {
+	s.mu.RLock()
+	value, ok := s.cache[types.Bytes2Str(key)]
+	s.mu.RUnlock()
+	if ok {
+		return value, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.get(key)
+}
+
 func debugAccount(prefix string, key []byte, value []byte) {
 	//println(prefix, string(key), string(value))
 	/*
@@ -172,6 +203,7 @@ func debugAccount(prefix string, key []byte, value []byte) {
 // StartTx reset state db keys
 func (s *StateDB) StartTx() {
 	s.keys = nil
+	s.nread = 0
 }
 
 // GetSetKeys  get state db set keys
@@ -4,6 +4,7 @@
 package p2p
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/33cn/chain33/queue"
@@ -69,4 +70,13 @@ func TestEvent(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, ty, msg.Ty)
 	}
+
+	//被替换的交易不再提交到mempool, 替换的交易转发给p2p插件
+	hash := []byte("replacedtx")
+	mgr.Client.Send(mgr.Client.NewMessage("p2p", types.EventTxReplaced, &types.P2PTxReplaced{OldHash: hash, Tx: &types.Transaction{}}), false)
+	msg, ok := (<-subChan).(*queue.Message)
+	assert.True(t, ok)
+	assert.Equal(t, int64(types.EventTxReplaced), msg.Ty)
+	assert.True(t, mgr.broadcastFilter.Contains(hex.EncodeToString(hash)))
+	assert.Nil(t, mgr.PubBroadCast(hex.EncodeToString(hash), &types.Transaction{}, types.EventTx))
 }
@@ -5,6 +5,8 @@
 package p2p
 
 import (
+	"encoding/hex"
+
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
@@ -25,6 +27,8 @@ func (mgr *Manager) handleSysEvent() {
 
 		case types.EventTxBroadcast, types.EventBlockBroadcast: //广播
 			mgr.pub2All(msg)
+		case types.EventTxReplaced:
+			mgr.filterReplacedTx(msg)
 		case types.EventFetchBlocks, types.EventGetMempool, types.EventFetchBlockHeaders:
 			mgr.pub2P2P(msg, mgr.p2pCfg.Types[0])
 		case types.EventPeerInfo:
@@ -96,6 +100,17 @@ func (mgr *Manager) PubBroadCast(hash string, data interface{}, eventTy int) err
 	return err
 }
 
+// filterReplacedTx mempool中已经被替换的交易, 不再从其他节点接收, 并由各个p2p插件向其他节点转发替换的交易
+func (mgr *Manager) filterReplacedTx(msg *queue.Message) {
+	req, ok := msg.GetData().(*types.P2PTxReplaced)
+	if !ok || req.GetTx() == nil {
+		log.Error("filterReplacedTx", "receive unexpect msg", msg)
+		return
+	}
+	mgr.broadcastFilter.Add(hex.EncodeToString(req.GetOldHash()), true)
+	mgr.pub2All(msg)
+}
+
 //
 func (mgr *Manager) pub2All(msg *queue.Message) {
 
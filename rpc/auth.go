@@ -0,0 +1,231 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+/*
+api key 认证:
+1. 开启 enableAPIKey 后非本地请求需要携带 api key 或者签名的 token, 开启 apiKeyForLoopback 后本地请求也需要
+2. jrpc 使用 http 头 X-API-Key 或者 Authorization: Bearer, grpc 使用同名的 metadata
+3. token 通过名称对应到配置中的 api key, 使用该 api key 的方法范围和限流
+4. 每个 api key 一个令牌桶, jrpc 和 grpc 共用, 批量请求中的每个请求消耗一个令牌
+5. 在 ip 白名单和方法黑白名单检查之后进行, InitAPIKeys 可以在运行时重新加载配置
+*/
+
+const (
+	apiKeyHeader = "X-API-Key"
+	authHeader   = "Authorization"
+	bearerPrefix = "bearer "
+	grpcAPIKeyMD = "x-api-key"
+	grpcAuthMD   = "authorization"
+)
+
+var apiAuth = &apiKeyAuth{}
+
+type apiKey struct {
+	name    string
+	methods map[string]bool
+	rate    float64
+	burst   int
+	bucket  *tokenBucket
+}
+
+type apiKeyAuth struct {
+	mu       sync.RWMutex
+	enable   bool
+	loopback bool
+	secret   []byte
+	keys     map[string]*apiKey
+	names    map[string]*apiKey
+}
+
+// InitAPIKeys init api keys, 运行时调用会替换原来的配置, 限流参数没有修改的api key保留令牌桶的状态
+func InitAPIKeys(cfg *types.RPC) error {
+	keys := make(map[string]*apiKey)
+	names := make(map[string]*apiKey)
+	for _, item := range cfg.APIKeys {
+		if item == nil || item.Name == "" || item.Rate < 0 || item.Burst < 0 {
+			return fmt.Errorf("invalid api key config %v", item)
+		}
+		if _, ok := names[item.Name]; ok {
+			return fmt.Errorf("duplicate api key name %s", item.Name)
+		}
+		key := &apiKey{name: item.Name, rate: item.Rate, burst: item.Burst}
+		for _, method := range item.Methods {
+			if key.methods == nil {
+				key.methods = make(map[string]bool)
+			}
+			key.methods[method] = true
+		}
+		names[item.Name] = key
+		if item.Key == "" {
+			continue
+		}
+		if _, ok := keys[item.Key]; ok {
+			return fmt.Errorf("duplicate api key of %s", item.Name)
+		}
+		keys[item.Key] = key
+	}
+
+	apiAuth.mu.Lock()
+	defer apiAuth.mu.Unlock()
+	for name, key := range names {
+		if key.rate == 0 {
+			continue
+		}
+		if old, ok := apiAuth.names[name]; ok && old.rate == key.rate && old.burst == key.burst {
+			key.bucket = old.bucket
+		} else {
+			key.bucket = newTokenBucket(key.rate, key.burst)
+		}
+	}
+	apiAuth.enable = cfg.EnableAPIKey
+	apiAuth.loopback = cfg.APIKeyForLoopback
+	apiAuth.secret = []byte(cfg.TokenSecret)
+	apiAuth.keys = keys
+	apiAuth.names = names
+	log.Info("InitAPIKeys", "enable", cfg.EnableAPIKey, "keys", len(names))
+	return nil
+}
+
+//check 检查调用方是否可以调用方法, cred 为请求中携带的 api key 或者 token
+func (a *apiKeyAuth) check(ip net.IP, cred, funcName string) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if !a.enable || (ip.IsLoopback() && !a.loopback) {
+		return nil
+	}
+	key, err := a.lookup(cred)
+	if err != nil {
+		return err
+	}
+	if len(key.methods) > 0 && !key.methods["*"] && !key.methods[funcName] {
+		return fmt.Errorf("the %s method is not authorized for api key %s", funcName, key.name)
+	}
+	if key.bucket != nil && !key.bucket.allow(time.Now()) {
+		return types.ErrRateLimited
+	}
+	return nil
+}
+
+func (a *apiKeyAuth) lookup(cred string) (*apiKey, error) {
+	if cred == "" {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	if key, ok := a.keys[cred]; ok {
+		return key, nil
+	}
+	if len(a.secret) == 0 {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	token, err := rpctypes.VerifyAPIToken(a.secret, cred, types.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	//删除了配置中的api key, 对应的token也失效
+	key, ok := a.names[token.Name]
+	if !ok {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	return key, nil
+}
+
+//credential 从 api key 或者 Authorization 中获取凭证
+func credential(key, authorization string) string {
+	if key != "" {
+		return key
+	}
+	if len(authorization) > len(bearerPrefix) && strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(authorization[len(bearerPrefix):])
+	}
+	return ""
+}
+
+func httpCredential(r *http.Request) string {
+	return credential(r.Header.Get(apiKeyHeader), r.Header.Get(authHeader))
+}
+
+func grpcCredential(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	first := func(vals []string) string {
+		if len(vals) == 0 {
+			return ""
+		}
+		return vals[0]
+	}
+	return credential(first(md.Get(grpcAPIKeyMD)), first(md.Get(grpcAuthMD)))
+}
+
+//checkGrpcAPIKey 检查 grpc 请求的 api key, 返回带有 grpc 错误码的错误
+func checkGrpcAPIKey(ctx context.Context, ip net.IP, funcName string) error {
+	err := apiAuth.check(ip, grpcCredential(ctx), funcName)
+	switch err {
+	case nil:
+		return nil
+	case types.ErrRateLimited:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case types.ErrAPIKeyInvalid, types.ErrAPITokenExpired:
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return status.Error(codes.PermissionDenied, err.Error())
+}
+
+//authErrCode api key 认证失败时 JSON-RPC 2.0 的错误码
+func authErrCode(err error) int {
+	if err == types.ErrRateLimited {
+		return rpctypes.ErrCodeRateLimited
+	}
+	return rpctypes.ErrCodeUnauthorized
+}
+
+//tokenBucket 令牌桶限流, 每秒补充 rate 个令牌, 最多 burst 个
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	b := float64(burst)
+	if burst == 0 {
+		b = math.Max(1, math.Ceil(rate))
+	}
+	return &tokenBucket{rate: rate, burst: b, tokens: b, last: time.Now()}
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
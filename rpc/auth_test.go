@@ -0,0 +1,136 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func newTestAuthCfg() *types.RPC {
+	return &types.RPC{
+		EnableAPIKey: true,
+		TokenSecret:  "secret",
+		APIKeys: []*types.RPCAPIKey{
+			{Name: "all", Key: "key-all"},
+			{Name: "partner", Key: "key-partner", Methods: []string{"Version", "IsSync"}, Rate: 1, Burst: 2},
+			{Name: "token", Methods: []string{"Version"}},
+		},
+	}
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	defer InitAPIKeys(&types.RPC{})
+	require.Nil(t, InitAPIKeys(newTestAuthCfg()))
+	remote := net.ParseIP("192.168.1.2")
+
+	//本地请求默认不需要认证
+	assert.Nil(t, apiAuth.check(net.ParseIP("127.0.0.1"), "", "GetNetInfo"))
+	assert.Equal(t, types.ErrAPIKeyInvalid, apiAuth.check(remote, "", "Version"))
+	assert.Equal(t, types.ErrAPIKeyInvalid, apiAuth.check(remote, "key-none", "Version"))
+	assert.Nil(t, apiAuth.check(remote, "key-all", "GetNetInfo"))
+	assert.NotNil(t, apiAuth.check(remote, "key-partner", "GetNetInfo"))
+
+	//令牌桶容量为2, 之后每秒补充一个
+	assert.Nil(t, apiAuth.check(remote, "key-partner", "Version"))
+	assert.Nil(t, apiAuth.check(remote, "key-partner", "IsSync"))
+	assert.Equal(t, types.ErrRateLimited, apiAuth.check(remote, "key-partner", "Version"))
+	bucket := apiAuth.names["partner"].bucket
+	assert.True(t, bucket.allow(time.Now().Add(time.Second)))
+	assert.False(t, bucket.allow(time.Now().Add(time.Second)))
+
+	//token
+	token, err := rpctypes.SignAPIToken([]byte("secret"), &rpctypes.APIToken{Name: "token", Expire: types.Now().Unix() + 100})
+	require.Nil(t, err)
+	assert.Nil(t, apiAuth.check(remote, token, "Version"))
+	assert.NotNil(t, apiAuth.check(remote, token, "IsSync"))
+	expired, _ := rpctypes.SignAPIToken([]byte("secret"), &rpctypes.APIToken{Name: "token", Expire: types.Now().Unix() - 1})
+	assert.Equal(t, types.ErrAPITokenExpired, apiAuth.check(remote, expired, "Version"))
+	other, _ := rpctypes.SignAPIToken([]byte("other"), &rpctypes.APIToken{Name: "token"})
+	assert.Equal(t, types.ErrAPIKeyInvalid, apiAuth.check(remote, other, "Version"))
+	unknown, _ := rpctypes.SignAPIToken([]byte("secret"), &rpctypes.APIToken{Name: "unknown"})
+	assert.Equal(t, types.ErrAPIKeyInvalid, apiAuth.check(remote, unknown, "Version"))
+
+	//重新加载后限流参数没有变化的保留令牌桶, 删除的api key失效
+	cfg := newTestAuthCfg()
+	cfg.APIKeys = cfg.APIKeys[1:]
+	cfg.APIKeyForLoopback = true
+	require.Nil(t, InitAPIKeys(cfg))
+	assert.Equal(t, bucket, apiAuth.names["partner"].bucket)
+	assert.Equal(t, types.ErrAPIKeyInvalid, apiAuth.check(remote, "key-all", "Version"))
+	assert.Equal(t, types.ErrAPIKeyInvalid, apiAuth.check(net.ParseIP("127.0.0.1"), "", "Version"))
+	cfg.APIKeys[0].Rate = 10
+	require.Nil(t, InitAPIKeys(cfg))
+	assert.NotEqual(t, bucket, apiAuth.names["partner"].bucket)
+
+	//错误的配置不会替换原来的配置
+	cfg.APIKeys = append(cfg.APIKeys, &types.RPCAPIKey{Name: "partner"})
+	assert.NotNil(t, InitAPIKeys(cfg))
+	assert.Equal(t, 2, len(apiAuth.names))
+
+	assert.Equal(t, "abc", credential("", "Bearer abc"))
+	assert.Equal(t, "key", credential("key", "Bearer abc"))
+	assert.Equal(t, "", credential("", "Basic abc"))
+}
+
+func TestAPIKeyJSONRPC2(t *testing.T) {
+	server, _ := newTestJSONRPC2Server(t, "127.0.0.1:8205")
+	defer delete(jrpcFuncBlacklist, "GetNetInfo")
+	defer InitAPIKeys(&types.RPC{})
+	require.Nil(t, InitAPIKeys(newTestAuthCfg()))
+	remote := net.ParseIP("192.168.1.2")
+
+	batch := `[
+		{"jsonrpc":"2.0","method":"Chain33.Version","id":1},
+		{"jsonrpc":"2.0","method":"Chain33.IsSync","id":2},
+		{"jsonrpc":"2.0","method":"Chain33.Version","id":3},
+		{"jsonrpc":"2.0","method":"Chain33.GetNetInfo","params":[{}],"id":4}
+	]`
+	resps := server.handleJSONRPC2([]byte(batch), remote, "key-partner").([]*rpctypes.JSONRPC2Response)
+	require.Equal(t, 4, len(resps))
+	assert.Nil(t, resps[0].Error)
+	assert.Nil(t, resps[1].Error)
+	assert.Equal(t, rpctypes.ErrCodeRateLimited, resps[2].Error.Code)
+	//黑名单先于api key检查
+	assert.Equal(t, rpctypes.ErrCodeUnauthorized, resps[3].Error.Code)
+
+	resp := server.handleJSONRPC2([]byte(`{"jsonrpc":"2.0","method":"Chain33.Version","id":1}`), remote, "").(*rpctypes.JSONRPC2Response)
+	assert.Equal(t, rpctypes.ErrCodeUnauthorized, resp.Error.Code)
+	assert.Equal(t, types.ErrAPIKeyInvalid.Error(), resp.Error.Message)
+}
+
+func TestAPIKeyGrpcAuth(t *testing.T) {
+	defer InitAPIKeys(&types.RPC{})
+	rpcCfg = &types.RPC{Whitelist: []string{"127.0.0.1"}, GrpcFuncWhitelist: []string{"*"}}
+	InitCfg(rpcCfg)
+	require.Nil(t, InitAPIKeys(newTestAuthCfg()))
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8802}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	assert.Nil(t, auth(ctx, "/types.Chain33/GetNetInfo"))
+
+	cfg := newTestAuthCfg()
+	cfg.APIKeyForLoopback = true
+	require.Nil(t, InitAPIKeys(cfg))
+	err := auth(ctx, "/types.Chain33/Version")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	keyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "key-partner"))
+	assert.Nil(t, auth(keyCtx, "/types.Chain33/Version"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(auth(keyCtx, "/types.Chain33/GetNetInfo")))
+	assert.Nil(t, auth(keyCtx, "/types.Chain33/IsSync"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(auth(keyCtx, "/types.Chain33/Version")))
+	bearerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer key-all"))
+	assert.Nil(t, auth(bearerCtx, "/types.Chain33/GetNetInfo"))
+}
@@ -7,6 +7,7 @@ package rpc
 
 import (
 	"encoding/hex"
+	"sync"
 	"time"
 
 	"github.com/33cn/chain33/account"
@@ -414,3 +415,90 @@ func (c *channelClient) GetExecBalance(in *types.ReqGetExecBalance) (*types.Repl
 	}
 	return resp, nil
 }
+
+//simulateTxLimit 模拟执行交易需要完整执行交易, 默认关闭, 开启后按照配置限流
+type simulateTxLimit struct {
+	mu     sync.RWMutex
+	enable bool
+	bucket *tokenBucket
+}
+
+var simulateTx = &simulateTxLimit{}
+
+// InitSimulateTx init simulate tx switch and rate limit
+func InitSimulateTx(cfg *types.RPC) {
+	simulateTx.mu.Lock()
+	defer simulateTx.mu.Unlock()
+	simulateTx.enable = cfg.EnableSimulateTx
+	simulateTx.bucket = nil
+	if cfg.SimulateTxRate > 0 {
+		simulateTx.bucket = newTokenBucket(cfg.SimulateTxRate, cfg.SimulateTxBurst)
+	}
+}
+
+func (s *simulateTxLimit) check() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.enable {
+		return types.ErrNotAllow
+	}
+	if s.bucket != nil && !s.bucket.allow(time.Now()) {
+		return types.ErrRateLimited
+	}
+	return nil
+}
+
+// SimulateTransaction 在最新区块的状态上模拟执行交易, 执行结果不会提交, 也不计算执行后的状态哈希
+func (c *channelClient) SimulateTransaction(in *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	if err := simulateTx.check(); err != nil {
+		return nil, err
+	}
+	if in == nil || in.Tx == nil {
+		return nil, types.ErrInvalidParam
+	}
+	tx := in.Tx.Clone()
+	if tx.Signature == nil {
+		//未签名的交易用公钥确定发送地址
+		if len(in.Pubkey) == 0 {
+			return nil, types.ErrInvalidParam
+		}
+		tx.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: in.Pubkey}
+	}
+	cfg := c.GetConfig()
+	if tx.Fee == 0 {
+		fee, err := tx.GetRealFee(cfg.GetMinTxFeeRate())
+		if err != nil {
+			return nil, err
+		}
+		tx.Fee = fee
+	}
+	header, err := c.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	height := header.Height + 1
+	list := &types.ExecTxList{
+		StateHash:  header.StateHash,
+		ParentHash: header.Hash,
+		MainHash:   header.Hash,
+		MainHeight: height,
+		Txs:        []*types.Transaction{tx},
+		BlockTime:  types.Now().Unix(),
+		Height:     height,
+		Difficulty: uint64(header.Difficulty),
+	}
+	receipts, err := c.ExecTxList(list)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts.Receipts) != 1 {
+		return nil, types.ErrInvalidParam
+	}
+	receipt := receipts.Receipts[0]
+	reply := &types.ReplySimulateTx{Receipt: receipt, Height: height}
+	if receipt.Ty == types.ExecErr {
+		return reply, nil
+	}
+	reply.Fee = tx.Fee
+	return reply, nil
+}
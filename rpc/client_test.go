@@ -434,3 +434,54 @@ func TestClientReWriteRawTx(t *testing.T) {
 		}
 	}
 }
+
+func TestChannelClientSimulateTransaction(t *testing.T) {
+	client := newTestChannelClient()
+	cfg := client.GetConfig()
+	api := client.QueueProtocolAPI.(*mocks.QueueProtocolAPI)
+	//默认关闭
+	InitSimulateTx(&types.RPC{})
+	_, err := client.SimulateTransaction(nil)
+	assert.Equal(t, types.ErrNotAllow, err)
+	InitSimulateTx(&types.RPC{EnableSimulateTx: true})
+	defer InitSimulateTx(&types.RPC{})
+	_, err = client.SimulateTransaction(nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("none"), To: "1MY4pMgjpS2vWiaSDZasRhN47pcwEire32"}
+	//未签名也没有公钥
+	_, err = client.SimulateTransaction(&types.ReqSimulateTx{Tx: tx})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	pubkey, _ := hex.DecodeString("02504fa1c28caaf1d5a20fefb87c50a49724ff401043420cb3ba271997eb5a4387")
+	header := &types.Header{Height: 10, StateHash: []byte("state"), Hash: []byte("hash")}
+	api.On("GetLastHeader").Return(header, nil)
+	receipt := &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{{Key: []byte("k"), Value: []byte("v")}}}
+	api.On("ExecTxList", mock.Anything).Return(&types.Receipts{Receipts: []*types.Receipt{receipt}}, nil).Once()
+	reply, err := client.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, Pubkey: pubkey})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), reply.Height)
+	assert.Equal(t, receipt, reply.Receipt)
+	realFee, _ := tx.GetRealFee(cfg.GetMinTxFeeRate())
+	assert.True(t, reply.Fee >= realFee)
+	//原交易不修改
+	assert.Nil(t, tx.Signature)
+	assert.Equal(t, int64(0), tx.Fee)
+	//不修改store中的状态
+	api.AssertNotCalled(t, "StoreMemSet", mock.Anything)
+	api.AssertNotCalled(t, "StoreRollback", mock.Anything)
+
+	//交易执行错误不扣除手续费
+	api.On("ExecTxList", mock.Anything).Return(&types.Receipts{Receipts: []*types.Receipt{types.NewErrReceipt(types.ErrNoBalance)}}, nil).Once()
+	reply, err = client.SimulateTransaction(&types.ReqSimulateTx{Tx: tx, Pubkey: pubkey})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecErr), reply.Receipt.Ty)
+	assert.Equal(t, int64(0), reply.Fee)
+
+	//限流
+	InitSimulateTx(&types.RPC{EnableSimulateTx: true, SimulateTxRate: 0.001, SimulateTxBurst: 1})
+	_, err = client.SimulateTransaction(&types.ReqSimulateTx{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = client.SimulateTransaction(&types.ReqSimulateTx{})
+	assert.Equal(t, types.ErrRateLimited, err)
+}
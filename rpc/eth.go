@@ -0,0 +1,355 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/version"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+以太坊风格的 JSON-RPC 接口, 开启 enableEthRPC 后使用 JSON-RPC 2.0 调用:
+1. ethMethods 中的方法转换为 Eth 的方法, 参数数组原样传给方法, 方法名也用于黑白名单和 api key 的检查
+2. 地址使用 hash160 的十六进制表示, 参数中的地址也可以使用 chain33 地址
+3. 金额按照 18 位小数表示, 交易手续费表示为 gas 为 1, gasPrice 为手续费
+4. eth_sendRawTransaction 的参数为十六进制编码的 chain33 交易
+*/
+
+var ethMethods = map[string]string{
+	"eth_chainId":               "Eth.ChainID",
+	"net_version":               "Eth.NetVersion",
+	"web3_clientVersion":        "Eth.ClientVersion",
+	"eth_blockNumber":           "Eth.BlockNumber",
+	"eth_getBlockByNumber":      "Eth.GetBlockByNumber",
+	"eth_getTransactionReceipt": "Eth.GetTransactionReceipt",
+	"eth_getBalance":            "Eth.GetBalance",
+	"eth_sendRawTransaction":    "Eth.SendRawTransaction",
+}
+
+const (
+	ethUnclesHash = "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"
+	ethNonce      = "0x0000000000000000"
+	//blockchain 中找不到交易时返回的错误
+	errTxNotExist = "tx not exist"
+)
+
+var (
+	ethZeroHash    = common.ToHex(make([]byte, 32))
+	ethZeroAddress = common.ToHex(make([]byte, 20))
+	ethEmptyBloom  = common.ToHex(make([]byte, 256))
+	//chain33 金额的最小单位对应的 wei
+	ethWeiPerUnit = big.NewInt(1e18 / types.Coin)
+)
+
+// Eth 以太坊风格的rpc接口
+type Eth struct {
+	c       *Chain33
+	chainID int64
+}
+
+// ChainID eth_chainId
+func (e *Eth) ChainID(in []json.RawMessage, result *interface{}) error {
+	*result = ethQuantity(e.chainID)
+	return nil
+}
+
+// NetVersion net_version
+func (e *Eth) NetVersion(in []json.RawMessage, result *interface{}) error {
+	*result = strconv.FormatInt(e.chainID, 10)
+	return nil
+}
+
+// ClientVersion web3_clientVersion
+func (e *Eth) ClientVersion(in []json.RawMessage, result *interface{}) error {
+	*result = "chain33/" + version.GetVersion()
+	return nil
+}
+
+// BlockNumber eth_blockNumber
+func (e *Eth) BlockNumber(in []json.RawMessage, result *interface{}) error {
+	header, err := e.c.cli.GetLastHeader()
+	if err != nil {
+		return err
+	}
+	*result = ethQuantity(header.Height)
+	return nil
+}
+
+// GetBlockByNumber eth_getBlockByNumber, 区块不存在时返回null
+func (e *Eth) GetBlockByNumber(in []json.RawMessage, result *interface{}) error {
+	if len(in) == 0 {
+		return types.ErrInvalidParam
+	}
+	var full bool
+	if len(in) > 1 {
+		if err := json.Unmarshal(in[1], &full); err != nil {
+			return types.ErrInvalidParam
+		}
+	}
+	block, err := e.getBlock(in[0])
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		*result = nil
+		return nil
+	}
+	*result = e.fmtBlock(block, full)
+	return nil
+}
+
+// GetTransactionReceipt eth_getTransactionReceipt, 交易没有打包时返回null
+func (e *Eth) GetTransactionReceipt(in []json.RawMessage, result *interface{}) error {
+	if len(in) == 0 {
+		return types.ErrInvalidParam
+	}
+	var hash string
+	if err := json.Unmarshal(in[0], &hash); err != nil {
+		return types.ErrInvalidParam
+	}
+	txHash, err := common.FromHex(hash)
+	if err != nil || len(txHash) != 32 {
+		return types.ErrInvalidParam
+	}
+	detail, err := e.c.cli.QueryTx(&types.ReqHash{Hash: txHash})
+	if err != nil {
+		if err.Error() == errTxNotExist {
+			*result = nil
+			return nil
+		}
+		return err
+	}
+	blockHash, err := e.c.cli.GetBlockHash(&types.ReqInt{Height: detail.Height})
+	if err != nil {
+		return err
+	}
+	tx := detail.GetTx()
+	receipt := &rpctypes.EthReceipt{
+		TransactionHash:   common.ToHex(txHash),
+		TransactionIndex:  ethQuantity(detail.Index),
+		BlockHash:         common.ToHex(blockHash.Hash),
+		BlockNumber:       ethQuantity(detail.Height),
+		From:              ethAddress(tx.From()),
+		To:                ethAddressPtr(tx.GetRealToAddr()),
+		CumulativeGasUsed: ethQuantity(detail.Index + 1),
+		GasUsed:           "0x1",
+		EffectiveGasPrice: ethWei(tx.Fee),
+		Logs:              []*rpctypes.EthLog{},
+		LogsBloom:         ethEmptyBloom,
+		Type:              "0x0",
+		Status:            "0x0",
+	}
+	if detail.GetReceipt().GetTy() == types.ExecOk {
+		receipt.Status = "0x1"
+	}
+	execAddr := ethAddress(address.ExecAddress(string(tx.Execer)))
+	for i, l := range detail.GetReceipt().GetLogs() {
+		topic := make([]byte, 32)
+		binary.BigEndian.PutUint32(topic[28:], uint32(l.Ty))
+		receipt.Logs = append(receipt.Logs, &rpctypes.EthLog{
+			Address:          execAddr,
+			Topics:           []string{common.ToHex(topic)},
+			Data:             common.ToHex(l.Log),
+			BlockNumber:      receipt.BlockNumber,
+			BlockHash:        receipt.BlockHash,
+			TransactionHash:  receipt.TransactionHash,
+			TransactionIndex: receipt.TransactionIndex,
+			LogIndex:         ethQuantity(int64(i)),
+		})
+	}
+	*result = receipt
+	return nil
+}
+
+// GetBalance eth_getBalance, 返回coins的可用余额
+func (e *Eth) GetBalance(in []json.RawMessage, result *interface{}) error {
+	if len(in) == 0 {
+		return types.ErrInvalidParam
+	}
+	var addr string
+	if err := json.Unmarshal(in[0], &addr); err != nil {
+		return types.ErrInvalidParam
+	}
+	addr, err := chain33Address(addr)
+	if err != nil {
+		return err
+	}
+	req := &types.ReqBalance{Addresses: []string{addr}}
+	if len(in) > 1 && !isEthLatest(in[1]) {
+		block, err := e.getBlock(in[1])
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			return types.ErrBlockNotFound
+		}
+		req.StateHash = common.ToHex(block.StateHash)
+	}
+	accounts, err := e.c.cli.GetBalance(req)
+	if err != nil {
+		return err
+	}
+	var balance int64
+	if len(accounts) > 0 {
+		balance = accounts[0].Balance
+	}
+	*result = ethWei(balance)
+	return nil
+}
+
+// SendRawTransaction eth_sendRawTransaction, 参数为十六进制编码的chain33交易
+func (e *Eth) SendRawTransaction(in []json.RawMessage, result *interface{}) error {
+	if len(in) == 0 {
+		return types.ErrInvalidParam
+	}
+	var data string
+	if err := json.Unmarshal(in[0], &data); err != nil {
+		return types.ErrInvalidParam
+	}
+	return e.c.SendTransaction(rpctypes.RawParm{Data: data}, result)
+}
+
+//getBlock 获取区块编号或者标签对应的区块, 区块不存在时返回nil
+func (e *Eth) getBlock(param json.RawMessage) (*types.Block, error) {
+	var tag string
+	if err := json.Unmarshal(param, &tag); err != nil {
+		return nil, types.ErrInvalidParam
+	}
+	header, err := e.c.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	height := header.Height
+	switch tag {
+	case "latest", "pending", "safe", "finalized":
+	case "earliest":
+		height = 0
+	default:
+		if !strings.HasPrefix(tag, "0x") {
+			return nil, types.ErrInvalidParam
+		}
+		height, err = strconv.ParseInt(tag[2:], 16, 64)
+		if err != nil {
+			return nil, types.ErrInvalidParam
+		}
+		if height > header.Height {
+			return nil, nil
+		}
+	}
+	blocks, err := e.c.cli.GetBlocks(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.GetItems()) == 0 {
+		return nil, nil
+	}
+	return blocks.Items[0].Block, nil
+}
+
+func (e *Eth) fmtBlock(block *types.Block, full bool) *rpctypes.EthBlock {
+	cfg := e.c.cli.GetConfig()
+	eb := &rpctypes.EthBlock{
+		Number:           ethQuantity(block.Height),
+		Hash:             common.ToHex(block.Hash(cfg)),
+		ParentHash:       common.ToHex(block.ParentHash),
+		Nonce:            ethNonce,
+		Sha3Uncles:       ethUnclesHash,
+		LogsBloom:        ethEmptyBloom,
+		TransactionsRoot: common.ToHex(block.TxHash),
+		StateRoot:        common.ToHex(block.StateHash),
+		ReceiptsRoot:     ethZeroHash,
+		Miner:            ethZeroAddress,
+		Difficulty:       ethQuantity(int64(block.Difficulty)),
+		TotalDifficulty:  "0x0",
+		ExtraData:        "0x",
+		Size:             ethQuantity(int64(types.Size(block))),
+		GasLimit:         ethQuantity(cfg.GetP(block.Height).MaxTxNumber),
+		GasUsed:          ethQuantity(int64(len(block.Txs))),
+		Timestamp:        ethQuantity(block.BlockTime),
+		Transactions:     make([]interface{}, 0, len(block.Txs)),
+		Uncles:           []string{},
+	}
+	if len(block.GetSignature().GetPubkey()) > 0 {
+		eb.Miner = ethAddress(address.PubKeyToAddr(block.Signature.Pubkey))
+	}
+	for i, tx := range block.Txs {
+		if !full {
+			eb.Transactions = append(eb.Transactions, common.ToHex(tx.Hash()))
+			continue
+		}
+		amount, _ := tx.Amount()
+		eb.Transactions = append(eb.Transactions, &rpctypes.EthTransaction{
+			Hash:             common.ToHex(tx.Hash()),
+			Nonce:            ethQuantity(tx.Nonce),
+			BlockHash:        eb.Hash,
+			BlockNumber:      eb.Number,
+			TransactionIndex: ethQuantity(int64(i)),
+			From:             ethAddress(tx.From()),
+			To:               ethAddressPtr(tx.GetRealToAddr()),
+			Value:            ethWei(amount),
+			Gas:              "0x1",
+			GasPrice:         ethWei(tx.Fee),
+			Input:            common.ToHex(tx.Payload),
+		})
+	}
+	return eb
+}
+
+func isEthLatest(param json.RawMessage) bool {
+	var tag string
+	return json.Unmarshal(param, &tag) == nil && (tag == "latest" || tag == "pending")
+}
+
+//ethQuantity 十六进制的数量, nonce 可能为负数, 按照 uint64 表示
+func ethQuantity(n int64) string {
+	return "0x" + strconv.FormatUint(uint64(n), 16)
+}
+
+func ethWei(n int64) string {
+	return "0x" + new(big.Int).Mul(big.NewInt(n), ethWeiPerUnit).Text(16)
+}
+
+//ethAddress chain33 地址转换为 hash160 的十六进制, 不能解析的地址返回零地址
+func ethAddress(addr string) string {
+	a, err := address.NewAddrFromString(addr)
+	if err != nil || a == nil {
+		return ethZeroAddress
+	}
+	return common.ToHex(a.Hash160[:])
+}
+
+func ethAddressPtr(addr string) *string {
+	if addr == "" {
+		return nil
+	}
+	hex := ethAddress(addr)
+	return &hex
+}
+
+//chain33Address 十六进制的 hash160 转换为 chain33 地址, 也可以直接使用 chain33 地址
+func chain33Address(addr string) (string, error) {
+	if strings.HasPrefix(addr, "0x") || strings.HasPrefix(addr, "0X") {
+		hash, err := common.FromHex(addr)
+		if err != nil || len(hash) != 20 {
+			return "", types.ErrInvalidAddress
+		}
+		a := &address.Address{Version: address.NormalVer}
+		a.SetBytes(hash)
+		return a.String(), nil
+	}
+	if address.CheckAddress(addr) != nil && address.CheckMultiSignAddress(addr) != nil {
+		return "", types.ErrInvalidAddress
+	}
+	return addr, nil
+}
@@ -0,0 +1,113 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	qmocks "github.com/33cn/chain33/queue/mocks"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthRPC(t *testing.T) {
+	rpcCfg = &types.RPC{JrpcBindAddr: "127.0.0.1:8206", Whitelist: []string{"127.0.0.1"}, EnableEthRPC: true, EthChainID: 33}
+	InitCfg(rpcCfg)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	qm := &qmocks.Client{}
+	qm.On("GetConfig", mock.Anything).Return(cfg)
+	server := NewJSONRPCServer(qm, api)
+	require.NotNil(t, server)
+	assert.True(t, server.eth)
+
+	addr := "1Jn2qu84Z1SUUosWjySggBS9pKWdAP3tZt"
+	a, err := address.NewAddrFromString(addr)
+	require.Nil(t, err)
+	hexAddr := common.ToHex(a.Hash160[:])
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("none"), Fee: 100000, Nonce: -1, To: addr}
+	block := &types.Block{Height: 10, BlockTime: 1600000000, StateHash: []byte("state10"), Txs: []*types.Transaction{tx}}
+	api.On("GetLastHeader").Return(&types.Header{Height: 12, StateHash: []byte("state12")}, nil)
+	api.On("GetBlocks", &types.ReqBlocks{Start: 10, End: 10}).Return(&types.BlockDetails{Items: []*types.BlockDetail{{Block: block}}}, nil)
+	api.On("QueryTx", &types.ReqHash{Hash: tx.Hash()}).Return(&types.TransactionDetail{
+		Tx: tx, Height: 10, Index: 0,
+		Receipt: &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: 2, Log: []byte("log")}}},
+	}, nil)
+	api.On("QueryTx", mock.Anything).Return(nil, errors.New(errTxNotExist))
+	api.On("GetBlockHash", &types.ReqInt{Height: 10}).Return(&types.ReplyHash{Hash: block.Hash(cfg)}, nil)
+	acc := types.Encode(&types.Account{Addr: addr, Balance: 5 * types.Coin})
+	api.On("StoreGet", mock.Anything).Return(&types.StoreReplyValue{Values: [][]byte{acc}}, nil)
+	api.On("SendTx", mock.Anything).Return(&types.Reply{IsOk: true, Msg: tx.Hash()}, nil)
+
+	call := func(body string) *rpctypes.JSONRPC2Response {
+		resp := server.handleJSONRPC2([]byte(body), net.ParseIP("127.0.0.1"), "")
+		require.NotNil(t, resp)
+		return resp.(*rpctypes.JSONRPC2Response)
+	}
+	resp := call(`{"jsonrpc":"2.0","method":"eth_chainId","id":1}`)
+	assert.Equal(t, `"0x21"`, string(resp.Result))
+	resp = call(`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`)
+	assert.Equal(t, `"0xc"`, string(resp.Result))
+
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0xa",true],"id":1}`)
+	require.Nil(t, resp.Error)
+	var eb struct {
+		Number       string                     `json:"number"`
+		Hash         string                     `json:"hash"`
+		Transactions []*rpctypes.EthTransaction `json:"transactions"`
+	}
+	require.Nil(t, json.Unmarshal(resp.Result, &eb))
+	assert.Equal(t, "0xa", eb.Number)
+	assert.Equal(t, common.ToHex(block.Hash(cfg)), eb.Hash)
+	require.Equal(t, 1, len(eb.Transactions))
+	assert.Equal(t, hexAddr, *eb.Transactions[0].To)
+	assert.Equal(t, "0xffffffffffffffff", eb.Transactions[0].Nonce)
+	//手续费 0.001 换算为 wei
+	assert.Equal(t, "0x38d7ea4c68000", eb.Transactions[0].GasPrice)
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10",false],"id":1}`)
+	assert.Equal(t, "null", string(resp.Result))
+
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["` + common.ToHex(tx.Hash()) + `"],"id":1}`)
+	require.Nil(t, resp.Error)
+	var receipt rpctypes.EthReceipt
+	require.Nil(t, json.Unmarshal(resp.Result, &receipt))
+	assert.Equal(t, "0x1", receipt.Status)
+	assert.Equal(t, eb.Hash, receipt.BlockHash)
+	require.Equal(t, 1, len(receipt.Logs))
+	assert.Equal(t, common.ToHex([]byte("log")), receipt.Logs[0].Data)
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["` + common.ToHex(common.Sha256([]byte("none"))) + `"],"id":1}`)
+	assert.Equal(t, "null", string(resp.Result))
+
+	//5 个币换算为 5e18 wei
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getBalance","params":["` + hexAddr + `","0xa"],"id":1}`)
+	assert.Equal(t, `"0x4563918244f40000"`, string(resp.Result))
+	api.AssertCalled(t, "StoreGet", mock.MatchedBy(func(get *types.StoreGet) bool { return string(get.StateHash) == "state10" }))
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getBalance","params":["` + addr + `","latest"],"id":1}`)
+	assert.Equal(t, `"0x4563918244f40000"`, string(resp.Result))
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getBalance","params":["0x1234","latest"],"id":1}`)
+	assert.Equal(t, rpctypes.ErrCodeServer, resp.Error.Code)
+
+	resp = call(`{"jsonrpc":"2.0","method":"eth_sendRawTransaction","params":["` + common.ToHex(types.Encode(tx)) + `"],"id":1}`)
+	assert.Equal(t, `"`+common.ToHex(tx.Hash())+`"`, string(resp.Result))
+
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":{},"id":1}`)
+	assert.Equal(t, rpctypes.ErrCodeInvalidParams, resp.Error.Code)
+	resp = call(`{"jsonrpc":"2.0","method":"eth_getLogs","params":[],"id":1}`)
+	assert.Equal(t, rpctypes.ErrCodeMethodNotFound, resp.Error.Code)
+
+	addr2, err := chain33Address(hexAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, addr, addr2)
+}
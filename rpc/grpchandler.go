@@ -19,6 +19,16 @@ func (g *Grpc) SendTransaction(ctx context.Context, in *pb.Transaction) (*pb.Rep
 	return g.cli.SendTx(in)
 }
 
+// SimulateTransaction 模拟执行交易, 返回回执但不提交
+func (g *Grpc) SimulateTransaction(ctx context.Context, in *pb.ReqSimulateTx) (*pb.ReplySimulateTx, error) {
+	return g.cli.SimulateTransaction(in)
+}
+
+// GetStateProof 获取指定状态下keys的value及mavl证明
+func (g *Grpc) GetStateProof(ctx context.Context, in *pb.StoreGet) (*pb.ReplyStateProof, error) {
+	return g.cli.StoreGetProof(in)
+}
+
 // CreateNoBalanceTxs create multiple transaction with no balance
 func (g *Grpc) CreateNoBalanceTxs(ctx context.Context, in *pb.NoBalanceTxs) (*pb.ReplySignRawTx, error) {
 	reply, err := g.cli.CreateNoBalanceTxs(in)
@@ -189,6 +199,15 @@ func (g *Grpc) ImportPrivkey(ctx context.Context, in *pb.ReqWalletImportPrivkey)
 	return reply.(*pb.WalletAccount), nil
 }
 
+// ImportWatchOnly import watch-only account by address or public key
+func (g *Grpc) ImportWatchOnly(ctx context.Context, in *pb.ReqWalletImportWatchOnly) (*pb.WalletAccount, error) {
+	reply, err := g.cli.ExecWalletFunc("wallet", "WalletImportWatchOnly", in)
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*pb.WalletAccount), nil
+}
+
 // SendToAddress send to address of coins
 func (g *Grpc) SendToAddress(ctx context.Context, in *pb.ReqWalletSendToAddress) (*pb.ReplyHash, error) {
 	reply, err := g.cli.ExecWalletFunc("wallet", "WalletSendToAddress", in)
@@ -401,6 +420,42 @@ func (g *Grpc) ImportPrivkeysFile(ctx context.Context, in *pb.ReqPrivkeysFile) (
 	return reply.(*pb.Reply), nil
 }
 
+// ExportKeystore exports private keys to keystore v3 files.
+func (g *Grpc) ExportKeystore(ctx context.Context, in *pb.ReqKeystoreFile) (*pb.ReplyStrings, error) {
+	reply, err := g.cli.ExecWalletFunc("wallet", "ExportKeystore", in)
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*pb.ReplyStrings), nil
+}
+
+// ImportKeystore imports private keys from keystore v3 files.
+func (g *Grpc) ImportKeystore(ctx context.Context, in *pb.ReqKeystoreFile) (*pb.ReplyImportKeystore, error) {
+	reply, err := g.cli.ExecWalletFunc("wallet", "ImportKeystore", in)
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*pb.ReplyImportKeystore), nil
+}
+
+// RecoverAccounts recovers used accounts from seed.
+func (g *Grpc) RecoverAccounts(ctx context.Context, in *pb.ReqRecoverAccounts) (*pb.HDAccounts, error) {
+	reply, err := g.cli.ExecWalletFunc("wallet", "RecoverAccounts", in)
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*pb.HDAccounts), nil
+}
+
+// GetHDAccounts gets accounts generated from seed.
+func (g *Grpc) GetHDAccounts(ctx context.Context, in *pb.ReqNil) (*pb.HDAccounts, error) {
+	reply, err := g.cli.ExecWalletFunc("wallet", "GetHDAccounts", in)
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*pb.HDAccounts), nil
+}
+
 // Version version
 func (g *Grpc) Version(ctx context.Context, in *pb.ReqNil) (*pb.VersionInfo, error) {
 
@@ -520,3 +575,31 @@ func (g *Grpc) GetAccount(ctx context.Context, in *pb.ReqGetAccount) (*pb.Wallet
 
 	return acc.(*pb.WalletAccount), nil
 }
+
+// SubscribeBlockSeqs 按sequence顺序推送区块, start小于0时从最新的sequence之后开始
+func (g *Grpc) SubscribeBlockSeqs(in *pb.ReqSubscribeBlockSeqs, stream pb.Chain33_SubscribeBlockSeqsServer) error {
+	cursor, err := g.hub.startCursor(in.GetStart())
+	if err != nil {
+		return err
+	}
+	return g.hub.run(stream.Context().Done(), cursor, stream.Send)
+}
+
+// SubscribeTxReceipts 按sequence顺序推送满足合约名或地址条件的交易及回执
+func (g *Grpc) SubscribeTxReceipts(in *pb.ReqSubscribeTxReceipts, stream pb.Chain33_SubscribeTxReceiptsServer) error {
+	filter := newTxFilter(in.GetContract(), in.GetAddress())
+	if filter.isEmpty() {
+		return pb.ErrInvalidParam
+	}
+	cursor, err := g.hub.startCursor(in.GetStart())
+	if err != nil {
+		return err
+	}
+	return g.hub.run(stream.Context().Done(), cursor, func(blockSeq *pb.BlockSeq) error {
+		txReceipts := filterTxReceipts(blockSeq, filter)
+		if txReceipts == nil {
+			return nil
+		}
+		return stream.Send(txReceipts)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"strings"
+	"time"
 
 	"github.com/33cn/chain33/client/mocks"
 	"github.com/33cn/chain33/common"
@@ -19,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 )
 
@@ -146,6 +148,23 @@ func TestGetProperFee(t *testing.T) {
 	testGetProperFeeOK(t)
 }
 
+func TestSimulateTransaction(t *testing.T) {
+	_, err := g.SimulateTransaction(getOkCtx(), &pb.ReqSimulateTx{})
+	assert.Equal(t, types.ErrNotAllow, err)
+	InitSimulateTx(&types.RPC{EnableSimulateTx: true})
+	defer InitSimulateTx(&types.RPC{})
+	_, err = g.SimulateTransaction(getOkCtx(), &pb.ReqSimulateTx{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestGetStateProof(t *testing.T) {
+	in := &pb.StoreGet{StateHash: []byte("hash"), Keys: [][]byte{[]byte("k1")}}
+	qapi.On("StoreGetProof", in).Return(&pb.ReplyStateProof{StateHash: []byte("hash")}, nil)
+	reply, err := g.GetStateProof(getOkCtx(), in)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hash"), reply.StateHash)
+}
+
 func testQueryChainError(t *testing.T) {
 	var in *pb.ChainExecutor
 
@@ -715,3 +734,79 @@ func TestGrpc_GetParaTxByHeight(t *testing.T) {
 	_, err := g.GetParaTxByHeight(getOkCtx(), &pb.ReqParaTxByHeight{})
 	assert.NoError(t, err)
 }
+
+type testSubscribeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs chan proto.Message
+}
+
+func (s *testSubscribeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testSubscribeStream) Send(msg *pb.BlockSeq) error {
+	s.msgs <- msg
+	return nil
+}
+
+type testTxReceiptsStream struct {
+	*testSubscribeStream
+}
+
+func (s *testTxReceiptsStream) Send(msg *pb.TxReceipts4SubscribePerBlk) error {
+	s.msgs <- msg
+	return nil
+}
+
+func TestSubscribeBlockSeqs(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("GetLastBlockSequence").Return(&types.Int64{Data: 2}, nil)
+	api.On("GetBlockBySeq", &types.Int64{Data: 1}).Return(newTestBlockSeq(cfg, 1, 1), nil)
+	api.On("GetBlockBySeq", &types.Int64{Data: 2}).Return(newTestBlockSeq(cfg, 2, 2), nil)
+	gs := &Grpc{hub: newSubHub(api)}
+	defer gs.hub.close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testSubscribeStream{ctx: ctx, msgs: make(chan proto.Message, 4)}
+	err := gs.SubscribeBlockSeqs(&pb.ReqSubscribeBlockSeqs{Start: 4}, stream)
+	assert.Equal(t, types.ErrStartHeight, err)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- gs.SubscribeBlockSeqs(&pb.ReqSubscribeBlockSeqs{Start: 1}, stream)
+	}()
+	for i := int64(1); i <= 2; i++ {
+		select {
+		case msg := <-stream.msgs:
+			assert.Equal(t, i, msg.(*pb.BlockSeq).Num)
+		case <-time.After(5 * time.Second):
+			t.Fatal("wait block seq timeout")
+		}
+	}
+	cancel()
+	assert.Nil(t, <-done)
+
+	//交易回执订阅必须指定合约或者地址
+	txStream := &testTxReceiptsStream{testSubscribeStream: stream}
+	err = gs.SubscribeTxReceipts(&pb.ReqSubscribeTxReceipts{Start: 1}, txStream)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	txStream.ctx = ctx
+	go func() {
+		done <- gs.SubscribeTxReceipts(&pb.ReqSubscribeTxReceipts{Start: 1, Contract: []string{"coins"}}, txStream)
+	}()
+	select {
+	case msg := <-stream.msgs:
+		receipts := msg.(*pb.TxReceipts4SubscribePerBlk)
+		assert.Equal(t, int64(1), receipts.SeqNum)
+		assert.Equal(t, 1, len(receipts.Tx))
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait tx receipts timeout")
+	}
+	cancel()
+	assert.Nil(t, <-done)
+}
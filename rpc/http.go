@@ -18,7 +18,6 @@ import (
 
 	"github.com/rs/cors"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc"
 	pr "google.golang.org/grpc/peer"
 )
 
@@ -53,7 +52,8 @@ func (j *JSONRPCServer) Listen() (int, error) {
 		return 0, err
 	}
 	j.l = listener
-	co := cors.New(cors.Options{})
+	//允许跨域请求携带 api key
+	co := cors.New(cors.Options{AllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With", apiKeyHeader, authHeader}})
 
 	// Insert the middleware
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -68,12 +68,31 @@ func (j *JSONRPCServer) Listen() (int, error) {
 			writeError(w, r, 0, fmt.Sprintf(`The %s Address is not authorized!`, ip))
 			return
 		}
+		if isWebSocketRequest(r.URL.Path) {
+			//websocket订阅和jrpc方法使用同一套黑白名单
+			if !net.ParseIP(ip).IsLoopback() && (checkJrpcFuncBlacklist(wsFuncName) || !checkJrpcFuncWhitelist(wsFuncName)) {
+				writeError(w, r, 0, fmt.Sprintf(`The %s method is not authorized!`, wsFuncName))
+				return
+			}
+			if err := apiAuth.check(net.ParseIP(ip), httpCredential(r), wsFuncName); err != nil {
+				writeError(w, r, 0, err.Error())
+				return
+			}
+			server := j.wsServer()
+			server.ServeHTTP(w, r)
+			return
+		}
 		if r.URL.Path == "/" {
 			data, err := ioutil.ReadAll(r.Body)
 			if err != nil {
 				writeError(w, r, 0, "Can't get request body!")
 				return
 			}
+			//JSON-RPC 2.0 和批量请求
+			if isJSONRPC2(data) {
+				writeJSONRPC2(w, r, j.handleJSONRPC2(data, net.ParseIP(ip), httpCredential(r)))
+				return
+			}
 			//格式做一个检查
 			client, err := parseJSONRpcParams(data)
 			if err != nil {
@@ -95,6 +114,10 @@ func (j *JSONRPCServer) Listen() (int, error) {
 					return
 				}
 			}
+			if err := apiAuth.check(ipaddr, httpCredential(r), funcName); err != nil {
+				writeError(w, r, client.ID, err.Error())
+				return
+			}
 			serverCodec := jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r})
 			w.Header().Set("Content-type", "application/json")
 			if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
@@ -140,6 +163,28 @@ func writeError(w http.ResponseWriter, r *http.Request, id uint64, errstr string
 	}
 }
 
+func writeJSONRPC2(w http.ResponseWriter, r *http.Request, resp interface{}) {
+	//只有通知的请求不需要应答
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		log.Error("writeJSONRPC2 json marshal", "err", err)
+		return
+	}
+	w.Header().Set("Content-type", "application/json")
+	if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+	w.WriteHeader(200)
+	conn := &HTTPConn{r: r, out: w}
+	if _, err = conn.Write(data); err != nil {
+		log.Debug("writeJSONRPC2", "err", err)
+	}
+}
+
 // Listen grpcserver listen
 func (g *Grpcserver) Listen() (int, error) {
 	listener, err := net.Listen("tcp", rpcCfg.GrpcBindAddr)
@@ -158,11 +203,12 @@ func isLoopBackAddr(addr net.Addr) bool {
 	return false
 }
 
-func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
+func auth(ctx context.Context, fullMethod string) error {
 	getctx, ok := pr.FromContext(ctx)
 	if ok {
+		funcName := strings.Split(fullMethod, "/")[len(strings.Split(fullMethod, "/"))-1]
 		if isLoopBackAddr(getctx.Addr) {
-			return nil
+			return checkGrpcAPIKey(ctx, net.IPv6loopback, funcName)
 		}
 		//remoteaddr := strings.Split(getctx.Addr.String(), ":")[0]
 		ip, _, err := net.SplitHostPort(getctx.Addr.String())
@@ -174,11 +220,10 @@ func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
 			return fmt.Errorf("the %s Address is not authorized", ip)
 		}
 
-		funcName := strings.Split(info.FullMethod, "/")[len(strings.Split(info.FullMethod, "/"))-1]
 		if checkGrpcFuncBlacklist(funcName) || !checkGrpcFuncWhitelist(funcName) {
 			return fmt.Errorf("the %s method is not authorized", funcName)
 		}
-		return nil
+		return checkGrpcAPIKey(ctx, net.ParseIP(ip), funcName)
 	}
 	return fmt.Errorf("can't get remote ip")
 }
@@ -130,6 +130,84 @@ func (c *Chain33) SendTransaction(in rpctypes.RawParm, result *interface{}) erro
 	return err
 }
 
+// SimulateTransaction 模拟执行交易, 返回回执但不提交
+func (c *Chain33) SimulateTransaction(in rpctypes.SimulateTxParam, result *interface{}) error {
+	var tx types.Transaction
+	data, err := common.FromHex(in.Data)
+	if err != nil {
+		return err
+	}
+	err = types.Decode(data, &tx)
+	if err != nil {
+		return err
+	}
+	pubkey, err := common.FromHex(in.Pubkey)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.SimulateTransaction(&types.ReqSimulateTx{Tx: &tx, Pubkey: pubkey})
+	if err != nil {
+		return err
+	}
+	var recp rpctypes.ReceiptData
+	recp.Ty = reply.GetReceipt().GetTy()
+	for _, lg := range reply.GetReceipt().GetLogs() {
+		recp.Logs = append(recp.Logs, &rpctypes.ReceiptLog{Ty: lg.Ty, Log: common.ToHex(lg.GetLog())})
+	}
+	rd, err := rpctypes.DecodeLog(tx.Execer, &recp)
+	if err != nil {
+		return err
+	}
+	res := &rpctypes.SimulateTxResult{
+		Receipt: rd,
+		Fee:     reply.Fee,
+		Height:  reply.Height,
+	}
+	for _, kv := range reply.GetReceipt().GetKV() {
+		res.KV = append(res.KV, &rpctypes.KeyValue{Key: common.ToHex(kv.Key), Value: common.ToHex(kv.Value)})
+	}
+	*result = res
+	return nil
+}
+
+// GetStateProof 获取指定状态下keys的value及mavl证明
+func (c *Chain33) GetStateProof(in rpctypes.ReqStateProof, result *interface{}) error {
+	stateHash, err := common.FromHex(in.StateHash)
+	if err != nil {
+		return err
+	}
+	req := &types.StoreGet{StateHash: stateHash}
+	for _, key := range in.Keys {
+		k, err := common.FromHex(key)
+		if err != nil {
+			return err
+		}
+		req.Keys = append(req.Keys, k)
+	}
+	reply, err := c.cli.StoreGetProof(req)
+	if err != nil {
+		return err
+	}
+	res := &rpctypes.ReplyStateProof{StateHash: common.ToHex(reply.StateHash)}
+	for _, item := range reply.Proofs {
+		proof := &rpctypes.StateProof{Key: common.ToHex(item.Key), Value: common.ToHex(item.Value), Exists: item.Exists}
+		if item.Proof != nil {
+			proof.LeafHash = common.ToHex(item.Proof.LeafHash)
+			for _, node := range item.Proof.InnerNodes {
+				proof.InnerNodes = append(proof.InnerNodes, &rpctypes.InnerNode{
+					LeftHash:  common.ToHex(node.LeftHash),
+					RightHash: common.ToHex(node.RightHash),
+					Height:    node.Height,
+					Size:      node.Size,
+				})
+			}
+		}
+		res.Proofs = append(res.Proofs, proof)
+	}
+	*result = res
+	return nil
+}
+
 // GetHexTxByHash get hex transaction by hash
 func (c *Chain33) GetHexTxByHash(in rpctypes.QueryParm, result *interface{}) error {
 	var data types.ReqHash
@@ -404,7 +482,7 @@ func (c *Chain33) GetAccounts(in *types.ReqAccountList, result *interface{}) err
 	accountsList := reply.(*types.WalletAccounts)
 	var accounts rpctypes.WalletAccounts
 	for _, wallet := range accountsList.Wallets {
-		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(),
+		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(), HdPath: wallet.GetHdPath(), WatchOnly: wallet.GetWatchOnly(),
 			Acc: &rpctypes.Account{Currency: wallet.GetAcc().GetCurrency(), Balance: wallet.GetAcc().GetBalance(),
 				Frozen: wallet.GetAcc().GetFrozen(), Addr: wallet.GetAcc().GetAddr()}})
 	}
@@ -455,6 +533,16 @@ func (c *Chain33) ImportPrivkey(in types.ReqWalletImportPrivkey, result *interfa
 	return nil
 }
 
+// ImportWatchOnly import watch-only account by address or public key
+func (c *Chain33) ImportWatchOnly(in types.ReqWalletImportWatchOnly, result *interface{}) error {
+	reply, err := c.cli.ExecWalletFunc("wallet", "WalletImportWatchOnly", &in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 // SendToAddress send to address of coins
 func (c *Chain33) SendToAddress(in types.ReqWalletSendToAddress, result *interface{}) error {
 	reply, err := c.cli.ExecWalletFunc("wallet", "WalletSendToAddress", &in)
@@ -874,7 +962,23 @@ func (c *Chain33) Query(in rpctypes.Query4Jrpc, result *interface{}) error {
 		log.Error("EventQuery1", "err", err.Error())
 		return err
 	}
-	resp, err := c.cli.Query(cfg.ExecName(in.Execer), in.FuncName, decodePayload)
+	var resp types.Message
+	if in.Height != nil || in.StateHash != "" {
+		query := &types.ChainExecutor{Driver: cfg.ExecName(in.Execer), FuncName: in.FuncName, Param: types.Encode(decodePayload)}
+		if in.Height != nil {
+			query.Height = *in.Height
+			query.ByHeight = true
+		}
+		if in.StateHash != "" {
+			query.StateHash, err = common.FromHex(in.StateHash)
+			if err != nil {
+				return types.ErrInvalidParam
+			}
+		}
+		resp, err = c.cli.QueryChain(query)
+	} else {
+		resp, err = c.cli.Query(cfg.ExecName(in.Execer), in.FuncName, decodePayload)
+	}
 	if err != nil {
 		log.Error("EventQuery2", "err", err.Error())
 		return err
@@ -927,6 +1031,55 @@ func (c *Chain33) ImportPrivkeysFile(in types.ReqPrivkeysFile, result *interface
 	return nil
 }
 
+// ExportKeystore exports private keys to keystore v3 files.
+func (c *Chain33) ExportKeystore(in types.ReqKeystoreFile, result *interface{}) error {
+	reply, err := c.cli.ExecWalletFunc("wallet", "ExportKeystore", &in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// ImportKeystore imports private keys from keystore v3 files.
+func (c *Chain33) ImportKeystore(in types.ReqKeystoreFile, result *interface{}) error {
+	reply, err := c.cli.ExecWalletFunc("wallet", "ImportKeystore", &in)
+	if err != nil {
+		return err
+	}
+	var res rpctypes.ReplyImportKeystore
+	for _, wallet := range reply.(*types.ReplyImportKeystore).Wallets {
+		res.Wallets = append(res.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(), HdPath: wallet.GetHdPath(), WatchOnly: wallet.GetWatchOnly(),
+			Acc: &rpctypes.Account{Currency: wallet.GetAcc().GetCurrency(), Balance: wallet.GetAcc().GetBalance(),
+				Frozen: wallet.GetAcc().GetFrozen(), Addr: wallet.GetAcc().GetAddr()}})
+	}
+	for _, failed := range reply.(*types.ReplyImportKeystore).Failed {
+		res.Failed = append(res.Failed, &rpctypes.KeystoreFileError{FileName: failed.GetFileName(), Err: failed.GetErr()})
+	}
+	*result = &res
+	return nil
+}
+
+// RecoverAccounts recovers used accounts from seed.
+func (c *Chain33) RecoverAccounts(in types.ReqRecoverAccounts, result *interface{}) error {
+	reply, err := c.cli.ExecWalletFunc("wallet", "RecoverAccounts", &in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// GetHDAccounts gets accounts generated from seed with derivation path.
+func (c *Chain33) GetHDAccounts(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.ExecWalletFunc("wallet", "GetHDAccounts", &types.ReqNil{})
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 // Version get software version
 func (c *Chain33) Version(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.Version()
@@ -937,6 +1090,12 @@ func (c *Chain33) Version(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// Discover 返回描述jrpc接口的OpenRPC文档, JSON-RPC 2.0 也可以使用rpc.discover调用
+func (c *Chain33) Discover(in *types.ReqNil, result *interface{}) error {
+	*result = buildOpenRPC(rpcCfg != nil && rpcCfg.EnableEthRPC)
+	return nil
+}
+
 // GetTotalCoins get total coins
 func (c *Chain33) GetTotalCoins(in *types.ReqGetTotalCoins, result *interface{}) error {
 	resp, err := c.cli.GetTotalCoins(in)
@@ -1002,7 +1161,8 @@ func (c *Chain33) QueryTotalFee(in *types.LocalDBGet, result *interface{}) error
 // SignRawTx signature the rawtransaction
 func (c *Chain33) SignRawTx(in *types.ReqSignRawTx, result *interface{}) error {
 	req := types.ReqSignRawTx{Addr: in.Addr, Privkey: in.Privkey, TxHex: in.TxHex, Expire: in.Expire,
-		Index: in.Index, Token: in.Token, Fee: in.Fee, NewToAddr: in.NewToAddr}
+		Index: in.Index, Token: in.Token, Fee: in.Fee, NewToAddr: in.NewToAddr,
+		MultiSignPubkeys: in.MultiSignPubkeys, MultiSignThreshold: in.MultiSignThreshold}
 	reply, err := c.cli.ExecWalletFunc("wallet", "SignRawTx", &req)
 	if err != nil {
 		return err
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"encoding/hex"
+	"encoding/json"
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
@@ -1474,6 +1475,44 @@ func TestChain33_Query(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestChain33_QueryHistory(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	pruned := common.Sha256([]byte("pruned"))
+	api.On("QueryChain", mock.MatchedBy(func(req *types.ChainExecutor) bool {
+		return req.Height == 10 && req.ByHeight && req.StateHash == nil && req.Driver == "coins" && req.FuncName == "GetAddrReciver"
+	})).Return(&types.Int64{Data: 100}, nil)
+	api.On("QueryChain", mock.MatchedBy(func(req *types.ChainExecutor) bool {
+		return req.Height == 0 && req.ByHeight && req.StateHash == nil
+	})).Return(&types.Int64{Data: 0}, nil)
+	api.On("QueryChain", &types.ChainExecutor{Driver: "coins", FuncName: "GetAddrReciver", Param: types.Encode(&types.ReqAddr{Addr: "addr"}), StateHash: pruned, Height: 10, ByHeight: true}).Return(nil, types.ErrStatePruned)
+
+	var testResult interface{}
+	height := int64(10)
+	in := rpctypes.Query4Jrpc{Execer: "coins", FuncName: "GetAddrReciver", Payload: []byte(`{"addr":"addr"}`), Height: &height}
+	err := client.Query(in, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"data":"100"}`, string(testResult.(json.RawMessage)))
+
+	//height为0时查询创世区块的状态
+	genesis := int64(0)
+	in.Height = &genesis
+	err = client.Query(in, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"data":"0"}`, string(testResult.(json.RawMessage)))
+	in.Height = &height
+
+	in.StateHash = common.ToHex(pruned)
+	err = client.Query(in, &testResult)
+	assert.Equal(t, types.ErrStatePruned, err)
+	assert.Equal(t, rpctypes.ErrCodeStatePruned, rpctypes.ErrorCode(err.Error()))
+	in.StateHash = "0xzz"
+	assert.Equal(t, types.ErrInvalidParam, client.Query(in, &testResult))
+	api.AssertNotCalled(t, "Query", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestChain33_DumpPrivkey(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1507,6 +1546,72 @@ func TestChain33_ImportPrivkeysFile(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_Keystore(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	api.On("ExecWalletFunc", "wallet", "ExportKeystore", mock.Anything).Return(&types.ReplyStrings{Datas: []string{"file"}}, nil)
+	err := client.ExportKeystore(types.ReqKeystoreFile{}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file"}, testResult.(*types.ReplyStrings).Datas)
+
+	acc := &types.WalletAccount{Acc: &types.Account{Addr: "addr", Balance: 1}, Label: "label"}
+	failed := &types.KeystoreFileError{FileName: "bad", Err: types.ErrKeystorePasswd.Error()}
+	api.On("ExecWalletFunc", "wallet", "ImportKeystore", mock.Anything).Return(&types.ReplyImportKeystore{Wallets: []*types.WalletAccount{acc}, Failed: []*types.KeystoreFileError{failed}}, nil)
+	err = client.ImportKeystore(types.ReqKeystoreFile{}, &testResult)
+	assert.NoError(t, err)
+	accounts := testResult.(*rpctypes.ReplyImportKeystore)
+	assert.Equal(t, "addr", accounts.Wallets[0].Acc.Addr)
+	assert.Equal(t, "label", accounts.Wallets[0].Label)
+	assert.Equal(t, "bad", accounts.Failed[0].FileName)
+	assert.Equal(t, types.ErrKeystorePasswd.Error(), accounts.Failed[0].Err)
+}
+
+func TestChain33_HDAccounts(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	hdAccounts := &types.HDAccounts{Accounts: []*types.HDAccount{{Addr: "addr", HdPath: "m/44'/13107'/0'/0/3", Index: 3}}}
+	api.On("ExecWalletFunc", "wallet", "RecoverAccounts", &types.ReqRecoverAccounts{GapLimit: 5}).Return(hdAccounts, nil)
+	err := client.RecoverAccounts(types.ReqRecoverAccounts{GapLimit: 5}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, hdAccounts, testResult)
+
+	api.On("ExecWalletFunc", "wallet", "GetHDAccounts", mock.Anything).Return(hdAccounts, nil)
+	err = client.GetHDAccounts(&types.ReqNil{}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), testResult.(*types.HDAccounts).Accounts[0].Index)
+
+	acc := &types.WalletAccount{Acc: &types.Account{Addr: "addr"}, Label: "label", HdPath: "m/44'/13107'/0'/0/3"}
+	api.On("ExecWalletFunc", "wallet", "WalletGetAccountList", mock.Anything).Return(&types.WalletAccounts{Wallets: []*types.WalletAccount{acc}}, nil)
+	err = client.GetAccounts(&types.ReqAccountList{}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, acc.HdPath, testResult.(*rpctypes.WalletAccounts).Wallets[0].HdPath)
+}
+
+func TestChain33_ImportWatchOnly(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	req := types.ReqWalletImportWatchOnly{Addr: "addr", Label: "label"}
+	acc := &types.WalletAccount{Acc: &types.Account{Addr: "addr"}, Label: "label", WatchOnly: true}
+	api.On("ExecWalletFunc", "wallet", "WalletImportWatchOnly", &req).Return(acc, nil)
+	err := client.ImportWatchOnly(req, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, acc, testResult)
+
+	api.On("ExecWalletFunc", "wallet", "WalletGetAccountList", mock.Anything).Return(&types.WalletAccounts{Wallets: []*types.WalletAccount{acc}}, nil)
+	err = client.GetAccounts(&types.ReqAccountList{}, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.WalletAccounts).Wallets[0].WatchOnly)
+}
+
 func TestChain33_GetTotalCoins(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1637,3 +1742,66 @@ func TestChain33_QueryTotalFee(t *testing.T) {
 	assert.Equal(t, total.Fee, queryTotalFee(client, req1, t))
 	assert.True(t, bytes.Equal(req.Keys[0], req1.Keys[0]))
 }
+
+func TestChain33_SimulateTransaction(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	InitSimulateTx(&types.RPC{EnableSimulateTx: true})
+	defer InitSimulateTx(&types.RPC{})
+	var testResult interface{}
+	err := client.SimulateTransaction(rpctypes.SimulateTxParam{Data: "0xzz"}, &testResult)
+	assert.NotNil(t, err)
+
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("none"), Fee: 100000}
+	api.On("GetLastHeader").Return(&types.Header{Height: 1}, nil)
+	feelog := &types.ReceiptLog{Ty: types.TyLogFee, Log: types.Encode(&types.ReceiptAccountTransfer{})}
+	receipt := &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{{Key: []byte("k"), Value: []byte("v")}}, Logs: []*types.ReceiptLog{feelog}}
+	api.On("ExecTxList", mock.Anything).Return(&types.Receipts{Receipts: []*types.Receipt{receipt}}, nil)
+	param := rpctypes.SimulateTxParam{Data: common.ToHex(types.Encode(tx)), Pubkey: "0x02504fa1c28caaf1d5a20fefb87c50a49724ff401043420cb3ba271997eb5a4387"}
+	err = client.SimulateTransaction(param, &testResult)
+	assert.Nil(t, err)
+	res := testResult.(*rpctypes.SimulateTxResult)
+	assert.Equal(t, int64(100000), res.Fee)
+	assert.Equal(t, int64(2), res.Height)
+	assert.Equal(t, "ExecOk", res.Receipt.TyName)
+	assert.Equal(t, "LogFee", res.Receipt.Logs[0].TyName)
+	assert.Equal(t, common.ToHex([]byte("k")), res.KV[0].Key)
+}
+
+func TestChain33_GetStateProof(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	err := client.GetStateProof(rpctypes.ReqStateProof{StateHash: "0xzz"}, &testResult)
+	assert.NotNil(t, err)
+	err = client.GetStateProof(rpctypes.ReqStateProof{StateHash: "0x1234", Keys: []string{"0xzz"}}, &testResult)
+	assert.NotNil(t, err)
+
+	proof := &types.MAVLProof{LeafHash: []byte("leaf"), InnerNodes: []*types.InnerNode{{LeftHash: []byte("left"), Height: 1, Size: 2}}}
+	reply := &types.ReplyStateProof{
+		StateHash: []byte("hash"),
+		Proofs: []*types.StateProof{
+			{Key: []byte("k1"), Value: []byte("v1"), Exists: true, Proof: proof},
+			{Key: []byte("k2")},
+		},
+	}
+	req := &types.StoreGet{StateHash: []byte("hash"), Keys: [][]byte{[]byte("k1"), []byte("k2")}}
+	api.On("StoreGetProof", req).Return(reply, nil)
+	in := rpctypes.ReqStateProof{StateHash: common.ToHex([]byte("hash")), Keys: []string{common.ToHex([]byte("k1")), common.ToHex([]byte("k2"))}}
+	err = client.GetStateProof(in, &testResult)
+	assert.Nil(t, err)
+	res := testResult.(*rpctypes.ReplyStateProof)
+	assert.Equal(t, in.StateHash, res.StateHash)
+	assert.Len(t, res.Proofs, 2)
+	assert.True(t, res.Proofs[0].Exists)
+	assert.Equal(t, common.ToHex([]byte("v1")), res.Proofs[0].Value)
+	assert.Equal(t, common.ToHex([]byte("leaf")), res.Proofs[0].LeafHash)
+	assert.Equal(t, common.ToHex([]byte("left")), res.Proofs[0].InnerNodes[0].LeftHash)
+	assert.Equal(t, "", res.Proofs[0].InnerNodes[0].RightHash)
+	assert.False(t, res.Proofs[1].Exists)
+	assert.Nil(t, res.Proofs[1].InnerNodes)
+}
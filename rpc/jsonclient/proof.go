@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonclient
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+)
+
+// VerifyStateProof 用可信区块头的StateHash校验GetStateProof返回的证明, 不存在的key无法证明, 返回ErrStateProofNotExist
+func VerifyStateProof(header *rpctypes.Header, reply *rpctypes.ReplyStateProof) error {
+	if header == nil || reply == nil {
+		return types.ErrInvalidParam
+	}
+	root, err := common.FromHex(header.StateHash)
+	if err != nil {
+		return err
+	}
+	stateHash, err := common.FromHex(reply.StateHash)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, stateHash) {
+		return types.ErrStateProofVerify
+	}
+	for _, item := range reply.Proofs {
+		if !item.Exists {
+			return types.ErrStateProofNotExist
+		}
+		proof, key, value, err := decodeStateProof(item)
+		if err != nil {
+			return err
+		}
+		proof.RootHash = root
+		if !proof.Verify(key, value, root) {
+			return types.ErrStateProofVerify
+		}
+	}
+	return nil
+}
+
+func decodeStateProof(item *rpctypes.StateProof) (proof *mavl.Proof, key, value []byte, err error) {
+	key, err = common.FromHex(item.Key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	value, err = common.FromHex(item.Value)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	proof = &mavl.Proof{}
+	proof.LeafHash, err = common.FromHex(item.LeafHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, node := range item.InnerNodes {
+		inner := &types.InnerNode{Height: node.Height, Size: node.Size}
+		inner.LeftHash, err = common.FromHex(node.LeftHash)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		inner.RightHash, err = common.FromHex(node.RightHash)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		proof.InnerNodes = append(proof.InnerNodes, inner)
+	}
+	return proof, key, value, nil
+}
@@ -0,0 +1,59 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonclient
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func toStateProof(key, value []byte, proof *mavl.Proof) *rpctypes.StateProof {
+	item := &rpctypes.StateProof{Key: common.ToHex(key), Value: common.ToHex(value), Exists: true, LeafHash: common.ToHex(proof.LeafHash)}
+	for _, node := range proof.InnerNodes {
+		item.InnerNodes = append(item.InnerNodes, &rpctypes.InnerNode{
+			LeftHash:  common.ToHex(node.LeftHash),
+			RightHash: common.ToHex(node.RightHash),
+			Height:    node.Height,
+			Size:      node.Size,
+		})
+	}
+	return item
+}
+
+func TestVerifyStateProof(t *testing.T) {
+	tree := mavl.NewTree(nil, true, nil)
+	for i := 0; i < 20; i++ {
+		tree.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	root := tree.Hash()
+	header := &rpctypes.Header{StateHash: common.ToHex(root)}
+
+	reply := &rpctypes.ReplyStateProof{StateHash: common.ToHex(root)}
+	for _, key := range []string{"key1", "key7", "key19"} {
+		value, proof := tree.ConstructProof([]byte(key))
+		assert.NotNil(t, proof)
+		reply.Proofs = append(reply.Proofs, toStateProof([]byte(key), value, proof))
+	}
+	assert.Nil(t, VerifyStateProof(header, reply))
+	assert.Equal(t, types.ErrInvalidParam, VerifyStateProof(nil, reply))
+
+	//区块头的StateHash不一致
+	other := &rpctypes.Header{StateHash: common.ToHex(common.Sha256([]byte("other")))}
+	assert.Equal(t, types.ErrStateProofVerify, VerifyStateProof(other, reply))
+
+	//篡改value
+	reply.Proofs[1].Value = common.ToHex([]byte("fake"))
+	assert.Equal(t, types.ErrStateProofVerify, VerifyStateProof(header, reply))
+
+	//不存在的key没有证明
+	reply.Proofs = []*rpctypes.StateProof{{Key: common.ToHex([]byte("none"))}}
+	assert.Equal(t, types.ErrStateProofNotExist, VerifyStateProof(header, reply))
+}
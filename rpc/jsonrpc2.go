@@ -0,0 +1,210 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"strings"
+
+	rpctypes "github.com/33cn/chain33/rpc/types"
+)
+
+/*
+JSON-RPC 2.0:
+1. 请求中 jsonrpc 字段为 "2.0" 或者请求是数组(批量请求)时使用 2.0 的格式应答, 其他请求保持 1.0 的处理方式
+2. 批量请求中的每个请求单独检查黑白名单和 api key, 单独返回结果
+3. 错误应答使用 rpc/types/code.go 中定义的错误码
+*/
+
+//jsonrpc2MaxBatch 一次批量请求最多包含的请求数量
+const jsonrpc2MaxBatch = 128
+
+//jsonrpc2Aliases 2.0 中按照规范命名的方法
+var jsonrpc2Aliases = map[string]string{
+	"rpc.discover": "Chain33.Discover",
+}
+
+var (
+	jsonrpc2Null        = json.RawMessage("null")
+	errJSONRPC2BadParam = errors.New("params must be an object or an array with one element")
+)
+
+//jsonrpc2Codec 处理单个请求的 rpc.ServerCodec, 应答保存在 resp 中
+type jsonrpc2Codec struct {
+	req       *rpctypes.JSONRPC2Request
+	method    string
+	rawParams bool //eth 的方法参数数组原样传递
+	read      bool
+	notFound  bool
+	badParams bool
+	resp      *rpctypes.JSONRPC2Response
+}
+
+func (c *jsonrpc2Codec) ReadRequestHeader(r *rpc.Request) error {
+	if c.read {
+		return io.EOF
+	}
+	c.read = true
+	r.ServiceMethod = c.method
+	r.Seq = 0
+	return nil
+}
+
+func (c *jsonrpc2Codec) ReadRequestBody(x interface{}) error {
+	//找不到方法时 net/rpc 使用 nil 丢弃请求参数
+	if x == nil {
+		c.notFound = true
+		return nil
+	}
+	params := bytes.TrimSpace(c.req.Params)
+	if c.rawParams && len(params) > 0 && params[0] != '[' {
+		c.badParams = true
+		return errJSONRPC2BadParam
+	}
+	//兼容 1.0 的参数格式
+	if len(params) > 0 && params[0] == '[' && !c.rawParams {
+		var arr []json.RawMessage
+		if err := json.Unmarshal(params, &arr); err != nil || len(arr) > 1 {
+			c.badParams = true
+			return errJSONRPC2BadParam
+		}
+		params = nil
+		if len(arr) == 1 {
+			params = arr[0]
+		}
+	}
+	if len(params) == 0 || bytes.Equal(params, jsonrpc2Null) {
+		return nil
+	}
+	if err := json.Unmarshal(params, x); err != nil {
+		c.badParams = true
+		return err
+	}
+	return nil
+}
+
+func (c *jsonrpc2Codec) WriteResponse(r *rpc.Response, x interface{}) error {
+	if r.Error != "" {
+		code := rpctypes.ErrorCode(r.Error)
+		if c.notFound {
+			code = rpctypes.ErrCodeMethodNotFound
+		} else if c.badParams {
+			code = rpctypes.ErrCodeInvalidParams
+		}
+		c.resp = newJSONRPC2Error(c.req.ID, code, r.Error)
+		return nil
+	}
+	result, err := json.Marshal(x)
+	if err != nil {
+		c.resp = newJSONRPC2Error(c.req.ID, rpctypes.ErrCodeInternal, err.Error())
+		return nil
+	}
+	c.resp = &rpctypes.JSONRPC2Response{Version: rpctypes.JSONRPCVersion2, Result: result, ID: c.req.ID}
+	return nil
+}
+
+func (c *jsonrpc2Codec) Close() error { return nil }
+
+func newJSONRPC2Error(id json.RawMessage, code int, msg string) *rpctypes.JSONRPC2Response {
+	if len(id) == 0 {
+		id = jsonrpc2Null
+	}
+	return &rpctypes.JSONRPC2Response{
+		Version: rpctypes.JSONRPCVersion2,
+		Error:   &rpctypes.JSONRPC2Error{Code: code, Message: msg},
+		ID:      id,
+	}
+}
+
+//isJSONRPC2 请求是否使用 2.0 的格式, 批量请求只支持 2.0
+func isJSONRPC2(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		return true
+	}
+	var head struct {
+		Version string `json:"jsonrpc"`
+	}
+	return json.Unmarshal(data, &head) == nil && head.Version == rpctypes.JSONRPCVersion2
+}
+
+//handleJSONRPC2 处理单个或者批量请求, 返回需要写回的应答, 全部是通知时返回 nil
+func (j *JSONRPCServer) handleJSONRPC2(data []byte, ip net.IP, cred string) interface{} {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '[' {
+		var req rpctypes.JSONRPC2Request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return newJSONRPC2Error(nil, rpctypes.ErrCodeParse, err.Error())
+		}
+		if resp := j.serveJSONRPC2(&req, ip, cred); resp != nil {
+			return resp
+		}
+		return nil
+	}
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return newJSONRPC2Error(nil, rpctypes.ErrCodeParse, err.Error())
+	}
+	if len(batch) == 0 || len(batch) > jsonrpc2MaxBatch {
+		return newJSONRPC2Error(nil, rpctypes.ErrCodeInvalidRequest, fmt.Sprintf("batch size must be between 1 and %d", jsonrpc2MaxBatch))
+	}
+	var resps []*rpctypes.JSONRPC2Response
+	for _, item := range batch {
+		var req rpctypes.JSONRPC2Request
+		if err := json.Unmarshal(item, &req); err != nil {
+			resps = append(resps, newJSONRPC2Error(nil, rpctypes.ErrCodeInvalidRequest, err.Error()))
+			continue
+		}
+		if resp := j.serveJSONRPC2(&req, ip, cred); resp != nil {
+			resps = append(resps, resp)
+		}
+	}
+	if len(resps) == 0 {
+		return nil
+	}
+	return resps
+}
+
+//serveJSONRPC2 执行一个请求, 通知不返回应答
+func (j *JSONRPCServer) serveJSONRPC2(req *rpctypes.JSONRPC2Request, ip net.IP, cred string) *rpctypes.JSONRPC2Response {
+	if req.Version != rpctypes.JSONRPCVersion2 || req.Method == "" {
+		return newJSONRPC2Error(req.ID, rpctypes.ErrCodeInvalidRequest, "invalid request")
+	}
+	if name, ok := jsonrpc2Aliases[req.Method]; ok {
+		req.Method = name
+	}
+	funcName := req.Method[strings.LastIndex(req.Method, ".")+1:]
+	if !checkFilterPrintFuncBlacklist(funcName) {
+		log.Debug("JSONRPCServer", "method", req.Method, "params", string(req.Params))
+	}
+	var resp *rpctypes.JSONRPC2Response
+	if !ip.IsLoopback() && (checkJrpcFuncBlacklist(funcName) || !checkJrpcFuncWhitelist(funcName)) {
+		resp = newJSONRPC2Error(req.ID, rpctypes.ErrCodeUnauthorized, fmt.Sprintf(`The %s method is not authorized!`, funcName))
+	} else if err := apiAuth.check(ip, cred, funcName); err != nil {
+		resp = newJSONRPC2Error(req.ID, authErrCode(err), err.Error())
+	} else {
+		codec := &jsonrpc2Codec{req: req, method: req.Method}
+		if name, ok := ethMethods[req.Method]; ok && j.eth {
+			codec.method, codec.rawParams = name, true
+		}
+		if err := j.s.ServeRequest(codec); err != nil {
+			log.Debug("serveJSONRPC2", "method", req.Method, "err", err)
+		}
+		resp = codec.resp
+		if resp == nil {
+			resp = newJSONRPC2Error(req.ID, rpctypes.ErrCodeInternal, "no response")
+		}
+	}
+	if len(req.ID) == 0 {
+		return nil
+	}
+	return resp
+}
@@ -0,0 +1,140 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	qmocks "github.com/33cn/chain33/queue/mocks"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJSONRPC2Server(t *testing.T, jrpcAddr string) (*JSONRPCServer, *mocks.QueueProtocolAPI) {
+	rpcCfg = new(types.RPC)
+	rpcCfg.GrpcBindAddr = "127.0.0.1:8103"
+	rpcCfg.JrpcBindAddr = jrpcAddr
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.JrpcFuncWhitelist = []string{"*"}
+	rpcCfg.JrpcFuncBlacklist = []string{"GetNetInfo"}
+	InitCfg(rpcCfg)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("Version").Return(&types.VersionInfo{Chain33: "6.0.2"}, nil)
+	api.On("IsSync").Return(&types.Reply{IsOk: true}, nil)
+	api.On("GetNetInfo", mock.Anything).Return(&types.NodeNetInfo{Externaladdr: "123"}, nil)
+	qm := &qmocks.Client{}
+	qm.On("GetConfig", mock.Anything).Return(cfg)
+	server := NewJSONRPCServer(qm, api)
+	require.NotNil(t, server)
+	return server, api
+}
+
+func TestHandleJSONRPC2(t *testing.T) {
+	server, _ := newTestJSONRPC2Server(t, "127.0.0.1:8203")
+	defer delete(jrpcFuncBlacklist, "GetNetInfo")
+	remote := net.ParseIP("192.168.1.2")
+
+	assert.True(t, isJSONRPC2([]byte(` [{"method":"Chain33.Version"}]`)))
+	assert.True(t, isJSONRPC2([]byte(`{"jsonrpc":"2.0","method":"Chain33.Version"}`)))
+	assert.False(t, isJSONRPC2([]byte(`{"method":"Chain33.Version","params":[],"id":1}`)))
+
+	resp := server.handleJSONRPC2([]byte(`{"jsonrpc":"2.0","method":"Chain33.Version","params":[],"id":"a"}`), remote, "").(*rpctypes.JSONRPC2Response)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, `"a"`, string(resp.ID))
+	var ver types.VersionInfo
+	require.Nil(t, json.Unmarshal(resp.Result, &ver))
+	assert.Equal(t, "6.0.2", ver.Chain33)
+
+	//参数可以是对象
+	resp = server.handleJSONRPC2([]byte(`{"jsonrpc":"2.0","method":"Chain33.IsSync","params":{},"id":1}`), remote, "").(*rpctypes.JSONRPC2Response)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "true", string(resp.Result))
+
+	batch := `[
+		{"jsonrpc":"2.0","method":"Chain33.Version","id":1},
+		{"jsonrpc":"2.0","method":"Chain33.GetNetInfo","params":[{}],"id":2},
+		{"jsonrpc":"2.0","method":"Chain33.NotExist","id":3},
+		{"jsonrpc":"2.0","method":"Chain33.GetBlocks","params":[1,2],"id":4},
+		{"jsonrpc":"2.0","method":"Chain33.IsSync"},
+		{"jsonrpc":"1.0","method":"Chain33.IsSync","id":5},
+		1
+	]`
+	resps := server.handleJSONRPC2([]byte(batch), remote, "").([]*rpctypes.JSONRPC2Response)
+	require.Equal(t, 6, len(resps))
+	assert.Nil(t, resps[0].Error)
+	assert.Equal(t, rpctypes.ErrCodeUnauthorized, resps[1].Error.Code)
+	assert.Equal(t, "2", string(resps[1].ID))
+	assert.Equal(t, rpctypes.ErrCodeMethodNotFound, resps[2].Error.Code)
+	assert.Equal(t, rpctypes.ErrCodeInvalidParams, resps[3].Error.Code)
+	assert.Equal(t, rpctypes.ErrCodeInvalidRequest, resps[4].Error.Code)
+	assert.Equal(t, rpctypes.ErrCodeInvalidRequest, resps[5].Error.Code)
+	assert.Equal(t, "null", string(resps[5].ID))
+
+	//本地请求不检查黑名单
+	resps = server.handleJSONRPC2([]byte(`[{"jsonrpc":"2.0","method":"Chain33.GetNetInfo","params":[{}],"id":2}]`), net.ParseIP("127.0.0.1"), "").([]*rpctypes.JSONRPC2Response)
+	require.Equal(t, 1, len(resps))
+	assert.Nil(t, resps[0].Error)
+
+	resp = server.handleJSONRPC2([]byte(`[]`), remote, "").(*rpctypes.JSONRPC2Response)
+	assert.Equal(t, rpctypes.ErrCodeInvalidRequest, resp.Error.Code)
+	resp = server.handleJSONRPC2([]byte(`[{"jsonrpc":"2.0"`), remote, "").(*rpctypes.JSONRPC2Response)
+	assert.Equal(t, rpctypes.ErrCodeParse, resp.Error.Code)
+	assert.Nil(t, server.handleJSONRPC2([]byte(`[{"jsonrpc":"2.0","method":"Chain33.IsSync"}]`), remote, ""))
+}
+
+func TestJSONRPC2HTTP(t *testing.T) {
+	server, api := newTestJSONRPC2Server(t, "127.0.0.1:8204")
+	defer delete(jrpcFuncBlacklist, "GetNetInfo")
+	api.On("Close").Return()
+	_, err := server.Listen()
+	require.Nil(t, err)
+	defer server.Close()
+	time.Sleep(time.Millisecond)
+
+	post := func(body string) (int, []byte) {
+		resp, err := http.Post("http://"+rpcCfg.JrpcBindAddr, "application/json", bytes.NewBufferString(body))
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		data, err := ioutil.ReadAll(resp.Body)
+		require.Nil(t, err)
+		return resp.StatusCode, data
+	}
+
+	code, data := post(`[{"jsonrpc":"2.0","method":"Chain33.Version","id":1},{"jsonrpc":"2.0","method":"Chain33.IsSync","params":[{}],"id":2}]`)
+	assert.Equal(t, http.StatusOK, code)
+	var resps []*rpctypes.JSONRPC2Response
+	require.Nil(t, json.Unmarshal(data, &resps))
+	require.Equal(t, 2, len(resps))
+	assert.Equal(t, rpctypes.JSONRPCVersion2, resps[0].Version)
+	assert.Equal(t, "true", string(resps[1].Result))
+
+	code, _ = post(`{"jsonrpc":"2.0","method":"Chain33.IsSync"}`)
+	assert.Equal(t, http.StatusNoContent, code)
+
+	//1.0 的请求保持原来的应答格式
+	code, data = post(`{"method":"Chain33.IsSync","params":[{}],"id":3}`)
+	assert.Equal(t, http.StatusOK, code)
+	var resp struct {
+		ID     uint64      `json:"id"`
+		Result interface{} `json:"result"`
+		Error  interface{} `json:"error"`
+	}
+	require.Nil(t, json.Unmarshal(data, &resp))
+	assert.Equal(t, uint64(3), resp.ID)
+	assert.Equal(t, true, resp.Result)
+	assert.Nil(t, resp.Error)
+}
@@ -0,0 +1,195 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/33cn/chain33/common/version"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+OpenRPC 文档:
+1. 反射 Chain33 中符合 net/rpc 要求的方法, 参数和返回值的类型生成 JSON Schema, 结构体放在 components.schemas 中
+2. 注册的执行器的 Query_ 方法和交易类型分别放在 x-chain33-queries 和 x-chain33-actions 中
+3. 返回值为 interface{} 的方法, 返回值的 schema 为空
+*/
+
+var (
+	errorType      = reflect.TypeOf((*error)(nil)).Elem()
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+type openrpcBuilder struct {
+	schemas map[string]*rpctypes.JSONSchema
+}
+
+//buildOpenRPC 生成 rpc.discover 返回的文档
+func buildOpenRPC(eth bool) *rpctypes.OpenRPCDoc {
+	b := &openrpcBuilder{schemas: make(map[string]*rpctypes.JSONSchema)}
+	doc := &rpctypes.OpenRPCDoc{
+		OpenRPC: rpctypes.OpenRPCVersion,
+		Info:    &rpctypes.OpenRPCInfo{Title: "chain33", Version: version.GetVersion()},
+		Methods: b.serviceMethods("Chain33", reflect.TypeOf(&Chain33{})),
+	}
+	if eth {
+		var names []string
+		for name := range ethMethods {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			doc.Methods = append(doc.Methods, &rpctypes.OpenRPCMethod{
+				Name:           name,
+				ParamStructure: "by-position",
+				Params:         []*rpctypes.OpenRPCContentDesc{{Name: "params", Schema: &rpctypes.JSONSchema{Type: "array"}}},
+				Result:         &rpctypes.OpenRPCContentDesc{Name: "result", Schema: &rpctypes.JSONSchema{}},
+			})
+		}
+	}
+	for _, execer := range types.ListExecutorNames() {
+		exec := types.LoadExecutorType(execer)
+		_, queries := types.BuildQueryType("Query_", exec.GetExecFuncMap())
+		for _, name := range sortedTypeNames(queries) {
+			doc.Queries = append(doc.Queries, &rpctypes.OpenRPCExecMethod{Execer: execer, Name: name, Params: b.schema(queries[name].In(1))})
+		}
+		var actions []string
+		for name := range exec.GetTypeMap() {
+			actions = append(actions, name)
+		}
+		sort.Strings(actions)
+		for _, name := range actions {
+			action, err := exec.GetAction(name)
+			if err != nil {
+				continue
+			}
+			doc.Actions = append(doc.Actions, &rpctypes.OpenRPCExecMethod{Execer: execer, Name: name, Params: b.schema(reflect.TypeOf(action))})
+		}
+	}
+	doc.Components = &rpctypes.OpenRPCComponents{Schemas: b.schemas}
+	return doc
+}
+
+//serviceMethods 和 net/rpc 注册时一样的规则筛选方法
+func (b *openrpcBuilder) serviceMethods(service string, typ reflect.Type) []*rpctypes.OpenRPCMethod {
+	var methods []*rpctypes.OpenRPCMethod
+	for i := 0; i < typ.NumMethod(); i++ {
+		method := typ.Method(i)
+		mtype := method.Type
+		if method.PkgPath != "" || mtype.NumIn() != 3 || mtype.NumOut() != 1 || mtype.Out(0) != errorType {
+			continue
+		}
+		if mtype.In(2).Kind() != reflect.Ptr {
+			continue
+		}
+		result := &rpctypes.JSONSchema{}
+		if reply := mtype.In(2).Elem(); reply.Kind() != reflect.Interface {
+			result = b.schema(reply)
+		}
+		methods = append(methods, &rpctypes.OpenRPCMethod{
+			Name:           service + "." + method.Name,
+			ParamStructure: "by-position",
+			Params:         []*rpctypes.OpenRPCContentDesc{{Name: "params", Schema: b.schema(mtype.In(1))}},
+			Result:         &rpctypes.OpenRPCContentDesc{Name: "result", Schema: result},
+		})
+	}
+	return methods
+}
+
+func (b *openrpcBuilder) schema(t reflect.Type) *rpctypes.JSONSchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == rawMessageType {
+		return &rpctypes.JSONSchema{}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &rpctypes.JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &rpctypes.JSONSchema{Type: "integer", Format: t.Kind().String()}
+	case reflect.Float32, reflect.Float64:
+		return &rpctypes.JSONSchema{Type: "number"}
+	case reflect.String:
+		return &rpctypes.JSONSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		//[]byte 使用 base64 编码
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &rpctypes.JSONSchema{Type: "string", Format: "byte"}
+		}
+		return &rpctypes.JSONSchema{Type: "array", Items: b.schema(t.Elem())}
+	case reflect.Map:
+		return &rpctypes.JSONSchema{Type: "object", AdditionalProperties: b.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+		name := schemaName(t)
+		if _, ok := b.schemas[name]; !ok {
+			//先占位, 避免递归的类型死循环
+			b.schemas[name] = &rpctypes.JSONSchema{}
+			*b.schemas[name] = *b.structSchema(t)
+		}
+		return &rpctypes.JSONSchema{Ref: "#/components/schemas/" + name}
+	}
+	return &rpctypes.JSONSchema{}
+}
+
+func (b *openrpcBuilder) structSchema(t reflect.Type) *rpctypes.JSONSchema {
+	s := &rpctypes.JSONSchema{Type: "object", Properties: make(map[string]*rpctypes.JSONSchema)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+		//和 encoding/json 一样展开匿名的结构体
+		if field.Anonymous && tag == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for name, prop := range b.structSchema(ft).Properties {
+					s.Properties[name] = prop
+				}
+				continue
+			}
+		}
+		if tag == "" {
+			tag = field.Name
+		}
+		s.Properties[tag] = b.schema(field.Type)
+	}
+	return s
+}
+
+//schemaName rpc/types 和 types 中有同名的结构体, 使用包名区分
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if strings.HasSuffix(pkg, "/rpc/types") {
+		return "rpctypes." + t.Name()
+	}
+	return path.Base(pkg) + "." + t.Name()
+}
+
+func sortedTypeNames(m map[string]reflect.Type) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,61 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscover(t *testing.T) {
+	server, _ := newTestJSONRPC2Server(t, "127.0.0.1:8207")
+	defer delete(jrpcFuncBlacklist, "GetNetInfo")
+	resp := server.handleJSONRPC2([]byte(`{"jsonrpc":"2.0","method":"rpc.discover","id":1}`), net.ParseIP("127.0.0.1"), "").(*rpctypes.JSONRPC2Response)
+	require.Nil(t, resp.Error)
+	var doc rpctypes.OpenRPCDoc
+	require.Nil(t, json.Unmarshal(resp.Result, &doc))
+	assert.Equal(t, rpctypes.OpenRPCVersion, doc.OpenRPC)
+
+	methods := make(map[string]*rpctypes.OpenRPCMethod)
+	for _, m := range doc.Methods {
+		methods[m.Name] = m
+	}
+	require.NotNil(t, methods["Chain33.GetBlocks"])
+	assert.NotNil(t, methods["Chain33.Discover"])
+	assert.Nil(t, methods["eth_blockNumber"])
+	assert.Equal(t, "#/components/schemas/rpctypes.BlockParam", methods["Chain33.GetBlocks"].Params[0].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/types.ReqBalance", methods["Chain33.GetBalance"].Params[0].Schema.Ref)
+	reqBalance := doc.Components.Schemas["types.ReqBalance"]
+	require.NotNil(t, reqBalance)
+	assert.Equal(t, "array", reqBalance.Properties["addresses"].Type)
+	assert.Equal(t, "string", reqBalance.Properties["addresses"].Items.Type)
+	_, ok := reqBalance.Properties["XXX_sizecache"]
+	assert.False(t, ok)
+	assert.Equal(t, "integer", doc.Components.Schemas["rpctypes.BlockParam"].Properties["start"].Type)
+	//rpc/types 中的结构体使用 rpctypes 前缀, 和 types 中的同名结构体区分
+	assert.Equal(t, "#/components/schemas/rpctypes.CreateTxIn", methods["Chain33.CreateTransaction"].Params[0].Schema.Ref)
+
+	var query, action *rpctypes.OpenRPCExecMethod
+	for _, q := range doc.Queries {
+		if q.Execer == "coins" && q.Name == "GetAddrReciver" {
+			query = q
+		}
+	}
+	for _, a := range doc.Actions {
+		if a.Execer == "coins" && a.Name == "Transfer" {
+			action = a
+		}
+	}
+	require.NotNil(t, query)
+	require.NotNil(t, action)
+	transfer := doc.Components.Schemas[action.Params.Ref[len("#/components/schemas/"):]]
+	require.NotNil(t, transfer)
+	assert.Equal(t, "string", transfer.Properties["to"].Type)
+}
@@ -43,6 +43,7 @@ type Chain33 struct {
 // Grpc a channelClient
 type Grpc struct {
 	cli channelClient
+	hub *subHub
 }
 
 // Grpcserver a object
@@ -62,6 +63,9 @@ type JSONRPCServer struct {
 	jrpc *Chain33
 	s    *rpc.Server
 	l    net.Listener
+	hub  *subHub
+	//是否注册了以太坊风格的接口
+	eth bool
 }
 
 // Close json rpcserver close
@@ -72,6 +76,9 @@ func (s *JSONRPCServer) Close() {
 			log.Error("JSONRPCServer close", "err", err)
 		}
 	}
+	if s.hub != nil {
+		s.hub.close()
+	}
 	if s.jrpc != nil {
 		s.jrpc.cli.Close()
 	}
@@ -143,6 +150,9 @@ func (j *Grpcserver) Close() {
 		}
 	}
 	if j.grpc != nil {
+		if j.grpc.hub != nil {
+			j.grpc.hub.close()
+		}
 		j.grpc.cli.Close()
 	}
 }
@@ -151,17 +161,25 @@ func (j *Grpcserver) Close() {
 func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 	s := &Grpcserver{grpc: &Grpc{}}
 	s.grpc.cli.Init(c, api)
+	s.grpc.hub = newSubHub(s.grpc.cli.QueueProtocolAPI)
 	var opts []grpc.ServerOption
 	//register interceptor
 	//var interceptor grpc.UnaryServerInterceptor
 	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		if err := auth(ctx, info); err != nil {
+		if err := auth(ctx, info.FullMethod); err != nil {
 			return nil, err
 		}
 		// Continue processing the request
 		return handler(ctx, req)
 	}
 	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	streamInterceptor := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := auth(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	opts = append(opts, grpc.StreamInterceptor(streamInterceptor))
 	if rpcCfg.EnableTLS {
 		creds, err := credentials.NewServerTLSFromFile(rpcCfg.CertFile, rpcCfg.KeyFile)
 		if err != nil {
@@ -194,12 +212,20 @@ func NewJSONRPCServer(c queue.Client, api client.QueueProtocolAPI) *JSONRPCServe
 		}
 		j.jrpc.mainGrpcCli = grpcCli
 	}
+	j.hub = newSubHub(j.jrpc.cli.QueueProtocolAPI)
 	server := rpc.NewServer()
 	j.s = server
 	err := server.RegisterName("Chain33", j.jrpc)
 	if err != nil {
 		return nil
 	}
+	if rpcCfg != nil && rpcCfg.EnableEthRPC {
+		err = server.RegisterName("Eth", &Eth{c: j.jrpc, chainID: rpcCfg.EthChainID})
+		if err != nil {
+			return nil
+		}
+		j.eth = true
+	}
 	return j
 }
 
@@ -221,6 +247,10 @@ func InitCfg(cfg *types.RPC) {
 	InitJrpcFuncBlacklist(cfg)
 	InitGrpcFuncBlacklist(cfg)
 	InitFilterPrintFuncBlacklist()
+	InitSimulateTx(cfg)
+	if err := InitAPIKeys(cfg); err != nil {
+		panic(err)
+	}
 }
 
 // New produce a rpc by cfg
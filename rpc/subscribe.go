@@ -0,0 +1,295 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	subPollInterval   = 500 * time.Millisecond
+	subBlockCacheSize = 128
+	subMempoolSeenCap = 10240
+)
+
+// subHub websocket和grpc流式订阅共享的数据源：
+// 定时检查最新的block sequence, 每个sequence对应的区块只从blockchain加载一次，缓存后分发给所有订阅者，
+// mempool的新增交易通过GetLastMempool轮询获取后去重广播
+type subHub struct {
+	api     client.QueueProtocolAPI
+	lastSeq int64
+	blocks  *lru.Cache
+	seenTx  *lru.Cache
+
+	mu      sync.Mutex
+	update  chan struct{}
+	mempool map[chan *types.Transaction]string
+
+	startOnce sync.Once
+	quit      chan struct{}
+	//blockchain没有开启isRecordBlockSequence时不提供区块订阅
+	seqOnce     sync.Once
+	seqDisabled bool
+}
+
+func newSubHub(api client.QueueProtocolAPI) *subHub {
+	blocks, _ := lru.New(subBlockCacheSize)
+	seenTx, _ := lru.New(subMempoolSeenCap)
+	return &subHub{
+		api:     api,
+		lastSeq: -1,
+		blocks:  blocks,
+		seenTx:  seenTx,
+		update:  make(chan struct{}),
+		mempool: make(map[chan *types.Transaction]string),
+		quit:    make(chan struct{}),
+	}
+}
+
+func (hub *subHub) start() {
+	hub.startOnce.Do(func() {
+		go hub.loop()
+	})
+}
+
+func (hub *subHub) close() {
+	select {
+	case <-hub.quit:
+	default:
+		close(hub.quit)
+	}
+}
+
+func (hub *subHub) loop() {
+	ticker := time.NewTicker(subPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !hub.isSeqDisabled() {
+				hub.pollBlockSeq()
+			}
+			hub.pollMempool()
+		case <-hub.quit:
+			return
+		}
+	}
+}
+
+// isSeqDisabled 第一次调用时检查blockchain是否记录sequence, 未开启时只打印一次错误日志并停止区块订阅
+func (hub *subHub) isSeqDisabled() bool {
+	hub.seqOnce.Do(func() {
+		hub.seqDisabled = !hub.api.GetConfig().GetModuleConfig().BlockChain.IsRecordBlockSequence
+		if hub.seqDisabled {
+			log.Error("subHub block subscription stopped", "err", types.ErrRecordBlockSequence)
+		}
+	})
+	return hub.seqDisabled
+}
+
+func (hub *subHub) pollBlockSeq() {
+	last, err := hub.api.GetLastBlockSequence()
+	if err != nil {
+		log.Debug("subHub GetLastBlockSequence", "err", err)
+		return
+	}
+	if last.GetData() == atomic.LoadInt64(&hub.lastSeq) {
+		return
+	}
+	atomic.StoreInt64(&hub.lastSeq, last.GetData())
+	//唤醒所有等待新区块的订阅者
+	hub.mu.Lock()
+	close(hub.update)
+	hub.update = make(chan struct{})
+	hub.mu.Unlock()
+}
+
+func (hub *subHub) pollMempool() {
+	hub.mu.Lock()
+	count := len(hub.mempool)
+	hub.mu.Unlock()
+	if count == 0 {
+		return
+	}
+	reply, err := hub.api.GetLastMempool()
+	if err != nil {
+		log.Debug("subHub GetLastMempool", "err", err)
+		return
+	}
+	var txs []*types.Transaction
+	for _, tx := range reply.GetTxs() {
+		hash := string(tx.Hash())
+		if hub.seenTx.Contains(hash) {
+			continue
+		}
+		hub.seenTx.Add(hash, nil)
+		txs = append(txs, tx)
+	}
+	if len(txs) == 0 {
+		return
+	}
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	for txChan, name := range hub.mempool {
+		for _, tx := range txs {
+			select {
+			case txChan <- tx:
+			default:
+				log.Error("subHub mempool subscriber too slow, tx dropped", "subscription", name, "hash", common.ToHex(tx.Hash()))
+			}
+		}
+	}
+}
+
+// waitUpdate 返回在下一次sequence更新时关闭的chan
+func (hub *subHub) waitUpdate() <-chan struct{} {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	return hub.update
+}
+
+func (hub *subHub) getLastSeq() int64 {
+	return atomic.LoadInt64(&hub.lastSeq)
+}
+
+func (hub *subHub) getBlockSeq(seq int64) (*types.BlockSeq, error) {
+	if value, ok := hub.blocks.Get(seq); ok {
+		return value.(*types.BlockSeq), nil
+	}
+	blockSeq, err := hub.api.GetBlockBySeq(&types.Int64{Data: seq})
+	if err != nil {
+		return nil, err
+	}
+	hub.blocks.Add(seq, blockSeq)
+	return blockSeq, nil
+}
+
+func (hub *subHub) addMempoolSub(txChan chan *types.Transaction, name string) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	hub.mempool[txChan] = name
+}
+
+func (hub *subHub) delMempoolSub(txChan chan *types.Transaction) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	delete(hub.mempool, txChan)
+}
+
+// startCursor 计算订阅开始前已经处理过的sequence, start小于0时从最新的sequence之后开始
+func (hub *subHub) startCursor(start int64) (int64, error) {
+	if hub.isSeqDisabled() {
+		return -1, types.ErrRecordBlockSequence
+	}
+	last, err := hub.api.GetLastBlockSequence()
+	if err != nil {
+		return -1, err
+	}
+	if start < 0 {
+		return last.GetData(), nil
+	}
+	if start > last.GetData()+1 {
+		return -1, types.ErrStartHeight
+	}
+	return start - 1, nil
+}
+
+// run 从cursor之后按sequence顺序逐个回调, 直到done关闭或者回调返回错误
+// 回调阻塞时不会继续读取后续的sequence, 所以慢的订阅者不会丢数据;
+// 区块回滚会以del类型的sequence推送给订阅者
+func (hub *subHub) run(done <-chan struct{}, cursor int64, handle func(*types.BlockSeq) error) error {
+	if hub.isSeqDisabled() {
+		return types.ErrRecordBlockSequence
+	}
+	hub.start()
+	for {
+		wait := hub.waitUpdate()
+		for cursor < hub.getLastSeq() {
+			select {
+			case <-done:
+				return nil
+			default:
+			}
+			blockSeq, err := hub.getBlockSeq(cursor + 1)
+			if err != nil {
+				log.Error("subHub getBlockSeq", "seq", cursor+1, "err", err)
+				break
+			}
+			if err := handle(blockSeq); err != nil {
+				return err
+			}
+			cursor++
+		}
+		select {
+		case <-wait:
+		case <-done:
+			return nil
+		}
+	}
+}
+
+// txFilter 按合约名或地址过滤交易, 两者都为空时不过滤
+type txFilter struct {
+	contract map[string]bool
+	address  map[string]bool
+}
+
+func newTxFilter(contracts, addrs []string) *txFilter {
+	filter := &txFilter{
+		contract: make(map[string]bool),
+		address:  make(map[string]bool),
+	}
+	for _, contract := range contracts {
+		filter.contract[contract] = true
+	}
+	for _, addr := range addrs {
+		filter.address[addr] = true
+	}
+	return filter
+}
+
+func (filter *txFilter) isEmpty() bool {
+	return len(filter.contract) == 0 && len(filter.address) == 0
+}
+
+func (filter *txFilter) matchTx(tx *types.Transaction) bool {
+	if len(filter.contract) > 0 && !filter.contract[string(tx.Execer)] {
+		return false
+	}
+	if len(filter.address) > 0 && !filter.address[tx.From()] && !filter.address[tx.GetRealToAddr()] {
+		return false
+	}
+	return true
+}
+
+// filterTxReceipts 过滤区块中满足条件的交易和回执, 没有匹配的交易时返回nil
+func filterTxReceipts(blockSeq *types.BlockSeq, filter *txFilter) *types.TxReceipts4SubscribePerBlk {
+	detail := blockSeq.GetDetail()
+	txReceipts := &types.TxReceipts4SubscribePerBlk{}
+	for i, tx := range detail.GetBlock().GetTxs() {
+		if !filter.matchTx(tx) || i >= len(detail.GetReceipts()) {
+			continue
+		}
+		txReceipts.Tx = append(txReceipts.Tx, tx)
+		txReceipts.ReceiptData = append(txReceipts.ReceiptData, detail.Receipts[i])
+	}
+	if len(txReceipts.Tx) == 0 {
+		return nil
+	}
+	txReceipts.Height = detail.Block.Height
+	txReceipts.BlockHash = blockSeq.GetSeq().GetHash()
+	txReceipts.ParentHash = detail.Block.ParentHash
+	txReceipts.PreviousHash = []byte{}
+	txReceipts.AddDelType = int32(blockSeq.GetSeq().GetType())
+	txReceipts.SeqNum = blockSeq.Num
+	return txReceipts
+}
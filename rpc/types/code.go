@@ -152,3 +152,37 @@ func decodeUserWrite(payload []byte) *types.UserWrite {
 	article.Content = string(payload)
 	return &article
 }
+
+// JSON-RPC 2.0 错误码, -32000 到 -32099 为服务端自定义的错误
+const (
+	ErrCodeParse          = -32700
+	ErrCodeInvalidRequest = -32600
+	ErrCodeMethodNotFound = -32601
+	ErrCodeInvalidParams  = -32602
+	ErrCodeInternal       = -32603
+	ErrCodeServer         = -32000
+	ErrCodeUnauthorized   = -32001
+	ErrCodeRateLimited    = -32005
+	ErrCodeStatePruned    = -32006
+)
+
+// JSONRPCVersion2 JSON-RPC 2.0 请求和应答中 jsonrpc 字段的值
+const JSONRPCVersion2 = "2.0"
+
+var errCodes = map[string]int{
+	types.ErrInvalidParam.Error():      ErrCodeInvalidParams,
+	types.ErrActionNotSupport.Error():  ErrCodeMethodNotFound,
+	types.ErrAPIKeyInvalid.Error():     ErrCodeUnauthorized,
+	types.ErrAPITokenExpired.Error():   ErrCodeUnauthorized,
+	types.ErrRateLimited.Error():       ErrCodeRateLimited,
+	types.ErrStatePruned.Error():       ErrCodeStatePruned,
+	types.ErrLocalDBNotHistory.Error(): ErrCodeInvalidParams,
+}
+
+// ErrorCode 执行方法返回的错误对应的错误码, 未定义的错误统一使用 ErrCodeServer
+func ErrorCode(msg string) int {
+	if code, ok := errCodes[msg]; ok {
+		return code
+	}
+	return ErrCodeServer
+}
@@ -0,0 +1,74 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// EthBlock eth_getBlockByNumber 返回的区块, Transactions 为交易哈希或者 EthTransaction 列表
+type EthBlock struct {
+	Number           string        `json:"number"`
+	Hash             string        `json:"hash"`
+	ParentHash       string        `json:"parentHash"`
+	Nonce            string        `json:"nonce"`
+	Sha3Uncles       string        `json:"sha3Uncles"`
+	LogsBloom        string        `json:"logsBloom"`
+	TransactionsRoot string        `json:"transactionsRoot"`
+	StateRoot        string        `json:"stateRoot"`
+	ReceiptsRoot     string        `json:"receiptsRoot"`
+	Miner            string        `json:"miner"`
+	Difficulty       string        `json:"difficulty"`
+	TotalDifficulty  string        `json:"totalDifficulty"`
+	ExtraData        string        `json:"extraData"`
+	Size             string        `json:"size"`
+	GasLimit         string        `json:"gasLimit"`
+	GasUsed          string        `json:"gasUsed"`
+	Timestamp        string        `json:"timestamp"`
+	Transactions     []interface{} `json:"transactions"`
+	Uncles           []string      `json:"uncles"`
+}
+
+// EthTransaction 区块中的交易, 手续费表示为 gas 为1, gasPrice 为手续费
+type EthTransaction struct {
+	Hash             string  `json:"hash"`
+	Nonce            string  `json:"nonce"`
+	BlockHash        string  `json:"blockHash"`
+	BlockNumber      string  `json:"blockNumber"`
+	TransactionIndex string  `json:"transactionIndex"`
+	From             string  `json:"from"`
+	To               *string `json:"to"`
+	Value            string  `json:"value"`
+	Gas              string  `json:"gas"`
+	GasPrice         string  `json:"gasPrice"`
+	Input            string  `json:"input"`
+}
+
+// EthReceipt eth_getTransactionReceipt 返回的交易回执
+type EthReceipt struct {
+	TransactionHash   string    `json:"transactionHash"`
+	TransactionIndex  string    `json:"transactionIndex"`
+	BlockHash         string    `json:"blockHash"`
+	BlockNumber       string    `json:"blockNumber"`
+	From              string    `json:"from"`
+	To                *string   `json:"to"`
+	CumulativeGasUsed string    `json:"cumulativeGasUsed"`
+	GasUsed           string    `json:"gasUsed"`
+	EffectiveGasPrice string    `json:"effectiveGasPrice"`
+	ContractAddress   *string   `json:"contractAddress"`
+	Logs              []*EthLog `json:"logs"`
+	LogsBloom         string    `json:"logsBloom"`
+	Type              string    `json:"type"`
+	Status            string    `json:"status"`
+}
+
+// EthLog 回执中的日志, topics 中只有日志类型
+type EthLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
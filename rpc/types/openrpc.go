@@ -0,0 +1,60 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// OpenRPCVersion rpc.discover 返回的文档使用的 OpenRPC 规范版本
+const OpenRPCVersion = "1.2.6"
+
+// OpenRPCDoc rpc.discover 返回的 OpenRPC 文档, 执行器的查询和交易类型放在扩展字段中
+type OpenRPCDoc struct {
+	OpenRPC    string               `json:"openrpc"`
+	Info       *OpenRPCInfo         `json:"info"`
+	Methods    []*OpenRPCMethod     `json:"methods"`
+	Components *OpenRPCComponents   `json:"components"`
+	Queries    []*OpenRPCExecMethod `json:"x-chain33-queries,omitempty"`
+	Actions    []*OpenRPCExecMethod `json:"x-chain33-actions,omitempty"`
+}
+
+// OpenRPCInfo 文档信息
+type OpenRPCInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenRPCMethod rpc方法
+type OpenRPCMethod struct {
+	Name           string                `json:"name"`
+	ParamStructure string                `json:"paramStructure,omitempty"`
+	Params         []*OpenRPCContentDesc `json:"params"`
+	Result         *OpenRPCContentDesc   `json:"result"`
+}
+
+// OpenRPCContentDesc 参数或者返回值
+type OpenRPCContentDesc struct {
+	Name   string      `json:"name"`
+	Schema *JSONSchema `json:"schema"`
+}
+
+// OpenRPCComponents 方法中引用的类型
+type OpenRPCComponents struct {
+	Schemas map[string]*JSONSchema `json:"schemas"`
+}
+
+// OpenRPCExecMethod 执行器的查询方法(Chain33.Query)或者交易(Chain33.CreateTransaction)
+type OpenRPCExecMethod struct {
+	Execer string      `json:"execer"`
+	Name   string      `json:"name"`
+	Params *JSONSchema `json:"params"`
+}
+
+// JSONSchema 参数和返回值的类型描述
+type JSONSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+}
@@ -0,0 +1,67 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/33cn/chain33/types"
+)
+
+// APIToken rpc访问的签名token, 格式为 base64url(json).base64url(hmac-sha256)
+type APIToken struct {
+	// 对应配置中api key的名称
+	Name string `json:"name"`
+	// 过期时间, unix秒, 0表示不过期
+	Expire int64 `json:"exp,omitempty"`
+}
+
+// SignAPIToken 使用secret签名token
+func SignAPIToken(secret []byte, token *APIToken) (string, error) {
+	if token == nil || token.Name == "" || len(secret) == 0 {
+		return "", types.ErrInvalidParam
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(tokenMac(secret, payload)), nil
+}
+
+// VerifyAPIToken 验证token的签名和过期时间, now为当前的unix秒
+func VerifyAPIToken(secret []byte, s string, now int64) (*APIToken, error) {
+	index := strings.IndexByte(s, '.')
+	if index <= 0 || len(secret) == 0 {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	payload := s[:index]
+	mac, err := base64.RawURLEncoding.DecodeString(s[index+1:])
+	if err != nil || !hmac.Equal(mac, tokenMac(secret, payload)) {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	var token APIToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Name == "" {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	if token.Expire != 0 && token.Expire < now {
+		return nil, types.ErrAPITokenExpired
+	}
+	return &token, nil
+}
+
+func tokenMac(secret []byte, payload string) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
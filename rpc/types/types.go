@@ -111,6 +111,55 @@ type ReceiptLogResult struct {
 	RawLog string          `json:"rawLog"`
 }
 
+// SimulateTxParam 模拟执行交易参数, 未签名的交易需要提供发送者公钥
+type SimulateTxParam struct {
+	Data   string `json:"data"`
+	Pubkey string `json:"pubkey,omitempty"`
+}
+
+// KeyValue 回执中的状态数据
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SimulateTxResult 模拟执行交易结果
+type SimulateTxResult struct {
+	Receipt *ReceiptDataResult `json:"receipt"`
+	KV      []*KeyValue        `json:"kv"`
+	Fee     int64              `json:"fee"`
+	Height  int64              `json:"height"`
+}
+
+// ReqStateProof 获取状态证明参数, stateHash和keys都是hex编码
+type ReqStateProof struct {
+	StateHash string   `json:"stateHash"`
+	Keys      []string `json:"keys"`
+}
+
+// InnerNode 证明路径上的mavl中间节点
+type InnerNode struct {
+	LeftHash  string `json:"leftHash,omitempty"`
+	RightHash string `json:"rightHash,omitempty"`
+	Height    int32  `json:"height"`
+	Size      int32  `json:"size"`
+}
+
+// StateProof 单个key的状态证明, key不存在时没有证明数据
+type StateProof struct {
+	Key        string       `json:"key"`
+	Value      string       `json:"value"`
+	Exists     bool         `json:"exists"`
+	LeafHash   string       `json:"leafHash,omitempty"`
+	InnerNodes []*InnerNode `json:"innerNodes,omitempty"`
+}
+
+// ReplyStateProof 状态证明结果
+type ReplyStateProof struct {
+	StateHash string        `json:"stateHash"`
+	Proofs    []*StateProof `json:"proofs"`
+}
+
 // Block block information
 type Block struct {
 	Version    int64          `json:"version"`
@@ -225,10 +274,24 @@ type WalletAccounts struct {
 	Wallets []*WalletAccount `json:"wallets"`
 }
 
+// ReplyImportKeystore 导入keystore文件的结果
+type ReplyImportKeystore struct {
+	Wallets []*WalletAccount     `json:"wallets"`
+	Failed  []*KeystoreFileError `json:"failed,omitempty"`
+}
+
+// KeystoreFileError 导入失败的keystore文件
+type KeystoreFileError struct {
+	FileName string `json:"fileName"`
+	Err      string `json:"err"`
+}
+
 // WalletAccount  wallet account
 type WalletAccount struct {
-	Acc   *Account `json:"acc"`
-	Label string   `json:"label"`
+	Acc       *Account `json:"acc"`
+	Label     string   `json:"label"`
+	HdPath    string   `json:"hdPath,omitempty"`
+	WatchOnly bool     `json:"watchOnly,omitempty"`
 }
 
 // Account account information
@@ -298,6 +361,9 @@ type Query4Jrpc struct {
 	Execer   string          `json:"execer"`
 	FuncName string          `json:"funcName"`
 	Payload  json.RawMessage `json:"payload"`
+	//查询历史状态, stateHash 优先于 height, 不设置height时查询最新状态, height为0时查询创世区块
+	Height    *int64 `json:"height,omitempty"`
+	StateHash string `json:"stateHash,omitempty"`
 }
 
 // ChainExecutor chain executor
@@ -398,3 +464,69 @@ type ReWriteRawTx struct {
 	Expire string `json:"expire"`
 	Index  int32  `json:"index"`
 }
+
+// JSONRPC2Request JSON-RPC 2.0 请求, params 可以是只有一个元素的数组或者对象, 没有 id 的请求为通知, 不需要应答
+type JSONRPC2Request struct {
+	Version string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// JSONRPC2Response JSON-RPC 2.0 应答, 成功时只有 result, 失败时只有 error
+type JSONRPC2Response struct {
+	Version string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *JSONRPC2Error  `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// JSONRPC2Error JSON-RPC 2.0 错误, code 定义在 code.go
+type JSONRPC2Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *JSONRPC2Error) Error() string {
+	return e.Message
+}
+
+// WsRequest websocket 请求, method 为 subscribe 或 unsubscribe
+type WsRequest struct {
+	ID     uint64          `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// WsSubscribeParam websocket 订阅参数
+// type: block, header, txReceipt, mempool
+// lastSequence 非0时从该sequence之后开始推送, lastBlockHash 用于校验该sequence对应的区块
+type WsSubscribeParam struct {
+	Type          string   `json:"type"`
+	Contract      []string `json:"contract,omitempty"`
+	Address       []string `json:"address,omitempty"`
+	LastSequence  int64    `json:"lastSequence,omitempty"`
+	LastBlockHash string   `json:"lastBlockHash,omitempty"`
+}
+
+// WsUnsubscribeParam websocket 取消订阅参数
+type WsUnsubscribeParam struct {
+	Subscription string `json:"subscription"`
+}
+
+// WsResponse websocket 请求应答
+type WsResponse struct {
+	ID     uint64      `json:"id"`
+	Result interface{} `json:"result"`
+	Error  interface{} `json:"error"`
+}
+
+// WsNotification websocket 订阅推送的数据, addDelType 1 为添加区块, 2 为回滚区块
+type WsNotification struct {
+	Subscription string          `json:"subscription"`
+	Type         string          `json:"type"`
+	Seq          int64           `json:"seq"`
+	AddDelType   int64           `json:"addDelType,omitempty"`
+	Result       json.RawMessage `json:"result"`
+}
@@ -0,0 +1,289 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/websocket"
+)
+
+// websocket 订阅类型
+const (
+	WsSubBlock     = "block"
+	WsSubHeader    = "header"
+	WsSubTxReceipt = "txReceipt"
+	WsSubMempool   = "mempool"
+)
+
+const (
+	wsPath              = "/ws"
+	wsFuncName          = "Subscribe"
+	wsMaxSubPerConn     = 16
+	wsMempoolChanBufCap = 256
+	wsWriteTimeout      = 10 * time.Second
+)
+
+// wsConn 一个websocket连接, 可以同时拥有多个订阅
+type wsConn struct {
+	hub    *subHub
+	ws     *websocket.Conn
+	wmu    sync.Mutex
+	subs   map[string]*wsSubscription
+	nextID int64
+}
+
+type wsSubscription struct {
+	*txFilter
+	id     string
+	param  *rpctypes.WsSubscribeParam
+	cursor int64
+	txs    chan *types.Transaction
+	quit   chan struct{}
+}
+
+func (conn *wsConn) send(v interface{}) error {
+	conn.wmu.Lock()
+	defer conn.wmu.Unlock()
+	err := conn.ws.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if err != nil {
+		return err
+	}
+	return websocket.JSON.Send(conn.ws, v)
+}
+
+func (conn *wsConn) sendError(id uint64, err error) {
+	if e := conn.send(&rpctypes.WsResponse{ID: id, Error: err.Error()}); e != nil {
+		log.Debug("wsConn sendError", "err", e)
+	}
+}
+
+func (conn *wsConn) serve() {
+	defer conn.close()
+	for {
+		var req rpctypes.WsRequest
+		if err := websocket.JSON.Receive(conn.ws, &req); err != nil {
+			log.Debug("wsConn Receive", "err", err)
+			return
+		}
+		switch req.Method {
+		case "subscribe":
+			var param rpctypes.WsSubscribeParam
+			if err := json.Unmarshal(req.Params, &param); err != nil {
+				conn.sendError(req.ID, types.ErrInvalidParam)
+				continue
+			}
+			sub, err := conn.subscribe(&param)
+			if err != nil {
+				conn.sendError(req.ID, err)
+				continue
+			}
+			if err := conn.send(&rpctypes.WsResponse{ID: req.ID, Result: sub.id}); err != nil {
+				return
+			}
+			//先回复订阅id, 再开始推送
+			if sub.param.Type == WsSubMempool {
+				go conn.runMempoolSub(sub)
+			} else {
+				go conn.runBlockSub(sub)
+			}
+		case "unsubscribe":
+			var param rpctypes.WsUnsubscribeParam
+			if err := json.Unmarshal(req.Params, &param); err != nil {
+				conn.sendError(req.ID, types.ErrInvalidParam)
+				continue
+			}
+			if err := conn.send(&rpctypes.WsResponse{ID: req.ID, Result: conn.unsubscribe(param.Subscription)}); err != nil {
+				return
+			}
+		default:
+			conn.sendError(req.ID, types.ErrActionNotSupport)
+		}
+	}
+}
+
+func (conn *wsConn) close() {
+	for id := range conn.subs {
+		conn.unsubscribe(id)
+	}
+	_ = conn.ws.Close()
+}
+
+func (conn *wsConn) subscribe(param *rpctypes.WsSubscribeParam) (*wsSubscription, error) {
+	if len(conn.subs) >= wsMaxSubPerConn {
+		return nil, types.ErrTooManySeqCB
+	}
+	sub := &wsSubscription{
+		txFilter: newTxFilter(param.Contract, param.Address),
+		param:    param,
+		quit:     make(chan struct{}),
+	}
+	switch param.Type {
+	case WsSubBlock, WsSubHeader, WsSubTxReceipt:
+		if param.Type == WsSubTxReceipt && sub.isEmpty() {
+			return nil, types.ErrInvalidParam
+		}
+		cursor, err := conn.startCursor(param)
+		if err != nil {
+			return nil, err
+		}
+		sub.cursor = cursor
+	case WsSubMempool:
+		sub.txs = make(chan *types.Transaction, wsMempoolChanBufCap)
+	default:
+		return nil, types.ErrInvalidParam
+	}
+	conn.nextID++
+	sub.id = fmt.Sprintf("%s-%d", param.Type, conn.nextID)
+	if param.Type == WsSubMempool {
+		conn.hub.addMempoolSub(sub.txs, sub.id)
+		conn.hub.start()
+	}
+	conn.subs[sub.id] = sub
+	return sub, nil
+}
+
+func (conn *wsConn) unsubscribe(id string) bool {
+	sub, ok := conn.subs[id]
+	if !ok {
+		return false
+	}
+	if sub.param.Type == WsSubMempool {
+		conn.hub.delMempoolSub(sub.txs)
+	}
+	close(sub.quit)
+	delete(conn.subs, id)
+	return true
+}
+
+// startCursor 指定lastSequence时从该sequence之后续传,
+// lastBlockHash用于确认订阅者和本节点在该sequence上的区块一致
+func (conn *wsConn) startCursor(param *rpctypes.WsSubscribeParam) (int64, error) {
+	if param.LastSequence <= 0 && param.LastBlockHash == "" {
+		return conn.hub.startCursor(-1)
+	}
+	cursor, err := conn.hub.startCursor(param.LastSequence + 1)
+	if err != nil {
+		return -1, err
+	}
+	if param.LastBlockHash != "" {
+		blockSeq, err := conn.hub.getBlockSeq(param.LastSequence)
+		if err != nil {
+			return -1, err
+		}
+		if common.ToHex(blockSeq.GetSeq().GetHash()) != param.LastBlockHash {
+			return -1, types.ErrBlockHashNoMatch
+		}
+	}
+	return cursor, nil
+}
+
+func (conn *wsConn) runBlockSub(sub *wsSubscription) {
+	err := conn.hub.run(sub.quit, sub.cursor, func(blockSeq *types.BlockSeq) error {
+		result, err := conn.formatBlockSeq(sub, blockSeq)
+		if err != nil {
+			return err
+		}
+		if result == nil {
+			return nil
+		}
+		return conn.send(&rpctypes.WsNotification{
+			Subscription: sub.id,
+			Type:         sub.param.Type,
+			Seq:          blockSeq.Num,
+			AddDelType:   blockSeq.GetSeq().GetType(),
+			Result:       result,
+		})
+	})
+	if err != nil {
+		log.Debug("wsConn runBlockSub", "subscription", sub.id, "err", err)
+	}
+}
+
+func (conn *wsConn) runMempoolSub(sub *wsSubscription) {
+	for {
+		select {
+		case tx := <-sub.txs:
+			if !sub.matchTx(tx) {
+				continue
+			}
+			result, err := rpctypes.DecodeTx(tx)
+			if err != nil {
+				continue
+			}
+			data, err := json.Marshal(result)
+			if err != nil {
+				continue
+			}
+			notify := &rpctypes.WsNotification{
+				Subscription: sub.id,
+				Type:         sub.param.Type,
+				Seq:          -1,
+				Result:       data,
+			}
+			if err := conn.send(notify); err != nil {
+				log.Debug("wsConn send", "subscription", sub.id, "err", err)
+				return
+			}
+		case <-sub.quit:
+			return
+		}
+	}
+}
+
+// formatBlockSeq 根据订阅类型格式化数据, 交易回执订阅在该区块没有匹配交易时返回nil
+func (conn *wsConn) formatBlockSeq(sub *wsSubscription, blockSeq *types.BlockSeq) (json.RawMessage, error) {
+	switch sub.param.Type {
+	case WsSubBlock:
+		return types.PBToJSON(blockSeq)
+	case WsSubHeader:
+		header := blockSeq.GetDetail().GetBlock().GetHeader(conn.hub.api.GetConfig())
+		return types.PBToJSON(&types.HeaderSeq{Num: blockSeq.Num, Seq: blockSeq.Seq, Header: header})
+	}
+	txReceipts := filterTxReceipts(blockSeq, sub.txFilter)
+	if txReceipts == nil {
+		return nil, nil
+	}
+	return types.PBToJSON(txReceipts)
+}
+
+// wsServer websocket订阅入口, 浏览器发起的连接需要检查Origin
+func (j *JSONRPCServer) wsServer() websocket.Server {
+	return websocket.Server{Handshake: wsCheckOrigin, Handler: func(ws *websocket.Conn) {
+		conn := &wsConn{
+			hub:  j.hub,
+			ws:   ws,
+			subs: make(map[string]*wsSubscription),
+		}
+		conn.serve()
+	}}
+}
+
+// wsCheckOrigin 带Origin的连接来自浏览器, Origin必须在wsOrigins中, 非浏览器客户端一般不带Origin, 不做检查
+func wsCheckOrigin(config *websocket.Config, req *http.Request) error {
+	origin := strings.TrimRight(req.Header.Get("Origin"), "/")
+	if origin == "" {
+		return nil
+	}
+	for _, allowed := range rpcCfg.WsOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimRight(allowed, "/"), origin) {
+			return nil
+		}
+	}
+	log.Debug("wsCheckOrigin", "origin", origin, "err", types.ErrNotAllow)
+	return types.ErrNotAllow
+}
+
+func isWebSocketRequest(path string) bool {
+	return rpcCfg.EnableWebSocket && strings.TrimRight(path, "/") == wsPath
+}
@@ -0,0 +1,163 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	qmocks "github.com/33cn/chain33/queue/mocks"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/websocket"
+)
+
+func newTestBlockSeq(cfg *types.Chain33Config, seq, height int64) *types.BlockSeq {
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("payload"), To: "1FCX9XJTZXvZteagTrefJEBPZMt8BFmdoi"}
+	block := &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+	detail := &types.BlockDetail{Block: block, Receipts: []*types.ReceiptData{{Ty: types.ExecOk}}}
+	return &types.BlockSeq{
+		Num:    seq,
+		Seq:    &types.BlockSequence{Hash: block.Hash(cfg), Type: 1},
+		Detail: detail,
+	}
+}
+
+func TestWsFormatBlockSeq(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	conn := &wsConn{hub: newSubHub(api)}
+	blockSeq := newTestBlockSeq(cfg, 2, 1)
+
+	sub := &wsSubscription{param: &rpctypes.WsSubscribeParam{Type: WsSubHeader}}
+	data, err := conn.formatBlockSeq(sub, blockSeq)
+	assert.Nil(t, err)
+	var header types.HeaderSeq
+	assert.Nil(t, types.JSONToPB(data, &header))
+	assert.Equal(t, int64(1), header.Header.Height)
+
+	sub = &wsSubscription{
+		txFilter: newTxFilter([]string{"token"}, nil),
+		param:    &rpctypes.WsSubscribeParam{Type: WsSubTxReceipt},
+	}
+	data, err = conn.formatBlockSeq(sub, blockSeq)
+	assert.Nil(t, err)
+	assert.Nil(t, data)
+
+	sub.txFilter = newTxFilter([]string{"coins"}, nil)
+	data, err = conn.formatBlockSeq(sub, blockSeq)
+	assert.Nil(t, err)
+	var receipts types.TxReceipts4SubscribePerBlk
+	assert.Nil(t, types.JSONToPB(data, &receipts))
+	assert.Equal(t, 1, len(receipts.Tx))
+	assert.Equal(t, int64(2), receipts.SeqNum)
+}
+
+func TestWsSubscribeSeqDisabled(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "isRecordBlockSequence=true", "isRecordBlockSequence=false", 1))
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	conn := &wsConn{hub: newSubHub(api), subs: make(map[string]*wsSubscription)}
+	defer conn.hub.close()
+
+	//没有记录sequence时不能订阅区块, 也不会轮询sequence
+	_, err := conn.subscribe(&rpctypes.WsSubscribeParam{Type: WsSubHeader})
+	assert.Equal(t, types.ErrRecordBlockSequence, err)
+	assert.Equal(t, types.ErrRecordBlockSequence, conn.hub.run(nil, -1, nil))
+	api.On("GetLastMempool").Return(&types.ReplyTxList{}, nil)
+	_, err = conn.subscribe(&rpctypes.WsSubscribeParam{Type: WsSubMempool})
+	assert.Nil(t, err)
+	time.Sleep(2 * subPollInterval)
+	api.AssertNotCalled(t, "GetLastBlockSequence")
+}
+
+func TestWsCheckOrigin(t *testing.T) {
+	defer func(cfg *types.RPC) { rpcCfg = cfg }(rpcCfg)
+	rpcCfg = &types.RPC{WsOrigins: []string{"https://example.com"}}
+	req, _ := http.NewRequest("GET", "/ws", nil)
+	assert.Nil(t, wsCheckOrigin(nil, req))
+	req.Header.Set("Origin", "https://example.com")
+	assert.Nil(t, wsCheckOrigin(nil, req))
+	req.Header.Set("Origin", "https://evil.com")
+	assert.Equal(t, types.ErrNotAllow, wsCheckOrigin(nil, req))
+	rpcCfg.WsOrigins = []string{"*"}
+	assert.Nil(t, wsCheckOrigin(nil, req))
+}
+
+func TestWebSocketSubscribe(t *testing.T) {
+	rpcCfg = new(types.RPC)
+	rpcCfg.GrpcBindAddr = "127.0.0.1:8102"
+	rpcCfg.JrpcBindAddr = "127.0.0.1:8202"
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.JrpcFuncWhitelist = []string{"*"}
+	rpcCfg.GrpcFuncWhitelist = []string{"*"}
+	rpcCfg.EnableWebSocket = true
+	rpcCfg.WsOrigins = []string{"http://localhost"}
+	InitCfg(rpcCfg)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("Close").Return()
+	api.On("GetLastBlockSequence").Return(&types.Int64{Data: 2}, nil)
+	blockSeq := newTestBlockSeq(cfg, 2, 1)
+	api.On("GetBlockBySeq", &types.Int64{Data: 2}).Return(blockSeq, nil)
+	qm := &qmocks.Client{}
+	qm.On("GetConfig", mock.Anything).Return(cfg)
+	server := NewJSONRPCServer(qm, api)
+	assert.NotNil(t, server)
+	_, err := server.Listen()
+	assert.Nil(t, err)
+	defer server.Close()
+
+	//不在wsOrigins中的浏览器Origin不能连接
+	_, err = websocket.Dial("ws://"+rpcCfg.JrpcBindAddr+"/ws", "", "http://evil.com/")
+	assert.NotNil(t, err)
+	ws, err := websocket.Dial("ws://"+rpcCfg.JrpcBindAddr+"/ws", "", "http://localhost/")
+	assert.Nil(t, err)
+	defer ws.Close()
+
+	//不支持的订阅类型
+	param, _ := json.Marshal(&rpctypes.WsSubscribeParam{Type: "unknown"})
+	assert.Nil(t, websocket.JSON.Send(ws, &rpctypes.WsRequest{ID: 1, Method: "subscribe", Params: param}))
+	var resp rpctypes.WsResponse
+	assert.Nil(t, websocket.JSON.Receive(ws, &resp))
+	assert.Equal(t, uint64(1), resp.ID)
+	assert.Equal(t, types.ErrInvalidParam.Error(), resp.Error)
+
+	//从sequence 1之后续传
+	param, _ = json.Marshal(&rpctypes.WsSubscribeParam{Type: WsSubHeader, LastSequence: 1})
+	assert.Nil(t, websocket.JSON.Send(ws, &rpctypes.WsRequest{ID: 2, Method: "subscribe", Params: param}))
+	resp = rpctypes.WsResponse{}
+	assert.Nil(t, websocket.JSON.Receive(ws, &resp))
+	assert.Equal(t, uint64(2), resp.ID)
+	assert.Nil(t, resp.Error)
+	subID, ok := resp.Result.(string)
+	assert.True(t, ok)
+
+	assert.Nil(t, ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var notify rpctypes.WsNotification
+	assert.Nil(t, websocket.JSON.Receive(ws, &notify))
+	assert.Equal(t, subID, notify.Subscription)
+	assert.Equal(t, int64(2), notify.Seq)
+	assert.Equal(t, int64(1), notify.AddDelType)
+	var header types.HeaderSeq
+	assert.Nil(t, types.JSONToPB(notify.Result, &header))
+	assert.Equal(t, common.ToHex(blockSeq.Seq.Hash), common.ToHex(header.Seq.Hash))
+
+	param, _ = json.Marshal(&rpctypes.WsUnsubscribeParam{Subscription: subID})
+	assert.Nil(t, websocket.JSON.Send(ws, &rpctypes.WsRequest{ID: 3, Method: "unsubscribe", Params: param}))
+	resp = rpctypes.WsResponse{}
+	assert.Nil(t, websocket.JSON.Receive(ws, &resp))
+	assert.Equal(t, uint64(3), resp.ID)
+	assert.Equal(t, true, resp.Result)
+}
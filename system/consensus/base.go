@@ -399,6 +399,19 @@ func buildHashList(deltx []*types.Transaction) *types.TxHashList {
 
 //WriteBlock 向blockchain写区块
 func (bc *BaseClient) WriteBlock(prev []byte, block *types.Block) error {
+	//区块gas有上限时先预执行, gas不够的交易不打包也不从mempool删除
+	//共识已经预执行过(StateHash已经设置)的区块不再执行
+	if len(block.StateHash) == 0 && bc.isBlockGasLimited(block.Height) {
+		_, deltx, err := util.PreExecBlock(bc.client, prev, block, false, false, true)
+		if err != nil {
+			return err
+		}
+		if len(deltx) > 0 {
+			if err := bc.delMempoolTx(deltx); err != nil {
+				return err
+			}
+		}
+	}
 	//保存block的原始信息用于删除mempool中的错误交易
 	rawtxs := make([]*types.Transaction, len(block.Txs))
 	copy(rawtxs, block.Txs)
@@ -452,6 +465,12 @@ func (bc *BaseClient) PreExecBlock(block *types.Block, errReturn bool) *types.Bl
 	return blockdetail.Block
 }
 
+//isBlockGasLimited 区块的gas是否有上限
+func (bc *BaseClient) isBlockGasLimited(height int64) bool {
+	cfg := bc.client.GetConfig()
+	return cfg.IsFork(height, "ForkExecGas") && cfg.GetGasParam(height).BlockGasLimit > 0
+}
+
 func diffTx(tx1, tx2 []*types.Transaction) (deltx []*types.Transaction) {
 	txlist2 := make(map[string]bool)
 	for _, tx := range tx2 {
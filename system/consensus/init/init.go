@@ -7,5 +7,7 @@ package init
 
 import (
 	//初始化
+	_ "github.com/33cn/chain33/system/consensus/pbft"
+	_ "github.com/33cn/chain33/system/consensus/raft"
 	_ "github.com/33cn/chain33/system/consensus/solo"
 )
@@ -0,0 +1,173 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	errPubKeys     = errors.New("ErrPbftPubKeys")
+	errPrivKey     = errors.New("ErrPbftPrivKey")
+	errBlockCert   = errors.New("ErrPbftBlockCert")
+	errMessageSign = errors.New("ErrPbftMessageSign")
+)
+
+// keyring 共识节点的签名密钥, 所有节点的公钥按照nodeID排列
+type keyring struct {
+	id     uint32
+	ty     int32
+	c      crypto.Crypto
+	priv   crypto.PrivKey
+	pubs   []crypto.PubKey
+	pubRaw [][]byte
+	//区块提交证书使用的2f+1多重签名公钥集合
+	cert    *types.MultiSignPubKey
+	certRaw []byte
+}
+
+func newKeyring(id uint32, privKey string, pubKeys []string) (*keyring, error) {
+	n := len(pubKeys)
+	if n == 0 || int(id) >= n {
+		return nil, errPubKeys
+	}
+	k := &keyring{id: id, ty: types.SECP256K1}
+	c, err := crypto.New(types.GetSignName("", int(k.ty)))
+	if err != nil {
+		return nil, err
+	}
+	k.c = c
+	keys := make([]*types.MultiSignKey, n)
+	for i, hex := range pubKeys {
+		raw, err := common.FromHex(hex)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := c.PubKeyFromBytes(raw)
+		if err != nil {
+			return nil, err
+		}
+		k.pubs = append(k.pubs, pub)
+		k.pubRaw = append(k.pubRaw, raw)
+		keys[i] = &types.MultiSignKey{Ty: k.ty, Pubkey: raw}
+	}
+	f := (n - 1) / 3
+	k.cert, err = types.NewMultiSignPubKey(int32(2*f+1), keys)
+	if err != nil {
+		return nil, err
+	}
+	k.certRaw = types.Encode(k.cert)
+	raw, err := common.FromHex(privKey)
+	if err != nil {
+		return nil, err
+	}
+	k.priv, err = c.PrivKeyFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(k.priv.PubKey().Bytes(), k.pubRaw[id]) {
+		return nil, errPrivKey
+	}
+	return k, nil
+}
+
+func requestSignData(req *types.Request) []byte {
+	return types.Encode(&types.Request{Value: req.Value, Sender: req.Sender})
+}
+
+// sign 使用本节点的私钥对消息签名
+func (k *keyring) sign(req *types.Request) *types.Request {
+	req.Sender = k.id
+	req.Signature = nil
+	req.Signature = &types.Signature{
+		Ty:        k.ty,
+		Pubkey:    k.pubRaw[k.id],
+		Signature: k.priv.Sign(requestSignData(req)).Bytes(),
+	}
+	return req
+}
+
+// verify 消息必须由sender的私钥签名, 并且消息内容中的节点就是sender
+func (k *keyring) verify(req *types.Request) error {
+	if int(req.GetSender()) >= len(k.pubs) || req.GetSignature() == nil {
+		return errMessageSign
+	}
+	if !k.verifyBytes(req.Sender, requestSignData(req), req.Signature.Signature) {
+		return errMessageSign
+	}
+	if replica, ok := requestReplica(req); ok && replica != req.Sender {
+		return errMessageSign
+	}
+	return nil
+}
+
+func (k *keyring) verifyBytes(replica uint32, data, sig []byte) bool {
+	if int(replica) >= len(k.pubs) {
+		return false
+	}
+	s, err := k.c.SignatureFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	return k.pubs[replica].VerifyBytes(data, s)
+}
+
+// requestReplica 消息内容中声明的发送节点, client消息由主节点转发没有节点字段
+func requestReplica(req *types.Request) (uint32, bool) {
+	switch value := req.GetValue().(type) {
+	case *types.Request_Preprepare:
+		return value.Preprepare.GetReplica(), true
+	case *types.Request_Prepare:
+		return value.Prepare.GetReplica(), true
+	case *types.Request_Commit:
+		return value.Commit.GetReplica(), true
+	case *types.Request_Checkpoint:
+		return value.Checkpoint.GetReplica(), true
+	case *types.Request_Viewchange:
+		return value.Viewchange.GetReplica(), true
+	case *types.Request_Ack:
+		return value.Ack.GetReplica(), true
+	case *types.Request_Newview:
+		return value.Newview.GetReplica(), true
+	}
+	return 0, false
+}
+
+func (k *keyring) signBlock(hash []byte) []byte {
+	return k.priv.Sign(hash).Bytes()
+}
+
+// blockCert 2f+1个节点对区块哈希的签名组成多重签名, 作为区块的签名写入链中
+func (k *keyring) blockCert(signs map[uint32][]byte) *types.Signature {
+	sigs := &types.MultiSignSignature{}
+	for i, key := range k.cert.Keys {
+		for replica, sign := range signs {
+			if bytes.Equal(k.pubRaw[replica], key.Pubkey) {
+				sigs.Sigs = append(sigs.Sigs, &types.MultiSignItem{Index: int32(i), Signature: sign})
+				break
+			}
+		}
+		if len(sigs.Sigs) == int(k.cert.Threshold) {
+			break
+		}
+	}
+	return &types.Signature{Ty: types.MultiSign, Pubkey: k.certRaw, Signature: types.Encode(sigs)}
+}
+
+// checkBlockCert 区块的签名必须是当前共识节点集合的2f+1多重签名
+func (k *keyring) checkBlockCert(hash []byte, sign *types.Signature) error {
+	if sign.GetTy() != types.MultiSign || !bytes.Equal(sign.GetPubkey(), k.certRaw) {
+		return errBlockCert
+	}
+	if !types.CheckSign(hash, "", sign) {
+		return errBlockCert
+	}
+	return nil
+}
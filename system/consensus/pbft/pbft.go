@@ -0,0 +1,288 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pbft 实用拜占庭容错共识, 适用于节点固定的许可链
+package pbft
+
+import (
+	"time"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+var plog = log.New("module", "pbft")
+
+const (
+	defaultWaitTxMs          = 1000
+	defaultViewChangeTimeout = 10000
+	defaultCheckpointPeriod  = 100
+)
+
+//Client pbft共识客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg    *subConfig
+	replica   *replica
+	keys      *keyring
+	sleepTime time.Duration
+}
+
+func init() {
+	drivers.Reg("pbft", New)
+	drivers.QueryData.Register("pbft", &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	WaitTxMs         int64  `json:"waitTxMs"`
+	// 本节点在peersURL中的下标
+	NodeID uint32 `json:"nodeID"`
+	// 所有共识节点的地址, 节点数为3f+1
+	PeersURL []string `json:"peersURL"`
+	// 节点间的传输方式, tcp或local(同进程内测试使用)
+	Transport string `json:"transport"`
+	// 没有区块提交时发起视图切换的超时时间
+	ViewChangeTimeoutMs int64 `json:"viewChangeTimeoutMs"`
+	// 检查点间隔的区块数
+	CheckpointPeriod uint32 `json:"checkpointPeriod"`
+	// 本节点签名共识消息的secp256k1私钥
+	PrivKey string `json:"privKey"`
+	// 所有共识节点的公钥, 和peersURL一一对应
+	PubKeys []string `json:"pubKeys"`
+}
+
+//New new
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.WaitTxMs == 0 {
+		subcfg.WaitTxMs = defaultWaitTxMs
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	if subcfg.ViewChangeTimeoutMs == 0 {
+		subcfg.ViewChangeTimeoutMs = defaultViewChangeTimeout
+	}
+	if subcfg.CheckpointPeriod == 0 {
+		subcfg.CheckpointPeriod = defaultCheckpointPeriod
+	}
+	if len(subcfg.PeersURL) == 0 || int(subcfg.NodeID) >= len(subcfg.PeersURL) {
+		panic("pbft: nodeID must be an index of peersURL")
+	}
+	if len(subcfg.PubKeys) != len(subcfg.PeersURL) {
+		panic("pbft: pubKeys must match peersURL")
+	}
+	if subcfg.PrivKey == "" {
+		panic("pbft: privKey must be set")
+	}
+	keys, err := newKeyring(subcfg.NodeID, subcfg.PrivKey, subcfg.PubKeys)
+	if err != nil {
+		panic(err)
+	}
+	transport, err := newTransport(subcfg.Transport, subcfg.NodeID, subcfg.PeersURL)
+	if err != nil {
+		panic(err)
+	}
+	client := &Client{BaseClient: c, subcfg: &subcfg, keys: keys, sleepTime: time.Duration(subcfg.WaitTxMs) * time.Millisecond}
+	client.replica = newReplica(keys, transport, client,
+		time.Duration(subcfg.ViewChangeTimeoutMs)*time.Millisecond, subcfg.CheckpointPeriod)
+	c.SetChild(client)
+	return client
+}
+
+//SetQueueClient 设置队列, 在创世区块写入后启动pbft状态机
+func (client *Client) SetQueueClient(q queue.Client) {
+	client.BaseClient.SetQueueClient(q)
+	client.replica.start(client.GetCurrentHeight())
+}
+
+//Close close
+func (client *Client) Close() {
+	client.replica.stop()
+	client.BaseClient.Close()
+	//关闭队列后正在写入的区块会返回错误, 状态机协程可以退出
+	client.replica.wg.Wait()
+	plog.Info("consensus pbft closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent false
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	return false
+}
+
+//AddBlock 区块同步或者提交后更新状态机的执行高度
+func (client *Client) AddBlock(b *types.Block) error {
+	client.replica.executed(b.Height)
+	return nil
+}
+
+//CheckBlock 没有交易的区块不合法, 区块签名必须是2f+1个共识节点的提交证书
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	if len(current.Block.Txs) == 0 {
+		return types.ErrEmptyTx
+	}
+	cfg := client.GetAPI().GetConfig()
+	return client.keys.checkBlockCert(current.Block.Hash(cfg), current.Block.Signature)
+}
+
+//CreateBlock 主节点打包区块并发起共识, 从节点只等待共识消息
+func (client *Client) CreateBlock() {
+	types.AssertConfig(client.GetAPI())
+	cfg := client.GetAPI().GetConfig()
+	for {
+		if client.IsClosed() {
+			break
+		}
+		lastBlock := client.GetCurrentBlock()
+		if !client.IsMining() || !client.IsCaughtUp() || !client.replica.canPropose(lastBlock.Height+1) {
+			time.Sleep(client.sleepTime)
+			continue
+		}
+		maxTxNum := int(cfg.GetP(lastBlock.Height + 1).MaxTxNumber)
+		txs := client.RequestTx(maxTxNum, nil)
+		txs = client.CheckTxDup(txs)
+		if len(txs) == 0 {
+			time.Sleep(client.sleepTime)
+			continue
+		}
+
+		var newblock types.Block
+		newblock.ParentHash = lastBlock.Hash(cfg)
+		newblock.Height = lastBlock.Height + 1
+		client.AddTxsToBlock(&newblock, txs)
+		newblock.Difficulty = cfg.GetP(0).PowLimitBits
+		//需要首先对交易进行排序然后再计算TxHash
+		if cfg.IsFork(newblock.GetHeight(), "ForkRootHash") {
+			newblock.Txs = types.TransactionSort(newblock.Txs)
+		}
+		newblock.TxHash = merkle.CalcMerkleRoot(cfg, newblock.Height, newblock.Txs)
+		newblock.BlockTime = types.Now().Unix()
+		if lastBlock.BlockTime >= newblock.BlockTime {
+			newblock.BlockTime = lastBlock.BlockTime + 1
+		}
+		//预执行确定区块的StateHash, 共识节点对最终写入链中的区块哈希签名
+		if err := client.preExecBlock(lastBlock, &newblock); err != nil {
+			plog.Error("CreateBlock preExecBlock", "height", newblock.Height, "err", err)
+			time.Sleep(client.sleepTime)
+			continue
+		}
+		client.replica.propose(&newblock)
+	}
+}
+
+//CmpBestBlock 比较newBlock是不是最优区块
+func (client *Client) CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block) bool {
+	return false
+}
+
+// preExecBlock 主节点预执行区块, 删除执行失败的交易并设置StateHash
+// 预执行的状态没有提交, 区块写入时会使用相同的StateHash再次执行并提交
+func (client *Client) preExecBlock(lastBlock, block *types.Block) error {
+	qclient := client.GetQueueClient()
+	_, deltx, err := util.PreExecBlock(qclient, lastBlock.StateHash, block, false, false, false)
+	if err != nil {
+		return err
+	}
+	if len(deltx) > 0 {
+		list := &types.TxHashList{}
+		for _, tx := range deltx {
+			list.Hashes = append(list.Hashes, tx.Hash())
+		}
+		msg := qclient.NewMessage("mempool", types.EventDelTxList, list)
+		if err := qclient.Send(msg, false); err != nil {
+			return err
+		}
+	}
+	if len(block.Txs) == 0 {
+		return types.ErrEmptyTx
+	}
+	return nil
+}
+
+// verifyBlock 从节点在prepare之前检查主节点提议的区块, 处于链顶端时预执行检查StateHash
+func (client *Client) verifyBlock(block *types.Block) error {
+	cfg := client.GetAPI().GetConfig()
+	lastBlock := client.GetCurrentBlock()
+	if len(block.Txs) == 0 {
+		return types.ErrEmptyTx
+	}
+	if block.Height != lastBlock.Height+1 {
+		//落后或者超前的节点只检查区块本身, 父区块由写入时检查
+		return nil
+	}
+	if string(block.ParentHash) != string(lastBlock.Hash(cfg)) {
+		return types.ErrParentHash
+	}
+	if block.Size() > types.MaxBlockSize {
+		return types.ErrBlockSize
+	}
+	if int64(len(block.Txs)) > cfg.GetP(block.Height).MaxTxNumber {
+		return types.ErrManyTx
+	}
+	_, _, err := util.PreExecBlock(client.GetQueueClient(), lastBlock.StateHash, types.Clone(block).(*types.Block), true, false, false)
+	return err
+}
+
+// blockHash 提议的区块已经包含StateHash, 和写入链中的区块哈希一致
+func (client *Client) blockHash(block *types.Block) []byte {
+	return block.Hash(client.GetAPI().GetConfig())
+}
+
+// execBlock 执行并写入已经达成共识的区块, 返回写入后的区块哈希
+func (client *Client) execBlock(block *types.Block) ([]byte, error) {
+	cfg := client.GetAPI().GetConfig()
+	lastBlock := client.GetCurrentBlock()
+	if block.Height <= lastBlock.Height {
+		//已经通过区块同步写入
+		cur, err := client.RequestBlock(block.Height)
+		if err != nil {
+			return nil, err
+		}
+		return cur.Hash(cfg), nil
+	}
+	err := client.WriteBlock(lastBlock.StateHash, types.Clone(block).(*types.Block))
+	if err != nil {
+		plog.Error("execBlock", "height", block.Height, "err", err)
+		return nil, err
+	}
+	return client.GetCurrentBlock().Hash(cfg), nil
+}
+
+// hasPendingTx mempool中还有交易, 主节点长时间不出块时用于判断是否需要视图切换
+func (client *Client) hasPendingTx() bool {
+	return len(client.RequestTx(1, nil)) > 0
+}
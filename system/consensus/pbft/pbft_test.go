@@ -0,0 +1,322 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	//加载系统内置store, 不要依赖plugin
+	_ "github.com/33cn/chain33/system/dapp/init"
+	_ "github.com/33cn/chain33/system/mempool/init"
+	_ "github.com/33cn/chain33/system/store/init"
+)
+
+type mockExec struct {
+	mu      sync.Mutex
+	blocks  []*types.Block
+	pending bool
+}
+
+func (m *mockExec) verifyBlock(block *types.Block) error {
+	return nil
+}
+
+func (m *mockExec) execBlock(block *types.Block) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blocks = append(m.blocks, block)
+	return blockDigest(block), nil
+}
+
+func (m *mockExec) blockHash(block *types.Block) []byte {
+	b := types.Clone(block).(*types.Block)
+	b.Signature = nil
+	return blockDigest(b)
+}
+
+func (m *mockExec) hasPendingTx() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.pending
+}
+
+func (m *mockExec) height() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.blocks)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	for i := 0; i < 300; i++ {
+		if cond() {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("wait timeout")
+}
+
+// genKeys 生成n个共识节点的私钥和公钥
+func genKeys(t *testing.T, n int) (privs, pubs []string) {
+	c, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	for i := 0; i < n; i++ {
+		priv, err := c.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, common.ToHex(priv.Bytes()))
+		pubs = append(pubs, common.ToHex(priv.PubKey().Bytes()))
+	}
+	return privs, pubs
+}
+
+func newKeyrings(t *testing.T, n int) []*keyring {
+	privs, pubs := genKeys(t, n)
+	keys := make([]*keyring, n)
+	for i := range keys {
+		var err error
+		keys[i], err = newKeyring(uint32(i), privs[i], pubs)
+		assert.Nil(t, err)
+	}
+	return keys
+}
+
+func newPeers(prefix string, n int) []string {
+	peers := make([]string, n)
+	for i := range peers {
+		peers[i] = fmt.Sprintf("%s-%d", prefix, i)
+	}
+	return peers
+}
+
+func TestReplicaViewChange(t *testing.T) {
+	peers := newPeers("replica", 4)
+	keys := newKeyrings(t, len(peers))
+	execs := make([]*mockExec, len(peers))
+	replicas := make([]*replica, len(peers))
+	for i := range peers {
+		execs[i] = &mockExec{}
+		replicas[i] = newReplica(keys[i], newLocalTransport(uint32(i), peers), execs[i], 200*time.Millisecond, 2)
+		replicas[i].start(0)
+	}
+	defer func() {
+		for _, r := range replicas[1:] {
+			r.stop()
+		}
+	}()
+
+	//view 0 的主节点是0
+	assert.True(t, replicas[0].canPropose(1))
+	assert.False(t, replicas[1].canPropose(1))
+	replicas[0].propose(&types.Block{Height: 1, Txs: []*types.Transaction{{Execer: []byte("none")}}})
+	waitFor(t, func() bool {
+		for _, e := range execs {
+			if e.height() != 1 {
+				return false
+			}
+		}
+		return true
+	})
+
+	//主节点失效后切换到view 1
+	replicas[0].stop()
+	for _, e := range execs[1:] {
+		e.mu.Lock()
+		e.pending = true
+		e.mu.Unlock()
+	}
+	waitFor(t, func() bool { return replicas[1].canPropose(2) })
+	replicas[1].propose(&types.Block{Height: 2, Txs: []*types.Transaction{{Execer: []byte("none")}}})
+	waitFor(t, func() bool {
+		for _, e := range execs[1:] {
+			if e.height() != 2 {
+				return false
+			}
+		}
+		return true
+	})
+	hash := execs[1].blockHash(execs[1].blocks[1])
+	for _, e := range execs[1:] {
+		assert.Equal(t, hash, e.blockHash(e.blocks[1]))
+		//写入的区块带有2f+1个节点的提交证书
+		assert.Nil(t, keys[0].checkBlockCert(hash, e.blocks[1].Signature))
+	}
+	//高度2是检查点
+	waitFor(t, func() bool {
+		replicas[1].mu.Lock()
+		defer replicas[1].mu.Unlock()
+		return replicas[1].stable == 2
+	})
+}
+
+const pbftSubCfg = `
+[consensus.sub.pbft]
+genesis="14KEKbYtKKQm4wMthSK9J4La4nAiidGozt"
+genesisBlockTime=1514533394
+waitTxMs=100
+nodeID=%d
+peersURL=["%s"]
+transport="local"
+viewChangeTimeoutMs=1000
+privKey="%s"
+pubKeys=["%s"]
+`
+
+func TestNewWithoutPrivKey(t *testing.T) {
+	sub := []byte(`{"nodeID":0,"peersURL":["127.0.0.1:8890"],"pubKeys":["0x02"]}`)
+	assert.PanicsWithValue(t, "pbft: privKey must be set", func() { New(&types.Consensus{}, sub) })
+}
+
+func TestRequestSign(t *testing.T) {
+	keys := newKeyrings(t, 4)
+	p := &types.RequestPrepare{View: 1, Sequence: 2, Digest: []byte("digest"), Replica: 1}
+	req := keys[1].sign(&types.Request{Value: &types.Request_Prepare{Prepare: p}})
+	assert.Nil(t, keys[0].verify(req))
+
+	//没有签名
+	unsigned := &types.Request{Value: &types.Request_Prepare{Prepare: p}, Sender: 1}
+	assert.Equal(t, errMessageSign, keys[0].verify(unsigned))
+	//签名后修改内容
+	tampered := types.Clone(req).(*types.Request)
+	tampered.GetPrepare().Digest = []byte("other")
+	assert.Equal(t, errMessageSign, keys[0].verify(tampered))
+	//节点2用自己的私钥冒充节点1
+	forged := keys[2].sign(&types.Request{Value: &types.Request_Prepare{Prepare: p}})
+	assert.Equal(t, errMessageSign, keys[0].verify(forged))
+	//节点2冒充节点1的签名
+	forged.Sender = 1
+	assert.Equal(t, errMessageSign, keys[0].verify(forged))
+
+	//不足2f+1个签名或者不是共识节点的多重签名不能作为提交证书
+	hash := []byte("blockhash")
+	signs := map[uint32][]byte{0: keys[0].signBlock(hash), 1: keys[1].signBlock(hash)}
+	assert.Equal(t, errBlockCert, keys[0].checkBlockCert(hash, keys[0].blockCert(signs)))
+	signs[3] = keys[3].signBlock(hash)
+	assert.Nil(t, keys[0].checkBlockCert(hash, keys[0].blockCert(signs)))
+	assert.Equal(t, errBlockCert, keys[0].checkBlockCert([]byte("other"), keys[0].blockCert(signs)))
+	other := newKeyrings(t, 4)
+	otherSigns := map[uint32][]byte{0: other[0].signBlock(hash), 1: other[1].signBlock(hash), 2: other[2].signBlock(hash)}
+	assert.Equal(t, errBlockCert, keys[0].checkBlockCert(hash, other[0].blockCert(otherSigns)))
+}
+
+func TestCheckNewView(t *testing.T) {
+	keys := newKeyrings(t, 4)
+	r := newReplica(keys[0], nil, &mockExec{}, time.Second, 2)
+	digest := []byte("digest")
+	//节点0在view 0中prepared了高度1的区块
+	pp := keys[0].sign(&types.Request{Value: &types.Request_Preprepare{Preprepare: &types.RequestPrePrepare{View: 0, Sequence: 1, Digest: digest, Replica: 0}}})
+	proofs := []*types.Request{pp}
+	for i := 1; i <= 2; i++ {
+		proofs = append(proofs, keys[i].sign(&types.Request{Value: &types.Request_Prepare{Prepare: &types.RequestPrepare{View: 0, Sequence: 1, Digest: digest, Replica: uint32(i)}}}))
+	}
+	entry := &types.Entry{Sequence: 1, Digest: digest, View: 0}
+	newView := func(prepared bool, summaryDigest []byte) *types.RequestNewView {
+		nv := &types.RequestNewView{View: 1, Replica: 1}
+		var vcs []*types.RequestViewChange
+		for i := 1; i <= 3; i++ {
+			vc := &types.RequestViewChange{View: 1, Replica: uint32(i)}
+			if prepared && i == 1 {
+				vc.Preps = []*types.Entry{entry}
+				vc.Proofs = proofs
+			}
+			vcs = append(vcs, vc)
+			nv.Proofs = append(nv.Proofs, keys[i].sign(&types.Request{Value: &types.Request_Viewchange{Viewchange: vc}}))
+		}
+		nv.Viewchanges, nv.Summaries = newViewSummaries(vcs)
+		if summaryDigest != nil {
+			nv.Summaries = []*types.Summary{{Sequence: 1, Digest: summaryDigest}}
+		}
+		for _, s := range nv.Summaries {
+			nv.Proofs = append(nv.Proofs, keys[1].sign(&types.Request{Value: &types.Request_Preprepare{Preprepare: &types.RequestPrePrepare{View: 1, Sequence: s.Sequence, Digest: s.Digest, Replica: 1}}}))
+		}
+		return nv
+	}
+	prePrepares, ok := r.checkNewView(newView(true, nil))
+	assert.True(t, ok)
+	assert.Equal(t, digest, prePrepares[1].GetPreprepare().Digest)
+	//新的主节点不能丢弃已经prepared的区块
+	nv := newView(true, nil)
+	nv.Summaries = nil
+	_, ok = r.checkNewView(nv)
+	assert.False(t, ok)
+	//新的主节点不能替换成其他区块
+	_, ok = r.checkNewView(newView(true, []byte("other")))
+	assert.False(t, ok)
+	//没有prepared的区块不能出现在summaries中
+	_, ok = r.checkNewView(newView(false, digest))
+	assert.False(t, ok)
+	//viewchange中的prepared区块必须有2f个prepare签名
+	nv = newView(true, nil)
+	vc := nv.Proofs[0].GetViewchange()
+	vc.Proofs = vc.Proofs[:2]
+	nv.Proofs[0] = keys[1].sign(&types.Request{Value: &types.Request_Viewchange{Viewchange: vc}})
+	_, ok = r.checkNewView(nv)
+	assert.False(t, ok)
+	//不足2f+1个viewchange
+	nv = newView(false, nil)
+	nv.Proofs = nv.Proofs[:2]
+	_, ok = r.checkNewView(nv)
+	assert.False(t, ok)
+}
+
+func TestPbft(t *testing.T) {
+	peers := newPeers("node", 4)
+	privs, pubs := genKeys(t, len(peers))
+	nodes := make([]*testnode.Chain33Mock, len(peers))
+	for i := range peers {
+		cfgstring := strings.Replace(types.GetDefaultCfgstring(), `name="solo"`, `name="pbft"`, 1)
+		cfgstring += fmt.Sprintf(pbftSubCfg, i, strings.Join(peers, `","`), privs[i], strings.Join(pubs, `","`))
+		nodes[i] = testnode.NewWithConfig(types.NewChain33Config(cfgstring), nil)
+	}
+	defer func() {
+		for _, node := range nodes[1:] {
+			node.Close()
+		}
+	}()
+	cfg := nodes[0].GetClient().GetConfig()
+	waitHeight := func(nodes []*testnode.Chain33Mock, height int64) {
+		waitFor(t, func() bool {
+			for _, node := range nodes {
+				header, err := node.GetAPI().GetLastHeader()
+				if err != nil || header.Height < height {
+					return false
+				}
+			}
+			return true
+		})
+		hash := nodes[0].GetBlock(height).Hash(cfg)
+		for _, node := range nodes[1:] {
+			assert.Equal(t, hash, node.GetBlock(height).Hash(cfg))
+			assert.Equal(t, int32(types.MultiSign), node.GetBlock(height).Signature.GetTy())
+		}
+	}
+
+	//交易正常情况下通过p2p广播到所有节点, 最后发送给主节点, 避免区块提交后交易重复
+	sendTxs := func(nodes []*testnode.Chain33Mock, txs []*types.Transaction) {
+		for i := len(nodes) - 1; i >= 0; i-- {
+			for _, tx := range txs {
+				_, err := nodes[i].GetAPI().SendTx(tx)
+				assert.Nil(t, err)
+			}
+		}
+	}
+	sendTxs(nodes, util.GenNoneTxs(cfg, nodes[0].GetGenesisKey(), 5))
+	waitHeight(nodes, 1)
+
+	//关闭view 0的主节点, 剩余3个节点仍然可以出块
+	nodes[0].Close()
+	sendTxs(nodes[1:], util.GenNoneTxs(cfg, nodes[1].GetGenesisKey(), 5))
+	waitHeight(nodes[1:], 2)
+}
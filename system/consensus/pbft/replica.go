@@ -0,0 +1,675 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+// blockExecutor 状态机依赖的区块操作, 由Client实现
+type blockExecutor interface {
+	verifyBlock(block *types.Block) error
+	execBlock(block *types.Block) ([]byte, error)
+	hasPendingTx() bool
+	// blockHash 区块写入链中的哈希, commit消息对这个哈希签名
+	blockHash(block *types.Block) []byte
+}
+
+type certKey struct {
+	view     uint32
+	sequence uint32
+}
+
+// cert 一个(view, sequence)上收集到的共识消息, 保留签名后的消息用于视图切换时证明区块已经prepared
+type cert struct {
+	digest        []byte
+	prePrepare    *types.RequestPrePrepare
+	prePrepareMsg *types.Request
+	prepares      map[uint32]*types.Request
+	commits       map[uint32]*types.RequestCommit
+	sentPrepare   bool
+	sentCommit    bool
+}
+
+// replica pbft状态机, 区块高度即为共识序号, 所有消息都在run协程中串行处理
+// 所有消息都由发送节点签名, 收到后先验证签名和发送节点
+type replica struct {
+	id        uint32
+	n         uint32
+	f         uint32
+	exec      blockExecutor
+	keys      *keyring
+	transport Transport
+	timeout   time.Duration
+	period    uint32
+
+	mu           sync.Mutex
+	view         uint32
+	viewChanging bool
+	newViewSent  uint32
+	pendingSeen  bool
+	lastExec     uint32
+	stable       uint32
+	stableDigest []byte
+	requests     map[string]*types.Block
+	certs        map[certKey]*cert
+	checkpoints  map[uint32]map[string]map[uint32]bool
+	viewChanges  map[uint32]map[uint32]*types.Request
+
+	//区块同步或者写入后的链高度, 由blockchain的EventAddBlock通知
+	chainHeight int64
+	proposeC    chan *types.Block
+	timer       *time.Timer
+	done        chan struct{}
+	wg          sync.WaitGroup
+}
+
+func newReplica(keys *keyring, transport Transport, exec blockExecutor, timeout time.Duration, period uint32) *replica {
+	n := uint32(len(keys.pubs))
+	return &replica{
+		id:          keys.id,
+		n:           n,
+		f:           (n - 1) / 3,
+		exec:        exec,
+		keys:        keys,
+		transport:   transport,
+		timeout:     timeout,
+		period:      period,
+		requests:    make(map[string]*types.Block),
+		certs:       make(map[certKey]*cert),
+		checkpoints: make(map[uint32]map[string]map[uint32]bool),
+		viewChanges: make(map[uint32]map[uint32]*types.Request),
+		proposeC:    make(chan *types.Block, 1),
+		done:        make(chan struct{}),
+	}
+}
+
+func blockDigest(block *types.Block) []byte {
+	return common.Sha256(types.Encode(block))
+}
+
+func (r *replica) start(height int64) {
+	atomic.StoreInt64(&r.chainHeight, height)
+	r.lastExec = uint32(height)
+	r.timer = time.NewTimer(r.timeout)
+	r.wg.Add(1)
+	go r.run()
+}
+
+func (r *replica) stop() {
+	close(r.done)
+	r.transport.Close()
+}
+
+func (r *replica) run() {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.done:
+			return
+		case req, ok := <-r.transport.Recv():
+			if !ok {
+				return
+			}
+			if err := r.keys.verify(req); err != nil {
+				plog.Error("pbft verify request", "sender", req.GetSender(), "err", err)
+				continue
+			}
+			r.mu.Lock()
+			r.syncHeight()
+			r.handle(req)
+			r.mu.Unlock()
+		case block := <-r.proposeC:
+			r.mu.Lock()
+			r.syncHeight()
+			r.handlePropose(block)
+			r.mu.Unlock()
+		case <-r.timer.C:
+			r.mu.Lock()
+			r.syncHeight()
+			r.onTimeout()
+			r.mu.Unlock()
+		}
+	}
+}
+
+func (r *replica) primary(view uint32) uint32 {
+	return view % r.n
+}
+
+// executed 区块写入链后更新执行高度
+func (r *replica) executed(height int64) {
+	for {
+		cur := atomic.LoadInt64(&r.chainHeight)
+		if height <= cur || atomic.CompareAndSwapInt64(&r.chainHeight, cur, height) {
+			return
+		}
+	}
+}
+
+func (r *replica) syncHeight() {
+	height := uint32(atomic.LoadInt64(&r.chainHeight))
+	if height > r.lastExec {
+		r.lastExec = height
+	}
+}
+
+// canPropose 当前视图的主节点, 且没有正在共识的区块
+func (r *replica) canPropose(height int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.syncHeight()
+	return !r.viewChanging && r.primary(r.view) == r.id && uint32(height) == r.lastExec+1 && !r.inflight()
+}
+
+func (r *replica) propose(block *types.Block) {
+	select {
+	case r.proposeC <- block:
+	case <-r.done:
+	}
+}
+
+func (r *replica) inflight() bool {
+	for key, c := range r.certs {
+		if key.view == r.view && key.sequence > r.lastExec && c.prePrepare != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *replica) getCert(view, sequence uint32) *cert {
+	key := certKey{view: view, sequence: sequence}
+	c, ok := r.certs[key]
+	if !ok {
+		c = &cert{prepares: make(map[uint32]*types.Request), commits: make(map[uint32]*types.RequestCommit)}
+		r.certs[key] = c
+	}
+	return c
+}
+
+// broadcast 签名后发送给其他节点, 返回签名后的消息
+func (r *replica) broadcast(req *types.Request) *types.Request {
+	r.keys.sign(req)
+	r.transport.Broadcast(req)
+	return req
+}
+
+func (r *replica) handle(req *types.Request) {
+	switch value := req.GetValue().(type) {
+	case *types.Request_Client:
+		r.handleClient(value.Client)
+	case *types.Request_Preprepare:
+		r.handlePrePrepare(req)
+	case *types.Request_Prepare:
+		r.handlePrepare(req)
+	case *types.Request_Commit:
+		r.handleCommit(value.Commit)
+	case *types.Request_Checkpoint:
+		r.handleCheckpoint(value.Checkpoint)
+	case *types.Request_Viewchange:
+		r.handleViewChange(req)
+	case *types.Request_Newview:
+		r.handleNewView(req)
+	default:
+		plog.Debug("pbft unsupported request", "req", req)
+	}
+}
+
+func (r *replica) handlePropose(block *types.Block) {
+	if r.viewChanging || r.primary(r.view) != r.id || uint32(block.Height) != r.lastExec+1 || r.inflight() {
+		return
+	}
+	digest := blockDigest(block)
+	r.requests[string(digest)] = block
+	r.sendClient(block)
+	pp := &types.RequestPrePrepare{View: r.view, Sequence: uint32(block.Height), Digest: digest, Replica: r.id}
+	c := r.getCert(pp.View, pp.Sequence)
+	c.prePrepare = pp
+	c.prePrepareMsg = r.broadcast(&types.Request{Value: &types.Request_Preprepare{Preprepare: pp}})
+	c.digest = digest
+	plog.Info("pbft propose", "view", r.view, "height", block.Height, "txs", len(block.Txs))
+}
+
+func (r *replica) sendClient(block *types.Block) {
+	r.broadcast(&types.Request{Value: &types.Request_Client{Client: &types.RequestClient{
+		Op:        &types.Operation{Value: block},
+		Timestamp: fmt.Sprint(types.Now().UnixNano()),
+		Client:    fmt.Sprint(r.id),
+	}}})
+}
+
+func (r *replica) handleClient(rc *types.RequestClient) {
+	block := rc.GetOp().GetValue()
+	if block == nil || uint32(block.Height) <= r.lastExec {
+		return
+	}
+	digest := blockDigest(block)
+	r.requests[string(digest)] = block
+	for key, c := range r.certs {
+		if key.view == r.view && bytes.Equal(c.digest, digest) {
+			r.tryPrepare(key, c)
+		}
+	}
+}
+
+func (r *replica) handlePrePrepare(req *types.Request) {
+	pp := req.GetPreprepare()
+	if r.viewChanging || pp.View != r.view || pp.Replica != r.primary(r.view) || pp.Replica == r.id {
+		return
+	}
+	if pp.Sequence <= r.lastExec {
+		return
+	}
+	key := certKey{view: pp.View, sequence: pp.Sequence}
+	c := r.getCert(pp.View, pp.Sequence)
+	if c.prePrepare != nil && !bytes.Equal(c.digest, pp.Digest) {
+		plog.Error("pbft conflicting preprepare", "view", pp.View, "sequence", pp.Sequence)
+		return
+	}
+	c.prePrepare = pp
+	c.prePrepareMsg = req
+	c.digest = pp.Digest
+	r.tryPrepare(key, c)
+}
+
+func (r *replica) tryPrepare(key certKey, c *cert) {
+	if c.sentPrepare || c.prePrepare == nil || r.primary(key.view) == r.id {
+		r.tryCommit(key, c)
+		return
+	}
+	block, ok := r.requests[string(c.digest)]
+	if !ok {
+		return
+	}
+	if uint32(block.Height) != key.sequence {
+		plog.Error("pbft preprepare height mismatch", "sequence", key.sequence, "height", block.Height)
+		return
+	}
+	if err := r.exec.verifyBlock(block); err != nil {
+		plog.Error("pbft verifyBlock", "height", block.Height, "err", err)
+		return
+	}
+	c.sentPrepare = true
+	p := &types.RequestPrepare{View: key.view, Sequence: key.sequence, Digest: c.digest, Replica: r.id}
+	c.prepares[r.id] = r.broadcast(&types.Request{Value: &types.Request_Prepare{Prepare: p}})
+	r.tryCommit(key, c)
+}
+
+func (r *replica) handlePrepare(req *types.Request) {
+	p := req.GetPrepare()
+	if r.viewChanging || p.View != r.view || p.Sequence <= r.lastExec || p.Replica == r.primary(p.View) {
+		return
+	}
+	key := certKey{view: p.View, sequence: p.Sequence}
+	c := r.getCert(p.View, p.Sequence)
+	c.prepares[p.Replica] = req
+	r.tryCommit(key, c)
+}
+
+// prepared 收到了preprepare, 请求本身以及2f个匹配的prepare
+func (r *replica) prepared(c *cert) bool {
+	if c.prePrepare == nil {
+		return false
+	}
+	if _, ok := r.requests[string(c.digest)]; !ok {
+		return false
+	}
+	return uint32(len(r.preparedProofs(c))) >= 2*r.f
+}
+
+// preparedProofs 和preprepare的摘要一致的prepare消息
+func (r *replica) preparedProofs(c *cert) []*types.Request {
+	var proofs []*types.Request
+	for _, req := range c.prepares {
+		if bytes.Equal(req.GetPrepare().GetDigest(), c.digest) {
+			proofs = append(proofs, req)
+		}
+	}
+	return proofs
+}
+
+func (r *replica) tryCommit(key certKey, c *cert) {
+	if c.sentCommit || !r.prepared(c) {
+		return
+	}
+	c.sentCommit = true
+	hash := r.exec.blockHash(r.requests[string(c.digest)])
+	cm := &types.RequestCommit{View: key.view, Sequence: key.sequence, Replica: r.id, Digest: c.digest, BlockSign: r.keys.signBlock(hash)}
+	c.commits[r.id] = cm
+	r.broadcast(&types.Request{Value: &types.Request_Commit{Commit: cm}})
+	r.tryExec()
+}
+
+func (r *replica) handleCommit(cm *types.RequestCommit) {
+	if r.viewChanging || cm.View != r.view || cm.Sequence <= r.lastExec {
+		return
+	}
+	c := r.getCert(cm.View, cm.Sequence)
+	c.commits[cm.Replica] = cm
+	r.tryExec()
+}
+
+// commitSigns 摘要一致并且区块签名正确的commit, 用于组成区块的提交证书
+func (r *replica) commitSigns(c *cert, hash []byte) map[uint32][]byte {
+	signs := make(map[uint32][]byte)
+	for replica, cm := range c.commits {
+		if bytes.Equal(cm.Digest, c.digest) && r.keys.verifyBytes(replica, hash, cm.BlockSign) {
+			signs[replica] = cm.BlockSign
+		}
+	}
+	return signs
+}
+
+// tryExec 按序执行已经committed的区块, 区块的签名为2f+1个节点的commit签名
+func (r *replica) tryExec() {
+	for {
+		c, ok := r.certs[certKey{view: r.view, sequence: r.lastExec + 1}]
+		if !ok || !c.sentCommit || uint32(len(c.commits)) < 2*r.f+1 {
+			return
+		}
+		block := types.Clone(r.requests[string(c.digest)]).(*types.Block)
+		signs := r.commitSigns(c, r.exec.blockHash(block))
+		if uint32(len(signs)) < 2*r.f+1 {
+			return
+		}
+		block.Signature = r.keys.blockCert(signs)
+		hash, err := r.exec.execBlock(block)
+		if err != nil {
+			//等待区块同步
+			return
+		}
+		r.lastExec++
+		r.pendingSeen = false
+		r.resetTimer()
+		plog.Info("pbft committed", "view", r.view, "height", block.Height, "hash", common.ToHex(hash))
+		if r.lastExec%r.period == 0 {
+			cp := &types.RequestCheckpoint{Sequence: r.lastExec, Digest: hash, Replica: r.id}
+			r.broadcast(&types.Request{Value: &types.Request_Checkpoint{Checkpoint: cp}})
+			r.handleCheckpoint(cp)
+		}
+	}
+}
+
+func (r *replica) handleCheckpoint(cp *types.RequestCheckpoint) {
+	if cp.Sequence <= r.stable {
+		return
+	}
+	digests, ok := r.checkpoints[cp.Sequence]
+	if !ok {
+		digests = make(map[string]map[uint32]bool)
+		r.checkpoints[cp.Sequence] = digests
+	}
+	replicas, ok := digests[string(cp.Digest)]
+	if !ok {
+		replicas = make(map[uint32]bool)
+		digests[string(cp.Digest)] = replicas
+	}
+	replicas[cp.Replica] = true
+	if uint32(len(replicas)) >= 2*r.f+1 {
+		r.stable = cp.Sequence
+		r.stableDigest = cp.Digest
+		r.gc()
+	}
+}
+
+// gc 稳定检查点之前的共识数据不再需要
+func (r *replica) gc() {
+	for key := range r.certs {
+		if key.sequence <= r.stable {
+			delete(r.certs, key)
+		}
+	}
+	for digest, block := range r.requests {
+		if uint32(block.Height) <= r.stable {
+			delete(r.requests, digest)
+		}
+	}
+	for seq := range r.checkpoints {
+		if seq <= r.stable {
+			delete(r.checkpoints, seq)
+		}
+	}
+}
+
+func (r *replica) resetTimer() {
+	if !r.timer.Stop() {
+		select {
+		case <-r.timer.C:
+		default:
+		}
+	}
+	r.timer.Reset(r.timeout)
+}
+
+func (r *replica) hasPending() bool {
+	for _, block := range r.requests {
+		if uint32(block.Height) > r.lastExec {
+			return true
+		}
+	}
+	return r.exec.hasPendingTx()
+}
+
+// onTimeout 连续两个超时周期都有待打包的交易但没有区块提交, 认为主节点失效
+func (r *replica) onTimeout() {
+	if r.viewChanging {
+		//新的主节点也没有响应
+		r.startViewChange(r.view + 1)
+		return
+	}
+	if r.hasPending() {
+		if r.pendingSeen {
+			r.startViewChange(r.view + 1)
+			return
+		}
+		r.pendingSeen = true
+	}
+	r.resetTimer()
+}
+
+func (r *replica) startViewChange(view uint32) {
+	plog.Info("pbft start view change", "from", r.view, "to", view, "replica", r.id)
+	r.view = view
+	r.viewChanging = true
+	r.pendingSeen = false
+	vc := &types.RequestViewChange{View: view, Sequence: r.lastExec, Replica: r.id}
+	if r.stable > 0 {
+		vc.Checkpoints = append(vc.Checkpoints, &types.Checkpoint{Sequence: r.stable, Digest: r.stableDigest})
+	}
+	for key, c := range r.certs {
+		if key.sequence <= r.lastExec || c.prePrepare == nil {
+			continue
+		}
+		entry := &types.Entry{Sequence: key.sequence, Digest: c.digest, View: key.view}
+		vc.Prepreps = append(vc.Prepreps, entry)
+		if r.prepared(c) && c.prePrepareMsg != nil {
+			vc.Preps = append(vc.Preps, entry)
+			vc.Proofs = append(vc.Proofs, c.prePrepareMsg)
+			vc.Proofs = append(vc.Proofs, r.preparedProofs(c)...)
+		}
+	}
+	req := r.broadcast(&types.Request{Value: &types.Request_Viewchange{Viewchange: vc}})
+	r.resetTimer()
+	r.handleViewChange(req)
+}
+
+// checkViewChange preps中的每个区块都要有主节点签名的preprepare和2f个其他节点签名的prepare
+func (r *replica) checkViewChange(vc *types.RequestViewChange) bool {
+	for _, entry := range vc.Preps {
+		pp := false
+		prepares := make(map[uint32]bool)
+		for _, proof := range vc.Proofs {
+			if r.keys.verify(proof) != nil {
+				continue
+			}
+			if p := proof.GetPreprepare(); p != nil && p.View == entry.View && p.Sequence == entry.Sequence &&
+				bytes.Equal(p.Digest, entry.Digest) && p.Replica == r.primary(entry.View) {
+				pp = true
+			}
+			if p := proof.GetPrepare(); p != nil && p.View == entry.View && p.Sequence == entry.Sequence &&
+				bytes.Equal(p.Digest, entry.Digest) && p.Replica != r.primary(entry.View) {
+				prepares[p.Replica] = true
+			}
+		}
+		if !pp || uint32(len(prepares)) < 2*r.f {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *replica) handleViewChange(req *types.Request) {
+	vc := req.GetViewchange()
+	if vc.View < r.view || (vc.View == r.view && !r.viewChanging) {
+		return
+	}
+	if !r.checkViewChange(vc) {
+		plog.Error("pbft invalid viewchange", "view", vc.View, "replica", vc.Replica)
+		return
+	}
+	vcs, ok := r.viewChanges[vc.View]
+	if !ok {
+		vcs = make(map[uint32]*types.Request)
+		r.viewChanges[vc.View] = vcs
+	}
+	vcs[vc.Replica] = req
+	if vc.View > r.view && uint32(len(vcs)) >= r.f+1 {
+		//f+1个节点已经切换视图, 不需要等待自己超时
+		r.startViewChange(vc.View)
+		return
+	}
+	if vc.View == r.view && r.primary(r.view) == r.id && r.newViewSent != r.view && uint32(len(vcs)) >= 2*r.f+1 {
+		r.sendNewView()
+	}
+}
+
+// newViewSummaries 按照节点顺序遍历viewchange, 每个序号选择最高视图中prepared的区块
+func newViewSummaries(vcs []*types.RequestViewChange) ([]*types.ViewChange, []*types.Summary) {
+	sort.Slice(vcs, func(i, j int) bool { return vcs[i].Replica < vcs[j].Replica })
+	var changes []*types.ViewChange
+	best := make(map[uint32]*types.Entry)
+	for _, vc := range vcs {
+		changes = append(changes, &types.ViewChange{Viewchanger: vc.Replica, Digest: common.Sha256(types.Encode(vc))})
+		for _, entry := range vc.Preps {
+			if old, ok := best[entry.Sequence]; !ok || entry.View > old.View {
+				best[entry.Sequence] = entry
+			}
+		}
+	}
+	var summaries []*types.Summary
+	for _, entry := range best {
+		summaries = append(summaries, &types.Summary{Sequence: entry.Sequence, Digest: entry.Digest})
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Sequence < summaries[j].Sequence })
+	return changes, summaries
+}
+
+// sendNewView 新的主节点在新视图中重新提议在旧视图中已经prepared的区块
+func (r *replica) sendNewView() {
+	r.newViewSent = r.view
+	nv := &types.RequestNewView{View: r.view, Replica: r.id}
+	var vcs []*types.RequestViewChange
+	for _, req := range r.viewChanges[r.view] {
+		vcs = append(vcs, req.GetViewchange())
+		nv.Proofs = append(nv.Proofs, req)
+	}
+	nv.Viewchanges, nv.Summaries = newViewSummaries(vcs)
+	for _, s := range nv.Summaries {
+		pp := &types.RequestPrePrepare{View: r.view, Sequence: s.Sequence, Digest: s.Digest, Replica: r.id}
+		nv.Proofs = append(nv.Proofs, r.keys.sign(&types.Request{Value: &types.Request_Preprepare{Preprepare: pp}}))
+		if block, ok := r.requests[string(s.Digest)]; ok {
+			r.sendClient(block)
+		}
+	}
+	r.handleNewView(r.broadcast(&types.Request{Value: &types.Request_Newview{Newview: nv}}))
+}
+
+// checkNewView 重新计算summaries, 并且每个summary都有新主节点签名的preprepare
+func (r *replica) checkNewView(nv *types.RequestNewView) (map[uint32]*types.Request, bool) {
+	var vcs []*types.RequestViewChange
+	seen := make(map[uint32]bool)
+	prePrepares := make(map[uint32]*types.Request)
+	for _, proof := range nv.Proofs {
+		if r.keys.verify(proof) != nil {
+			return nil, false
+		}
+		if pp := proof.GetPreprepare(); pp != nil && pp.View == nv.View && pp.Replica == nv.Replica {
+			prePrepares[pp.Sequence] = proof
+			continue
+		}
+		vc := proof.GetViewchange()
+		if vc == nil || vc.View != nv.View || seen[vc.Replica] || !r.checkViewChange(vc) {
+			return nil, false
+		}
+		seen[vc.Replica] = true
+		vcs = append(vcs, vc)
+	}
+	if uint32(len(vcs)) < 2*r.f+1 {
+		return nil, false
+	}
+	changes, summaries := newViewSummaries(vcs)
+	if !bytes.Equal(types.Encode(&types.RequestNewView{Viewchanges: changes, Summaries: summaries}),
+		types.Encode(&types.RequestNewView{Viewchanges: nv.Viewchanges, Summaries: nv.Summaries})) {
+		return nil, false
+	}
+	for _, s := range summaries {
+		pp, ok := prePrepares[s.Sequence]
+		if !ok || !bytes.Equal(pp.GetPreprepare().Digest, s.Digest) {
+			return nil, false
+		}
+	}
+	return prePrepares, true
+}
+
+func (r *replica) handleNewView(req *types.Request) {
+	nv := req.GetNewview()
+	if nv.View < r.view || (nv.View == r.view && !r.viewChanging) {
+		return
+	}
+	if nv.Replica != r.primary(nv.View) {
+		return
+	}
+	prePrepares, ok := r.checkNewView(nv)
+	if !ok {
+		plog.Error("pbft invalid newview", "view", nv.View, "primary", nv.Replica)
+		return
+	}
+	plog.Info("pbft new view", "view", nv.View, "primary", nv.Replica, "replica", r.id)
+	r.view = nv.View
+	r.viewChanging = false
+	r.pendingSeen = false
+	r.resetTimer()
+	for key := range r.certs {
+		if key.view < r.view {
+			delete(r.certs, key)
+		}
+	}
+	for view := range r.viewChanges {
+		if view <= r.view {
+			delete(r.viewChanges, view)
+		}
+	}
+	//新视图中只接受summaries中的区块
+	for _, s := range nv.Summaries {
+		if s.Sequence <= r.lastExec {
+			continue
+		}
+		key := certKey{view: r.view, sequence: s.Sequence}
+		c := r.getCert(r.view, s.Sequence)
+		c.prePrepareMsg = prePrepares[s.Sequence]
+		c.prePrepare = c.prePrepareMsg.GetPreprepare()
+		c.digest = s.Digest
+		r.tryPrepare(key, c)
+	}
+}
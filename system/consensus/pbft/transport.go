@@ -0,0 +1,229 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	recvBufferSize = 1024
+	maxMessageSize = 2 * types.MaxBlockSize
+	dialTimeout    = 3 * time.Second
+)
+
+var errMessageTooLarge = errors.New("ErrPbftMessageTooLarge")
+
+// Transport pbft节点间的消息传输, 消息可能丢失, 由视图切换保证活性
+type Transport interface {
+	// Broadcast 发送给除自己以外的所有节点
+	Broadcast(req *types.Request)
+	// Recv 接收其他节点发来的消息
+	Recv() <-chan *types.Request
+	Close()
+}
+
+func newTransport(name string, id uint32, peers []string) (Transport, error) {
+	switch name {
+	case "", "tcp":
+		return newTCPTransport(id, peers)
+	case "local":
+		return newLocalTransport(id, peers), nil
+	}
+	return nil, types.ErrNotSupport
+}
+
+// localTransport 同一进程内多个节点之间的传输, 用于测试
+type localTransport struct {
+	self  string
+	peers []string
+	recvC chan *types.Request
+}
+
+var (
+	localMu  sync.Mutex
+	localNet = make(map[string]*localTransport)
+)
+
+func newLocalTransport(id uint32, peers []string) *localTransport {
+	t := &localTransport{self: peers[id], peers: peers, recvC: make(chan *types.Request, recvBufferSize)}
+	localMu.Lock()
+	localNet[t.self] = t
+	localMu.Unlock()
+	return t
+}
+
+func (t *localTransport) Broadcast(req *types.Request) {
+	localMu.Lock()
+	defer localMu.Unlock()
+	for _, peer := range t.peers {
+		dst, ok := localNet[peer]
+		if !ok || peer == t.self {
+			continue
+		}
+		select {
+		case dst.recvC <- types.Clone(req).(*types.Request):
+		default:
+			plog.Error("local transport drop message", "to", peer)
+		}
+	}
+}
+
+func (t *localTransport) Recv() <-chan *types.Request {
+	return t.recvC
+}
+
+func (t *localTransport) Close() {
+	localMu.Lock()
+	delete(localNet, t.self)
+	localMu.Unlock()
+}
+
+// tcpTransport 节点之间使用长连接, 消息格式为4字节长度加protobuf编码的Request
+// 每个节点有独立的发送协程负责连接和写入, Broadcast不会阻塞状态机
+type tcpTransport struct {
+	self     string
+	listener net.Listener
+	recvC    chan *types.Request
+	sendC    map[string]chan []byte
+	done     chan struct{}
+}
+
+func newTCPTransport(id uint32, peers []string) (*tcpTransport, error) {
+	listener, err := net.Listen("tcp", peers[id])
+	if err != nil {
+		return nil, err
+	}
+	t := &tcpTransport{
+		self:     peers[id],
+		listener: listener,
+		recvC:    make(chan *types.Request, recvBufferSize),
+		sendC:    make(map[string]chan []byte),
+		done:     make(chan struct{}),
+	}
+	for _, peer := range peers {
+		if peer == t.self {
+			continue
+		}
+		t.sendC[peer] = make(chan []byte, recvBufferSize)
+		go t.sendLoop(peer, t.sendC[peer])
+	}
+	go t.accept()
+	return t, nil
+}
+
+func (t *tcpTransport) accept() {
+	for {
+		conn, err := t.listener.Accept()
+		if err != nil {
+			select {
+			case <-t.done:
+				return
+			default:
+			}
+			plog.Error("tcp transport accept", "err", err)
+			continue
+		}
+		go t.readLoop(conn)
+	}
+}
+
+func (t *tcpTransport) readLoop(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	var head [4]byte
+	for {
+		if _, err := io.ReadFull(reader, head[:]); err != nil {
+			return
+		}
+		size := binary.BigEndian.Uint32(head[:])
+		if size > maxMessageSize {
+			plog.Error("tcp transport read", "remote", conn.RemoteAddr(), "err", errMessageTooLarge)
+			return
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return
+		}
+		req := &types.Request{}
+		if err := types.Decode(data, req); err != nil {
+			plog.Error("tcp transport decode", "remote", conn.RemoteAddr(), "err", err)
+			return
+		}
+		select {
+		case t.recvC <- req:
+		case <-t.done:
+			return
+		}
+	}
+}
+
+func (t *tcpTransport) Broadcast(req *types.Request) {
+	data := types.Encode(req)
+	frame := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+	for peer, sendC := range t.sendC {
+		select {
+		case sendC <- frame:
+		default:
+			plog.Debug("tcp transport drop message", "to", peer)
+		}
+	}
+}
+
+// sendLoop 按顺序发送给一个节点, 连接失败的节点在dialTimeout内不再重连
+func (t *tcpTransport) sendLoop(peer string, sendC chan []byte) {
+	var conn net.Conn
+	var failed time.Time
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+	for {
+		var frame []byte
+		select {
+		case <-t.done:
+			return
+		case frame = <-sendC:
+		}
+		if conn == nil {
+			if time.Since(failed) < dialTimeout {
+				continue
+			}
+			var err error
+			conn, err = net.DialTimeout("tcp", peer, dialTimeout)
+			if err != nil {
+				plog.Debug("tcp transport dial", "peer", peer, "err", err)
+				failed = time.Now()
+				continue
+			}
+		}
+		conn.SetWriteDeadline(time.Now().Add(dialTimeout))
+		if _, err := conn.Write(frame); err != nil {
+			plog.Debug("tcp transport write", "peer", peer, "err", err)
+			conn.Close()
+			conn = nil
+		}
+	}
+}
+
+func (t *tcpTransport) Recv() <-chan *types.Request {
+	return t.recvC
+}
+
+func (t *tcpTransport) Close() {
+	close(t.done)
+	t.listener.Close()
+}
@@ -0,0 +1,445 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"bytes"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	roleFollower = iota
+	roleCandidate
+	roleLeader
+)
+
+var stateKey = []byte("raft-state")
+
+// chain raft节点依赖的区块操作, 由Client实现. 区块链本身就是raft日志, 链高度即为已提交的日志下标
+type chain interface {
+	tip() *types.Block
+	blockHash(block *types.Block) []byte
+	writeBlock(block *types.Block) error
+	getBlock(height int64) (*types.Block, error)
+	members() []string
+	checkLeader(block *types.Block, leader string) error
+}
+
+// node raft状态机, 每个高度最多只有一个未提交的区块, 所有消息在run协程中串行处理
+type node struct {
+	self      string
+	chain     chain
+	transport Transport
+	db        dbm.DB
+	election  time.Duration
+	heartbeat time.Duration
+
+	mu       sync.Mutex
+	role     int
+	term     int64
+	votedFor string
+	leader   string
+	votes    map[string]bool
+	deadline time.Time
+
+	//未提交的区块及其任期
+	pending     *types.Block
+	pendingTerm int64
+	acks        map[string]bool
+	//leader记录的follower链高度
+	heights map[string]int64
+	//最近提交的区块在执行前的哈希, 用于follower确认自己待提交的区块
+	commitHash []byte
+
+	proposeC chan *types.Block
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newNode(self string, c chain, transport Transport, db dbm.DB, election, heartbeat time.Duration) *node {
+	n := &node{
+		self:      self,
+		chain:     c,
+		transport: transport,
+		db:        db,
+		election:  election,
+		heartbeat: heartbeat,
+		heights:   make(map[string]int64),
+		proposeC:  make(chan *types.Block, 1),
+		done:      make(chan struct{}),
+	}
+	if value, err := db.Get(stateKey); err == nil && value != nil {
+		var state types.RaftState
+		if err := types.Decode(value, &state); err == nil {
+			n.term = state.Term
+			n.votedFor = state.VotedFor
+		}
+	}
+	return n
+}
+
+func blockDigest(block *types.Block) []byte {
+	return common.Sha256(types.Encode(block))
+}
+
+func (n *node) start() {
+	n.resetDeadline()
+	n.wg.Add(1)
+	go n.run()
+}
+
+func (n *node) stop() {
+	close(n.done)
+	n.transport.Close()
+}
+
+func (n *node) run() {
+	defer n.wg.Done()
+	ticker := time.NewTicker(n.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.done:
+			return
+		case msg, ok := <-n.transport.Recv():
+			if !ok {
+				return
+			}
+			n.mu.Lock()
+			n.handle(msg)
+			n.mu.Unlock()
+		case block := <-n.proposeC:
+			n.mu.Lock()
+			n.handlePropose(block)
+			n.mu.Unlock()
+		case <-ticker.C:
+			n.mu.Lock()
+			n.tick()
+			n.mu.Unlock()
+		}
+	}
+}
+
+// persist 任期和投票必须在回复消息之前落盘
+func (n *node) persist() {
+	err := n.db.SetSync(stateKey, types.Encode(&types.RaftState{Term: n.term, VotedFor: n.votedFor}))
+	if err != nil {
+		rlog.Error("raft persist", "err", err)
+	}
+}
+
+func (n *node) resetDeadline() {
+	n.deadline = time.Now().Add(n.election + time.Duration(rand.Int63n(int64(n.election))))
+}
+
+func (n *node) isMember(members []string) bool {
+	for _, m := range members {
+		if m == n.self {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *node) send(to string, msg *types.RaftMessage) {
+	if to == n.self {
+		return
+	}
+	n.transport.Send(to, msg)
+}
+
+// canPropose 当前是leader并且没有正在复制的区块
+func (n *node) canPropose(height int64) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.role == roleLeader && n.pending == nil && height == n.chain.tip().Height+1
+}
+
+func (n *node) propose(block *types.Block) {
+	select {
+	case n.proposeC <- block:
+	case <-n.done:
+	}
+}
+
+func (n *node) getTerm() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.term
+}
+
+func (n *node) getLeader() string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.leader
+}
+
+func (n *node) handle(msg *types.RaftMessage) {
+	switch value := msg.GetValue().(type) {
+	case *types.RaftMessage_Vote:
+		n.handleVote(value.Vote)
+	case *types.RaftMessage_VoteReply:
+		n.handleVoteReply(value.VoteReply)
+	case *types.RaftMessage_Append:
+		n.handleAppend(value.Append)
+	case *types.RaftMessage_AppendReply:
+		n.handleAppendReply(value.AppendReply)
+	}
+}
+
+func (n *node) becomeFollower(term int64, leader string) {
+	if term > n.term {
+		n.term = term
+		n.votedFor = ""
+		n.persist()
+	}
+	if n.role == roleLeader && n.pending != nil {
+		//没有提交的区块交给新的leader决定
+		n.acks = nil
+	}
+	n.role = roleFollower
+	n.leader = leader
+}
+
+func (n *node) tick() {
+	members := n.chain.members()
+	if n.role == roleLeader {
+		if !n.isMember(members) {
+			rlog.Info("raft leader removed from members", "self", n.self)
+			n.becomeFollower(n.term, "")
+			return
+		}
+		n.broadcastAppend(members)
+		return
+	}
+	if time.Now().After(n.deadline) && n.isMember(members) {
+		n.startElection(members)
+	}
+}
+
+func (n *node) startElection(members []string) {
+	n.role = roleCandidate
+	n.term++
+	n.votedFor = n.self
+	n.leader = ""
+	n.persist()
+	n.votes = map[string]bool{n.self: true}
+	n.resetDeadline()
+	rlog.Info("raft start election", "self", n.self, "term", n.term)
+	vote := &types.RaftVote{Term: n.term, Candidate: n.self, Height: n.chain.tip().Height}
+	if n.pending != nil {
+		vote.Pending = true
+		vote.PendingTerm = n.pendingTerm
+	}
+	for _, m := range members {
+		n.send(m, &types.RaftMessage{Value: &types.RaftMessage_Vote{Vote: vote}})
+	}
+	n.checkVotes(members)
+}
+
+// upToDate 已提交的链高度优先, 高度相同时比较待提交区块的任期
+func (n *node) upToDate(vote *types.RaftVote) bool {
+	height := n.chain.tip().Height
+	if vote.Height != height {
+		return vote.Height > height
+	}
+	if n.pending == nil {
+		return true
+	}
+	return vote.Pending && vote.PendingTerm >= n.pendingTerm
+}
+
+func (n *node) handleVote(vote *types.RaftVote) {
+	if vote.Term > n.term {
+		n.becomeFollower(vote.Term, "")
+	}
+	reply := &types.RaftVoteReply{Term: n.term, From: n.self}
+	if vote.Term == n.term && (n.votedFor == "" || n.votedFor == vote.Candidate) && n.upToDate(vote) {
+		n.votedFor = vote.Candidate
+		n.persist()
+		n.resetDeadline()
+		reply.Granted = true
+	}
+	n.send(vote.Candidate, &types.RaftMessage{Value: &types.RaftMessage_VoteReply{VoteReply: reply}})
+}
+
+func (n *node) handleVoteReply(reply *types.RaftVoteReply) {
+	if reply.Term > n.term {
+		n.becomeFollower(reply.Term, "")
+		return
+	}
+	if n.role != roleCandidate || reply.Term != n.term || !reply.Granted {
+		return
+	}
+	n.votes[reply.From] = true
+	n.checkVotes(n.chain.members())
+}
+
+func (n *node) checkVotes(members []string) {
+	count := 0
+	for _, m := range members {
+		if n.votes[m] {
+			count++
+		}
+	}
+	if count > len(members)/2 {
+		n.becomeLeader(members)
+	}
+}
+
+func (n *node) becomeLeader(members []string) {
+	rlog.Info("raft become leader", "self", n.self, "term", n.term)
+	n.role = roleLeader
+	n.leader = n.self
+	n.heights = make(map[string]int64)
+	if n.pending != nil {
+		//上一任期没有提交的区块在当前任期重新复制
+		n.pendingTerm = n.term
+		n.acks = map[string]bool{n.self: true}
+	}
+	n.broadcastAppend(members)
+	n.tryCommit(members)
+}
+
+func (n *node) handlePropose(block *types.Block) {
+	//区块记录的任期和当前任期不一致时说明打包期间发生了选举
+	if n.role != roleLeader || n.pending != nil || block.Height != n.chain.tip().Height+1 || block.Version != n.term {
+		return
+	}
+	n.pending = block
+	n.pendingTerm = n.term
+	n.acks = map[string]bool{n.self: true}
+	members := n.chain.members()
+	n.broadcastAppend(members)
+	n.tryCommit(members)
+}
+
+// broadcastAppend 落后的follower先同步链上已提交的区块, 再复制待提交的区块
+func (n *node) broadcastAppend(members []string) {
+	tip := n.chain.tip()
+	for _, m := range members {
+		if m == n.self {
+			continue
+		}
+		msg := &types.RaftAppend{Term: n.term, Leader: n.self, CommitHeight: tip.Height, CommitHash: n.commitHash}
+		height, ok := n.heights[m]
+		if ok && height < tip.Height {
+			block, err := n.chain.getBlock(height + 1)
+			if err == nil {
+				msg.Block = block
+				msg.Committed = true
+			}
+		} else if ok && n.pending != nil && !n.acks[m] {
+			msg.Block = n.pending
+		}
+		n.send(m, &types.RaftMessage{Value: &types.RaftMessage_Append{Append: msg}})
+	}
+}
+
+func (n *node) handleAppend(msg *types.RaftAppend) {
+	reply := &types.RaftAppendReply{Term: n.term, From: n.self}
+	if msg.Term < n.term {
+		reply.Height = n.chain.tip().Height
+		n.send(msg.Leader, &types.RaftMessage{Value: &types.RaftMessage_AppendReply{AppendReply: reply}})
+		return
+	}
+	n.becomeFollower(msg.Term, msg.Leader)
+	n.resetDeadline()
+	reply.Term = n.term
+	reply.Success = true
+	tip := n.chain.tip()
+	if msg.Block != nil && msg.Block.Height > tip.Height {
+		if msg.Block.Height != tip.Height+1 || !bytes.Equal(msg.Block.ParentHash, n.chain.blockHash(tip)) {
+			reply.Success = false
+		} else if msg.Committed {
+			if err := n.chain.writeBlock(msg.Block); err != nil {
+				reply.Success = false
+			}
+			n.pending = nil
+		} else if !n.checkProposer(msg) {
+			reply.Success = false
+		} else {
+			n.pending = msg.Block
+			n.pendingTerm = msg.Term
+		}
+	}
+	//leader已经提交了本节点待提交的区块
+	if n.pending != nil && msg.CommitHeight >= n.pending.Height && bytes.Equal(msg.CommitHash, blockDigest(n.pending)) {
+		if err := n.chain.writeBlock(n.pending); err != nil {
+			reply.Success = false
+		}
+		n.pending = nil
+	}
+	if n.pending != nil && n.pending.Height <= n.chain.tip().Height {
+		n.pending = nil
+	}
+	reply.Height = n.chain.tip().Height
+	//只确认当前任期leader发来的区块, 之前任期遗留的区块需要leader重新发送
+	if n.pending != nil && n.pendingTerm == msg.Term {
+		reply.PendingHeight = n.pending.Height
+	}
+	n.send(msg.Leader, &types.RaftMessage{Value: &types.RaftMessage_AppendReply{AppendReply: reply}})
+}
+
+// checkProposer 当前任期的区块必须由leader签名, 之前任期遗留的区块由新的leader重新复制, 写入时再检查签名
+func (n *node) checkProposer(msg *types.RaftAppend) bool {
+	if msg.Block.Version > msg.Term {
+		return false
+	}
+	if msg.Block.Version == msg.Term {
+		if err := n.chain.checkLeader(msg.Block, msg.Leader); err != nil {
+			rlog.Error("raft append", "leader", msg.Leader, "height", msg.Block.Height, "err", err)
+			return false
+		}
+	}
+	return true
+}
+
+func (n *node) handleAppendReply(reply *types.RaftAppendReply) {
+	if reply.Term > n.term {
+		n.becomeFollower(reply.Term, "")
+		return
+	}
+	if n.role != roleLeader || reply.Term != n.term {
+		return
+	}
+	n.heights[reply.From] = reply.Height
+	if reply.Success && n.pending != nil && reply.PendingHeight == n.pending.Height {
+		n.acks[reply.From] = true
+		n.tryCommit(n.chain.members())
+	}
+}
+
+// tryCommit 多数节点收到区块后leader写入区块, follower在下一次心跳时写入
+func (n *node) tryCommit(members []string) {
+	if n.pending == nil {
+		return
+	}
+	count := 0
+	for _, m := range members {
+		if n.acks[m] {
+			count++
+		}
+	}
+	if count <= len(members)/2 {
+		return
+	}
+	digest := blockDigest(n.pending)
+	if err := n.chain.writeBlock(n.pending); err != nil {
+		rlog.Error("raft leader write block", "height", n.pending.Height, "err", err)
+		n.pending = nil
+		return
+	}
+	rlog.Info("raft committed", "term", n.term, "height", n.pending.Height)
+	n.commitHash = digest
+	n.pending = nil
+	n.broadcastAppend(n.chain.members())
+}
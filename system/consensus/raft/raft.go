@@ -0,0 +1,428 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package raft 基于raft选举和复制的共识, 区块链本身作为raft日志, 落后节点直接从链上同步已提交的区块
+package raft
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+var rlog = log.New("module", "raft")
+
+const (
+	defaultWaitTxMs        = 1000
+	defaultElectionTimeout = 3000
+	defaultHeartbeat       = 500
+)
+
+var (
+	errBlockSign = errors.New("ErrRaftBlockSign")
+	errBlockTerm = errors.New("ErrRaftBlockTerm")
+)
+
+// 通过manage合约修改共识节点, 每次只增加或删除一个节点
+const (
+	//ConfigAddPeers 在peersURL基础上增加的节点, 格式为 地址@公钥
+	ConfigAddPeers = "raft-add-peers"
+	//ConfigDelPeers 从peersURL中删除的节点
+	ConfigDelPeers = "raft-del-peers"
+)
+
+//Client raft共识客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg    *subConfig
+	node      *node
+	sleepTime time.Duration
+	//leader签名区块的私钥
+	priv crypto.PrivKey
+	//peersURL中节点的公钥
+	pubKeys map[string][]byte
+	//按区块高度缓存的共识节点列表和公钥, 状态机协程和CheckBlock都会访问
+	mu          sync.Mutex
+	peersHeight int64
+	peers       []string
+	peerKeys    map[string][]byte
+}
+
+func init() {
+	drivers.Reg("raft", New)
+	drivers.QueryData.Register("raft", &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	WaitTxMs         int64  `json:"waitTxMs"`
+	// 本节点的地址, 必须在共识节点列表中才参与选举
+	SelfURL string `json:"selfURL"`
+	// 初始的共识节点地址
+	PeersURL []string `json:"peersURL"`
+	// 本节点签名区块的私钥
+	PrivKey string `json:"privKey"`
+	// 初始共识节点的公钥, 和peersURL一一对应
+	PubKeys []string `json:"pubKeys"`
+	// 节点间的传输方式, tcp或local(同进程内测试使用)
+	Transport string `json:"transport"`
+	// 选举超时时间, 实际超时在[T, 2T)之间随机
+	ElectionTimeoutMs int64 `json:"electionTimeoutMs"`
+	// leader发送心跳的间隔
+	HeartbeatMs int64 `json:"heartbeatMs"`
+}
+
+//New new
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.WaitTxMs == 0 {
+		subcfg.WaitTxMs = defaultWaitTxMs
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	if subcfg.ElectionTimeoutMs == 0 {
+		subcfg.ElectionTimeoutMs = defaultElectionTimeout
+	}
+	if subcfg.HeartbeatMs == 0 {
+		subcfg.HeartbeatMs = defaultHeartbeat
+	}
+	if subcfg.SelfURL == "" || len(subcfg.PeersURL) == 0 {
+		panic("raft: selfURL and peersURL must be set")
+	}
+	if len(subcfg.PubKeys) != len(subcfg.PeersURL) {
+		panic("raft: pubKeys must match peersURL")
+	}
+	if subcfg.PrivKey == "" {
+		panic("raft: privKey must be set")
+	}
+	client := &Client{BaseClient: c, subcfg: &subcfg, sleepTime: time.Duration(subcfg.WaitTxMs) * time.Millisecond, peersHeight: -1}
+	if err := client.initKeys(); err != nil {
+		panic(err)
+	}
+	c.SetChild(client)
+	return client
+}
+
+//SetQueueClient 设置队列, 在创世区块写入后启动raft状态机
+//BaseClient.SetQueueClient 会启动 CreateBlock 协程, node 必须在这之前创建
+func (client *Client) SetQueueClient(q queue.Client) {
+	transport, err := newTransport(client.subcfg.Transport, client.subcfg.SelfURL)
+	if err != nil {
+		panic(err)
+	}
+	//任期和投票信息保存在区块数据库同级目录下
+	dbPath := q.GetConfig().GetModuleConfig().BlockChain.DbPath
+	db := dbm.NewDB("raftstate", "leveldb", filepath.Join(filepath.Dir(dbPath), "raftstate"), 16)
+	client.node = newNode(client.subcfg.SelfURL, client, transport, db,
+		time.Duration(client.subcfg.ElectionTimeoutMs)*time.Millisecond, time.Duration(client.subcfg.HeartbeatMs)*time.Millisecond)
+	client.BaseClient.SetQueueClient(q)
+	client.node.start()
+}
+
+//Close close
+func (client *Client) Close() {
+	if client.node != nil {
+		client.node.stop()
+	}
+	client.BaseClient.Close()
+	if client.node != nil {
+		//关闭队列后正在写入的区块会返回错误, 状态机协程可以退出
+		client.node.wg.Wait()
+		client.node.db.Close()
+	}
+	rlog.Info("consensus raft closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent false
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	return false
+}
+
+// initKeys 解析签名私钥和初始共识节点的公钥, 本节点在peersURL中时私钥必须和对应的公钥一致
+func (client *Client) initKeys() error {
+	c, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return err
+	}
+	client.pubKeys = make(map[string][]byte)
+	for i, peer := range client.subcfg.PeersURL {
+		raw, err := common.FromHex(client.subcfg.PubKeys[i])
+		if err != nil {
+			return err
+		}
+		if _, err := c.PubKeyFromBytes(raw); err != nil {
+			return err
+		}
+		client.pubKeys[peer] = raw
+	}
+	raw, err := common.FromHex(client.subcfg.PrivKey)
+	if err != nil {
+		return err
+	}
+	client.priv, err = c.PrivKeyFromBytes(raw)
+	if err != nil {
+		return err
+	}
+	if pub, ok := client.pubKeys[client.subcfg.SelfURL]; ok && !bytes.Equal(pub, client.priv.PubKey().Bytes()) {
+		return errors.New("raft: privKey does not match pubKeys")
+	}
+	return nil
+}
+
+//CheckBlock 没有交易的区块不合法, 区块必须由父区块高度上的共识节点签名, 区块的任期不能小于父区块
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	block := current.Block
+	if len(block.Txs) == 0 {
+		return types.ErrEmptyTx
+	}
+	if block.Version < parent.Version {
+		return errBlockTerm
+	}
+	sign := block.GetSignature()
+	if sign == nil || sign.Ty != types.SECP256K1 {
+		return errBlockSign
+	}
+	_, keys := client.membersAt(parent)
+	signed := false
+	for _, key := range keys {
+		if bytes.Equal(key, sign.Pubkey) {
+			signed = true
+			break
+		}
+	}
+	if !signed || !types.CheckSign(client.blockHash(block), "", sign) {
+		return errBlockSign
+	}
+	return nil
+}
+
+// signBlock leader预执行区块确定StateHash, 记录当前任期后对区块哈希签名
+func (client *Client) signBlock(lastBlock, block *types.Block, term int64) error {
+	qclient := client.GetQueueClient()
+	_, deltx, err := util.PreExecBlock(qclient, lastBlock.StateHash, block, false, false, false)
+	if err != nil {
+		return err
+	}
+	if len(deltx) > 0 {
+		list := &types.TxHashList{}
+		for _, tx := range deltx {
+			list.Hashes = append(list.Hashes, tx.Hash())
+		}
+		msg := qclient.NewMessage("mempool", types.EventDelTxList, list)
+		if err := qclient.Send(msg, false); err != nil {
+			return err
+		}
+	}
+	if len(block.Txs) == 0 {
+		return types.ErrEmptyTx
+	}
+	//Version参与区块哈希的计算, 签名同时确认了leader的任期
+	block.Version = term
+	block.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    client.priv.PubKey().Bytes(),
+		Signature: client.priv.Sign(client.blockHash(block)).Bytes(),
+	}
+	return nil
+}
+
+//CreateBlock leader打包区块并复制到其他节点, follower只等待leader的消息
+func (client *Client) CreateBlock() {
+	types.AssertConfig(client.GetAPI())
+	cfg := client.GetAPI().GetConfig()
+	for {
+		if client.IsClosed() {
+			break
+		}
+		lastBlock := client.GetCurrentBlock()
+		if !client.IsMining() || !client.node.canPropose(lastBlock.Height+1) {
+			time.Sleep(client.sleepTime)
+			continue
+		}
+		maxTxNum := int(cfg.GetP(lastBlock.Height + 1).MaxTxNumber)
+		txs := client.RequestTx(maxTxNum, nil)
+		txs = client.CheckTxDup(txs)
+		if len(txs) == 0 {
+			time.Sleep(client.sleepTime)
+			continue
+		}
+
+		var newblock types.Block
+		newblock.ParentHash = lastBlock.Hash(cfg)
+		newblock.Height = lastBlock.Height + 1
+		client.AddTxsToBlock(&newblock, txs)
+		newblock.Difficulty = cfg.GetP(0).PowLimitBits
+		//需要首先对交易进行排序然后再计算TxHash
+		if cfg.IsFork(newblock.GetHeight(), "ForkRootHash") {
+			newblock.Txs = types.TransactionSort(newblock.Txs)
+		}
+		newblock.TxHash = merkle.CalcMerkleRoot(cfg, newblock.Height, newblock.Txs)
+		newblock.BlockTime = types.Now().Unix()
+		if lastBlock.BlockTime >= newblock.BlockTime {
+			newblock.BlockTime = lastBlock.BlockTime + 1
+		}
+		if err := client.signBlock(lastBlock, &newblock, client.node.getTerm()); err != nil {
+			rlog.Error("CreateBlock signBlock", "height", newblock.Height, "err", err)
+			time.Sleep(client.sleepTime)
+			continue
+		}
+		client.node.propose(&newblock)
+	}
+}
+
+//CmpBestBlock 比较newBlock是不是最优区块
+func (client *Client) CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block) bool {
+	return false
+}
+
+func (client *Client) tip() *types.Block {
+	return client.GetCurrentBlock()
+}
+
+func (client *Client) blockHash(block *types.Block) []byte {
+	return block.Hash(client.GetAPI().GetConfig())
+}
+
+// writeBlock 执行并写入已经提交的区块, 已经通过区块同步写入的高度直接跳过
+func (client *Client) writeBlock(block *types.Block) error {
+	lastBlock := client.GetCurrentBlock()
+	if block.Height <= lastBlock.Height {
+		return nil
+	}
+	err := client.WriteBlock(lastBlock.StateHash, types.Clone(block).(*types.Block))
+	if err != nil {
+		rlog.Error("writeBlock", "height", block.Height, "err", err)
+	}
+	return err
+}
+
+func (client *Client) getBlock(height int64) (*types.Block, error) {
+	return client.RequestBlock(height)
+}
+
+// checkLeader 待提交的区块必须由leader签名
+func (client *Client) checkLeader(block *types.Block, leader string) error {
+	_, keys := client.membersAt(client.GetCurrentBlock())
+	key, ok := keys[leader]
+	if !ok || !bytes.Equal(key, block.GetSignature().GetPubkey()) {
+		return errBlockSign
+	}
+	return nil
+}
+
+// members 当前共识节点列表
+func (client *Client) members() []string {
+	peers, _ := client.membersAt(client.GetCurrentBlock())
+	return peers
+}
+
+// membersAt 区块高度上的共识节点列表和公钥, peersURL加上链上manage配置增加的节点, 再去掉配置删除的节点
+func (client *Client) membersAt(block *types.Block) ([]string, map[string][]byte) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if block.Height == client.peersHeight {
+		return client.peers, client.peerKeys
+	}
+	cfg := client.GetAPI().GetConfig()
+	keys := [][]byte{
+		[]byte(cfg.ManaeKeyWithHeigh(ConfigAddPeers, block.Height)),
+		[]byte(cfg.ManaeKeyWithHeigh(ConfigDelPeers, block.Height)),
+	}
+	reply, err := client.GetAPI().StoreGet(&types.StoreGet{StateHash: block.StateHash, Keys: keys})
+	if err != nil {
+		rlog.Error("members", "height", block.Height, "err", err)
+		return client.subcfg.PeersURL, client.pubKeys
+	}
+	configs := make([][]string, len(keys))
+	for i, value := range reply.GetValues() {
+		if i >= len(keys) || len(value) == 0 {
+			continue
+		}
+		var item types.ConfigItem
+		if err := types.Decode(value, &item); err != nil {
+			rlog.Error("members", "key", string(keys[i]), "err", err)
+			continue
+		}
+		configs[i] = item.GetArr().GetValue()
+	}
+	skip := make(map[string]bool)
+	for _, peer := range configs[1] {
+		skip[peer] = true
+	}
+	pubKeys := make(map[string][]byte)
+	for peer, key := range client.pubKeys {
+		pubKeys[peer] = key
+	}
+	added := make([]string, 0, len(configs[0]))
+	for _, item := range configs[0] {
+		peer := item
+		if i := strings.LastIndex(item, "@"); i >= 0 {
+			peer = item[:i]
+			key, err := common.FromHex(item[i+1:])
+			if err != nil {
+				rlog.Error("members", "peer", item, "err", err)
+				continue
+			}
+			pubKeys[peer] = key
+		}
+		added = append(added, peer)
+	}
+	var peers []string
+	peerKeys := make(map[string][]byte)
+	for _, peer := range append(append([]string{}, client.subcfg.PeersURL...), added...) {
+		if !skip[peer] {
+			skip[peer] = true
+			peers = append(peers, peer)
+			if key, ok := pubKeys[peer]; ok {
+				peerKeys[peer] = key
+			}
+		}
+	}
+	client.peersHeight = block.Height
+	client.peers = peers
+	client.peerKeys = peerKeys
+	return peers, peerKeys
+}
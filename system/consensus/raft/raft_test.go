@@ -0,0 +1,307 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	//加载系统内置store, 不要依赖plugin
+	_ "github.com/33cn/chain33/system/dapp/init"
+	_ "github.com/33cn/chain33/system/mempool/init"
+	_ "github.com/33cn/chain33/system/store/init"
+)
+
+type mockChain struct {
+	mu     sync.Mutex
+	blocks []*types.Block
+	peers  []string
+}
+
+func newMockChain(peers []string) *mockChain {
+	return &mockChain{blocks: []*types.Block{{Height: 0}}, peers: peers}
+}
+
+func (m *mockChain) tip() *types.Block {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.blocks[len(m.blocks)-1]
+}
+
+func (m *mockChain) blockHash(block *types.Block) []byte {
+	return blockDigest(block)
+}
+
+func (m *mockChain) writeBlock(block *types.Block) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blocks = append(m.blocks, types.Clone(block).(*types.Block))
+	return nil
+}
+
+func (m *mockChain) getBlock(height int64) (*types.Block, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if height >= int64(len(m.blocks)) {
+		return nil, types.ErrBlockNotFound
+	}
+	return m.blocks[height], nil
+}
+
+func (m *mockChain) members() []string {
+	return m.peers
+}
+
+func (m *mockChain) checkLeader(block *types.Block, leader string) error {
+	return nil
+}
+
+func (m *mockChain) height() int64 {
+	return m.tip().Height
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	for i := 0; i < 300; i++ {
+		if cond() {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("wait timeout")
+}
+
+func newPeers(prefix string, n int) []string {
+	peers := make([]string, n)
+	for i := range peers {
+		peers[i] = fmt.Sprintf("%s-%d", prefix, i)
+	}
+	return peers
+}
+
+func findLeader(nodes []*node) *node {
+	for _, n := range nodes {
+		if n != nil && n.getLeader() == n.self {
+			return n
+		}
+	}
+	return nil
+}
+
+func TestNodeElectAndReplicate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raft")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	peers := newPeers("raft", 3)
+	chains := make([]*mockChain, len(peers))
+	nodes := make([]*node, len(peers))
+	for i, peer := range peers {
+		chains[i] = newMockChain(peers)
+		db := dbm.NewDB(peer, "leveldb", dir, 16)
+		nodes[i] = newNode(peer, chains[i], newLocalTransport(peer), db, 300*time.Millisecond, 50*time.Millisecond)
+		nodes[i].start()
+	}
+	defer func() {
+		for _, n := range nodes {
+			if n != nil {
+				n.stop()
+			}
+		}
+	}()
+
+	propose := func(n *node, chain *mockChain) {
+		tip := chain.tip()
+		block := &types.Block{Height: tip.Height + 1, ParentHash: blockDigest(tip), Txs: []*types.Transaction{{Execer: []byte("none")}}}
+		waitFor(t, func() bool { return n.canPropose(block.Height) })
+		block.Version = n.getTerm()
+		n.propose(block)
+	}
+	waitHeight := func(chains []*mockChain, height int64) {
+		waitFor(t, func() bool {
+			for _, c := range chains {
+				if c.height() < height {
+					return false
+				}
+			}
+			return true
+		})
+		for _, c := range chains[1:] {
+			assert.Equal(t, blockDigest(chains[0].blocks[height]), blockDigest(c.blocks[height]))
+		}
+	}
+
+	var leader *node
+	waitFor(t, func() bool {
+		leader = findLeader(nodes)
+		return leader != nil
+	})
+	index := 0
+	for i, n := range nodes {
+		if n == leader {
+			index = i
+		}
+	}
+	propose(leader, chains[index])
+	waitHeight(chains, 1)
+
+	//leader失效后剩余的两个节点重新选举
+	leader.stop()
+	nodes[index] = nil
+	var rest []*mockChain
+	for i, c := range chains {
+		if i != index {
+			rest = append(rest, c)
+		}
+	}
+	waitFor(t, func() bool {
+		leader = findLeader(nodes)
+		return leader != nil
+	})
+	for i, n := range nodes {
+		if n == leader {
+			index = i
+		}
+	}
+	propose(leader, chains[index])
+	waitHeight(rest, 2)
+	assert.True(t, leader.term > 1)
+}
+
+func TestUpToDate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raft")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	chain := newMockChain([]string{"a"})
+	chain.writeBlock(&types.Block{Height: 1})
+	n := newNode("a", chain, newLocalTransport("uptodate-a"), dbm.NewDB("a", "leveldb", dir, 16), time.Second, time.Second)
+	assert.False(t, n.upToDate(&types.RaftVote{Height: 0}))
+	assert.True(t, n.upToDate(&types.RaftVote{Height: 2}))
+	assert.True(t, n.upToDate(&types.RaftVote{Height: 1}))
+
+	n.pending = &types.Block{Height: 2}
+	n.pendingTerm = 3
+	assert.False(t, n.upToDate(&types.RaftVote{Height: 1}))
+	assert.False(t, n.upToDate(&types.RaftVote{Height: 1, Pending: true, PendingTerm: 2}))
+	assert.True(t, n.upToDate(&types.RaftVote{Height: 1, Pending: true, PendingTerm: 3}))
+
+	//任期和投票重启后恢复
+	n.term = 5
+	n.votedFor = "b"
+	n.persist()
+	n2 := newNode("a", chain, n.transport, n.db, time.Second, time.Second)
+	assert.Equal(t, int64(5), n2.term)
+	assert.Equal(t, "b", n2.votedFor)
+	n.transport.Close()
+}
+
+const raftSubCfg = `
+[consensus.sub.raft]
+genesis="14KEKbYtKKQm4wMthSK9J4La4nAiidGozt"
+genesisBlockTime=1514533394
+waitTxMs=100
+selfURL="%s"
+peersURL=["%s"]
+privKey="%s"
+pubKeys=["%s"]
+transport="local"
+electionTimeoutMs=1000
+heartbeatMs=100
+`
+
+func TestRaft(t *testing.T) {
+	peers := newPeers("node", 3)
+	c, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	privs := make([]string, len(peers))
+	pubs := make([]string, len(peers))
+	for i := range peers {
+		priv, err := c.GenKey()
+		assert.Nil(t, err)
+		privs[i] = common.ToHex(priv.Bytes())
+		pubs[i] = common.ToHex(priv.PubKey().Bytes())
+	}
+	nodes := make([]*testnode.Chain33Mock, len(peers))
+	for i, peer := range peers {
+		cfgstring := strings.Replace(types.GetDefaultCfgstring(), `name="solo"`, `name="raft"`, 1)
+		cfgstring += fmt.Sprintf(raftSubCfg, peer, strings.Join(peers, `","`), privs[i], strings.Join(pubs, `","`))
+		nodes[i] = testnode.NewWithConfig(types.NewChain33Config(cfgstring), nil)
+	}
+	defer func() {
+		for _, node := range nodes[1:] {
+			node.Close()
+		}
+	}()
+	cfg := nodes[0].GetClient().GetConfig()
+	waitHeight := func(nodes []*testnode.Chain33Mock, height int64) {
+		waitFor(t, func() bool {
+			for _, node := range nodes {
+				header, err := node.GetAPI().GetLastHeader()
+				if err != nil || header.Height < height {
+					return false
+				}
+			}
+			return true
+		})
+		hash := nodes[0].GetBlock(height).Hash(cfg)
+		for _, node := range nodes[1:] {
+			assert.Equal(t, hash, node.GetBlock(height).Hash(cfg))
+		}
+	}
+	sendTxs := func(nodes []*testnode.Chain33Mock) {
+		//交易正常情况下通过p2p广播到所有节点
+		txs := util.GenNoneTxs(cfg, nodes[0].GetGenesisKey(), 5)
+		for _, node := range nodes {
+			for _, tx := range txs {
+				_, err := node.GetAPI().SendTx(tx)
+				assert.Nil(t, err)
+			}
+		}
+	}
+
+	sendTxs(nodes)
+	waitHeight(nodes, 1)
+
+	//区块由leader签名并记录任期
+	block := nodes[1].GetBlock(1)
+	assert.True(t, block.Version >= 1)
+	assert.Contains(t, pubs, common.ToHex(block.GetSignature().GetPubkey()))
+	assert.Nil(t, util.CheckBlock(nodes[1].GetClient(), &types.BlockDetail{Block: block}))
+	//非共识节点签名的区块不合法
+	fake := types.Clone(block).(*types.Block)
+	fake.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    nodes[0].GetGenesisKey().PubKey().Bytes(),
+		Signature: nodes[0].GetGenesisKey().Sign(fake.Hash(cfg)).Bytes(),
+	}
+	err = util.CheckBlock(nodes[1].GetClient(), &types.BlockDetail{Block: fake})
+	assert.Equal(t, errBlockSign.Error(), err.Error())
+	fake.Signature = nil
+	err = util.CheckBlock(nodes[1].GetClient(), &types.BlockDetail{Block: fake})
+	assert.Equal(t, errBlockSign.Error(), err.Error())
+	//签名和区块内容不一致
+	fake.Signature = types.Clone(block.Signature).(*types.Signature)
+	fake.BlockTime++
+	err = util.CheckBlock(nodes[1].GetClient(), &types.BlockDetail{Block: fake})
+	assert.Equal(t, errBlockSign.Error(), err.Error())
+
+	//关闭一个节点, 剩余2个节点仍然是多数, 如果关闭的是leader会重新选举
+	nodes[0].Close()
+	sendTxs(nodes[1:])
+	waitHeight(nodes[1:], 2)
+}
@@ -0,0 +1,202 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	recvBufferSize = 1024
+	maxMessageSize = 2 * types.MaxBlockSize
+	dialTimeout    = 3 * time.Second
+)
+
+var errMessageTooLarge = errors.New("ErrRaftMessageTooLarge")
+
+// Transport raft节点间的消息传输, 按节点地址发送, 消息丢失由心跳和选举重试
+type Transport interface {
+	Send(to string, msg *types.RaftMessage)
+	Recv() <-chan *types.RaftMessage
+	Close()
+}
+
+func newTransport(name string, self string) (Transport, error) {
+	switch name {
+	case "", "tcp":
+		return newTCPTransport(self)
+	case "local":
+		return newLocalTransport(self), nil
+	}
+	return nil, types.ErrNotSupport
+}
+
+// localTransport 同一进程内多个节点之间的传输, 用于测试
+type localTransport struct {
+	self  string
+	recvC chan *types.RaftMessage
+}
+
+var (
+	localMu  sync.Mutex
+	localNet = make(map[string]*localTransport)
+)
+
+func newLocalTransport(self string) *localTransport {
+	t := &localTransport{self: self, recvC: make(chan *types.RaftMessage, recvBufferSize)}
+	localMu.Lock()
+	localNet[self] = t
+	localMu.Unlock()
+	return t
+}
+
+func (t *localTransport) Send(to string, msg *types.RaftMessage) {
+	localMu.Lock()
+	defer localMu.Unlock()
+	dst, ok := localNet[to]
+	if !ok {
+		return
+	}
+	select {
+	case dst.recvC <- types.Clone(msg).(*types.RaftMessage):
+	default:
+		rlog.Error("local transport drop message", "to", to)
+	}
+}
+
+func (t *localTransport) Recv() <-chan *types.RaftMessage {
+	return t.recvC
+}
+
+func (t *localTransport) Close() {
+	localMu.Lock()
+	delete(localNet, t.self)
+	localMu.Unlock()
+}
+
+// tcpTransport 节点之间使用长连接, 消息格式为4字节长度加protobuf编码的RaftMessage
+type tcpTransport struct {
+	listener net.Listener
+	recvC    chan *types.RaftMessage
+	mu       sync.Mutex
+	conns    map[string]net.Conn
+	//连接失败的节点在dialTimeout内不再重连, 避免阻塞状态机
+	failed map[string]time.Time
+	done   chan struct{}
+}
+
+func newTCPTransport(self string) (*tcpTransport, error) {
+	listener, err := net.Listen("tcp", self)
+	if err != nil {
+		return nil, err
+	}
+	t := &tcpTransport{
+		listener: listener,
+		recvC:    make(chan *types.RaftMessage, recvBufferSize),
+		conns:    make(map[string]net.Conn),
+		failed:   make(map[string]time.Time),
+		done:     make(chan struct{}),
+	}
+	go t.accept()
+	return t, nil
+}
+
+func (t *tcpTransport) accept() {
+	for {
+		conn, err := t.listener.Accept()
+		if err != nil {
+			select {
+			case <-t.done:
+				return
+			default:
+			}
+			rlog.Error("tcp transport accept", "err", err)
+			continue
+		}
+		go t.readLoop(conn)
+	}
+}
+
+func (t *tcpTransport) readLoop(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	var head [4]byte
+	for {
+		if _, err := io.ReadFull(reader, head[:]); err != nil {
+			return
+		}
+		size := binary.BigEndian.Uint32(head[:])
+		if size > maxMessageSize {
+			rlog.Error("tcp transport read", "remote", conn.RemoteAddr(), "err", errMessageTooLarge)
+			return
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return
+		}
+		msg := &types.RaftMessage{}
+		if err := types.Decode(data, msg); err != nil {
+			rlog.Error("tcp transport decode", "remote", conn.RemoteAddr(), "err", err)
+			return
+		}
+		select {
+		case t.recvC <- msg:
+		case <-t.done:
+			return
+		}
+	}
+}
+
+func (t *tcpTransport) Send(to string, msg *types.RaftMessage) {
+	data := types.Encode(msg)
+	frame := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	conn, ok := t.conns[to]
+	if !ok {
+		if time.Since(t.failed[to]) < dialTimeout {
+			return
+		}
+		var err error
+		conn, err = net.DialTimeout("tcp", to, dialTimeout)
+		if err != nil {
+			rlog.Debug("tcp transport dial", "peer", to, "err", err)
+			t.failed[to] = time.Now()
+			return
+		}
+		t.conns[to] = conn
+	}
+	conn.SetWriteDeadline(time.Now().Add(dialTimeout))
+	if _, err := conn.Write(frame); err != nil {
+		rlog.Debug("tcp transport write", "peer", to, "err", err)
+		conn.Close()
+		delete(t.conns, to)
+	}
+}
+
+func (t *tcpTransport) Recv() <-chan *types.RaftMessage {
+	return t.recvC
+}
+
+func (t *tcpTransport) Close() {
+	close(t.done)
+	t.listener.Close()
+	t.mu.Lock()
+	for peer, conn := range t.conns {
+		conn.Close()
+		delete(t.conns, peer)
+	}
+	t.mu.Unlock()
+}
@@ -0,0 +1,348 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bls BLS12-381签名, 使用标准的BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_套件,
+// 签名在G1, 公钥在G2, 支持聚合签名. 底层使用circl的常数时间实现
+package bls
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/cloudflare/circl/ecc/bls12381"
+)
+
+//const
+const (
+	BLSPrivateKeyLength = bls12381.ScalarSize
+	BLSPublicKeyLength  = bls12381.G2SizeCompressed
+	BLSSignatureLength  = bls12381.G1SizeCompressed
+)
+
+//签名消息映射到G1时使用的域分隔标签, 即标准的ciphersuite ID
+var dst = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+
+//error
+var (
+	ErrAggregateEmpty   = errors.New("ErrBLSAggregateEmpty")
+	ErrAggregateVerify  = errors.New("ErrBLSAggregateVerify")
+	ErrAggregateLength  = errors.New("ErrBLSAggregateLength")
+	ErrDuplicateMessage = errors.New("ErrBLSDuplicateMessage")
+)
+
+//Driver 驱动
+type Driver struct{}
+
+//GenKey 生成私钥
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	//64字节随机数取模, 保证私钥分布均匀
+	for {
+		var k bls12381.Scalar
+		k.SetBytes(crypto.CRandBytes(64))
+		if k.IsZero() == 0 {
+			return newPrivKey(&k), nil
+		}
+	}
+}
+
+//PrivKeyFromBytes 字节转为私钥
+func (d Driver) PrivKeyFromBytes(b []byte) (privKey crypto.PrivKey, err error) {
+	var k bls12381.Scalar
+	if len(b) != BLSPrivateKeyLength || k.UnmarshalBinary(b) != nil || k.IsZero() == 1 {
+		return nil, errors.New("invalid bls priv key byte")
+	}
+	return newPrivKey(&k), nil
+}
+
+//PubKeyFromBytes 字节转为公钥
+func (d Driver) PubKeyFromBytes(b []byte) (pubKey crypto.PubKey, err error) {
+	if len(b) != BLSPublicKeyLength {
+		return nil, errors.New("invalid bls pub key byte")
+	}
+	pubKeyBytes := new([BLSPublicKeyLength]byte)
+	copy(pubKeyBytes[:], b[:])
+	return PubKeyBLS(*pubKeyBytes), nil
+}
+
+//SignatureFromBytes 字节转为签名
+func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
+	if len(b) != BLSSignatureLength {
+		return nil, errors.New("invalid bls signature byte")
+	}
+	sigBytes := new([BLSSignatureLength]byte)
+	copy(sigBytes[:], b[:])
+	return SignatureBLS(*sigBytes), nil
+}
+
+//Aggregate 聚合多个签名
+func (d Driver) Aggregate(sigs []crypto.Signature) (crypto.Signature, error) {
+	if len(sigs) == 0 {
+		return nil, ErrAggregateEmpty
+	}
+	agg := new(bls12381.G1)
+	agg.SetIdentity()
+	for _, sig := range sigs {
+		p, err := toG1(sig)
+		if err != nil {
+			return nil, err
+		}
+		agg.Add(agg, p)
+	}
+	var sig SignatureBLS
+	copy(sig[:], agg.BytesCompressed())
+	return sig, nil
+}
+
+//AggregatePublic 聚合多个公钥
+func (d Driver) AggregatePublic(pubs []crypto.PubKey) (crypto.PubKey, error) {
+	agg, err := aggregatePublic(pubs)
+	if err != nil {
+		return nil, err
+	}
+	var pub PubKeyBLS
+	copy(pub[:], agg.BytesCompressed())
+	return pub, nil
+}
+
+//VerifyAggregatedOne 验证多个公钥对同一消息的聚合签名.
+//相同消息的聚合需要防止恶意公钥攻击, 调用者需要保证每个公钥都证明过拥有私钥(例如共识节点的注册公钥)
+func (d Driver) VerifyAggregatedOne(pubs []crypto.PubKey, m []byte, sig crypto.Signature) error {
+	agg, err := aggregatePublic(pubs)
+	if err != nil {
+		return err
+	}
+	p, err := toG1(sig)
+	if err != nil {
+		return err
+	}
+	if !verify(agg, m, p) {
+		return ErrAggregateVerify
+	}
+	return nil
+}
+
+//VerifyAggregatedN 验证多个公钥对各自消息的聚合签名, 消息不能重复
+func (d Driver) VerifyAggregatedN(pubs []crypto.PubKey, ms [][]byte, sig crypto.Signature) error {
+	if len(pubs) == 0 {
+		return ErrAggregateEmpty
+	}
+	if len(pubs) != len(ms) {
+		return ErrAggregateLength
+	}
+	seen := make(map[string]bool)
+	for _, m := range ms {
+		if seen[string(m)] {
+			return ErrDuplicateMessage
+		}
+		seen[string(m)] = true
+	}
+	p, err := toG1(sig)
+	if err != nil {
+		return err
+	}
+	// e(sig, g2) * prod(e(H(m_i), pk_i))^-1 == 1
+	g1s := []*bls12381.G1{p}
+	g2s := []*bls12381.G2{bls12381.G2Generator()}
+	signs := []int{1}
+	for i, pub := range pubs {
+		pk, err := toG2(pub)
+		if err != nil {
+			return err
+		}
+		g1s = append(g1s, hashToG1(ms[i], dst))
+		g2s = append(g2s, pk)
+		signs = append(signs, -1)
+	}
+	if !bls12381.ProdPairFrac(g1s, g2s, signs).IsIdentity() {
+		return ErrAggregateVerify
+	}
+	return nil
+}
+
+//hashToG1 标准的hash_to_curve(RFC 9380), 使用SSWU映射的随机预言机版本
+func hashToG1(m, dst []byte) *bls12381.G1 {
+	p := new(bls12381.G1)
+	p.Hash(m, dst)
+	return p
+}
+
+// toG2 公钥必须在G2的子群中, 并且不能是无穷远点
+func toG2(pub crypto.PubKey) (*bls12381.G2, error) {
+	pk, ok := pub.(PubKeyBLS)
+	if !ok {
+		return nil, errors.New("invalid bls pub key")
+	}
+	p := new(bls12381.G2)
+	if err := p.SetBytes(pk[:]); err != nil {
+		return nil, err
+	}
+	if p.IsIdentity() {
+		return nil, errors.New("invalid bls pub key")
+	}
+	return p, nil
+}
+
+// toG1 签名必须在G1的子群中, 并且不能是无穷远点
+func toG1(sig crypto.Signature) (*bls12381.G1, error) {
+	s, ok := sig.(SignatureBLS)
+	if !ok {
+		return nil, errors.New("invalid bls signature")
+	}
+	p := new(bls12381.G1)
+	if err := p.SetBytes(s[:]); err != nil {
+		return nil, err
+	}
+	if p.IsIdentity() {
+		return nil, errors.New("invalid bls signature")
+	}
+	return p, nil
+}
+
+func aggregatePublic(pubs []crypto.PubKey) (*bls12381.G2, error) {
+	if len(pubs) == 0 {
+		return nil, ErrAggregateEmpty
+	}
+	agg := new(bls12381.G2)
+	agg.SetIdentity()
+	for _, pub := range pubs {
+		p, err := toG2(pub)
+		if err != nil {
+			return nil, err
+		}
+		agg.Add(agg, p)
+	}
+	return agg, nil
+}
+
+// verify e(sig, g2) == e(H(m), pk)
+func verify(pk *bls12381.G2, m []byte, sig *bls12381.G1) bool {
+	if pk.IsIdentity() {
+		return false
+	}
+	return bls12381.ProdPairFrac([]*bls12381.G1{sig, hashToG1(m, dst)},
+		[]*bls12381.G2{bls12381.G2Generator(), pk}, []int{1, -1}).IsIdentity()
+}
+
+//PrivKeyBLS PrivKey
+type PrivKeyBLS [BLSPrivateKeyLength]byte
+
+func newPrivKey(k *bls12381.Scalar) PrivKeyBLS {
+	var priv PrivKeyBLS
+	b, _ := k.MarshalBinary()
+	copy(priv[:], b)
+	return priv
+}
+
+func (privKey PrivKeyBLS) scalar() *bls12381.Scalar {
+	k := new(bls12381.Scalar)
+	k.SetBytes(privKey[:])
+	return k
+}
+
+//Bytes 字节格式
+func (privKey PrivKeyBLS) Bytes() []byte {
+	s := make([]byte, BLSPrivateKeyLength)
+	copy(s, privKey[:])
+	return s
+}
+
+//Sign 签名
+func (privKey PrivKeyBLS) Sign(msg []byte) crypto.Signature {
+	p := hashToG1(msg, dst)
+	p.ScalarMult(privKey.scalar(), p)
+	var sig SignatureBLS
+	copy(sig[:], p.BytesCompressed())
+	return sig
+}
+
+//PubKey 公钥
+func (privKey PrivKeyBLS) PubKey() crypto.PubKey {
+	p := new(bls12381.G2)
+	p.ScalarMult(privKey.scalar(), bls12381.G2Generator())
+	var pub PubKeyBLS
+	copy(pub[:], p.BytesCompressed())
+	return pub
+}
+
+//Equals 相等
+func (privKey PrivKeyBLS) Equals(other crypto.PrivKey) bool {
+	if otherBLS, ok := other.(PrivKeyBLS); ok {
+		return bytes.Equal(privKey[:], otherBLS[:])
+	}
+	return false
+}
+
+//PubKeyBLS PubKey
+type PubKeyBLS [BLSPublicKeyLength]byte
+
+//Bytes 字节格式
+func (pubKey PubKeyBLS) Bytes() []byte {
+	s := make([]byte, BLSPublicKeyLength)
+	copy(s, pubKey[:])
+	return s
+}
+
+//VerifyBytes 验证字节
+func (pubKey PubKeyBLS) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	pk, err := toG2(pubKey)
+	if err != nil {
+		return false
+	}
+	p, err := toG1(sig)
+	if err != nil {
+		return false
+	}
+	return verify(pk, msg, p)
+}
+
+//KeyString 公钥字符串格式
+func (pubKey PubKeyBLS) KeyString() string {
+	return fmt.Sprintf("%X", pubKey[:])
+}
+
+//Equals 相等
+func (pubKey PubKeyBLS) Equals(other crypto.PubKey) bool {
+	if otherBLS, ok := other.(PubKeyBLS); ok {
+		return bytes.Equal(pubKey[:], otherBLS[:])
+	}
+	return false
+}
+
+//SignatureBLS Signature
+type SignatureBLS [BLSSignatureLength]byte
+
+//Bytes 字节格式
+func (sig SignatureBLS) Bytes() []byte {
+	s := make([]byte, BLSSignatureLength)
+	copy(s, sig[:])
+	return s
+}
+
+//IsZero 是否是0
+func (sig SignatureBLS) IsZero() bool { return len(sig) == 0 }
+
+func (sig SignatureBLS) String() string {
+	return fmt.Sprintf("/%X.../", sig[:])
+}
+
+//Equals 相等
+func (sig SignatureBLS) Equals(other crypto.Signature) bool {
+	if otherBLS, ok := other.(SignatureBLS); ok {
+		return bytes.Equal(sig[:], otherBLS[:])
+	}
+	return false
+}
+
+//const
+const (
+	Name = "bls"
+	ID   = 6
+)
+
+func init() {
+	crypto.Register(Name, &Driver{}, false)
+	crypto.RegisterType(Name, ID)
+}
@@ -0,0 +1,130 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/cloudflare/circl/ecc/bls12381"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignVerify(t *testing.T) {
+	c, err := crypto.New(Name)
+	assert.Nil(t, err)
+	priv, err := c.GenKey()
+	assert.Nil(t, err)
+	priv2, err := c.PrivKeyFromBytes(priv.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, priv.Equals(priv2))
+	_, err = c.PrivKeyFromBytes(make([]byte, BLSPrivateKeyLength))
+	assert.NotNil(t, err)
+
+	msg := []byte("hello bls")
+	sig := priv.Sign(msg)
+	pub, err := c.PubKeyFromBytes(priv.PubKey().Bytes())
+	assert.Nil(t, err)
+	sig2, err := c.SignatureFromBytes(sig.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, pub.VerifyBytes(msg, sig2))
+	assert.False(t, pub.VerifyBytes([]byte("hello"), sig2))
+
+	other, _ := c.GenKey()
+	assert.False(t, other.PubKey().VerifyBytes(msg, sig))
+}
+
+func TestAggregate(t *testing.T) {
+	c, err := crypto.New(Name)
+	assert.Nil(t, err)
+	aggr, err := crypto.ToAggregate(c)
+	assert.Nil(t, err)
+
+	var pubs []crypto.PubKey
+	var sigsOne, sigsN []crypto.Signature
+	var msgs [][]byte
+	msg := []byte("block hash")
+	for i := 0; i < 3; i++ {
+		priv, _ := c.GenKey()
+		pubs = append(pubs, priv.PubKey())
+		sigsOne = append(sigsOne, priv.Sign(msg))
+		msgs = append(msgs, []byte{byte(i)})
+		sigsN = append(sigsN, priv.Sign(msgs[i]))
+	}
+
+	sig, err := aggr.Aggregate(sigsOne)
+	assert.Nil(t, err)
+	assert.Nil(t, aggr.VerifyAggregatedOne(pubs, msg, sig))
+	assert.Equal(t, ErrAggregateVerify, aggr.VerifyAggregatedOne(pubs[:2], msg, sig))
+	aggPub, err := aggr.AggregatePublic(pubs)
+	assert.Nil(t, err)
+	assert.True(t, aggPub.VerifyBytes(msg, sig))
+
+	sig, err = aggr.Aggregate(sigsN)
+	assert.Nil(t, err)
+	assert.Nil(t, aggr.VerifyAggregatedN(pubs, msgs, sig))
+	msgs[2] = []byte{9}
+	assert.Equal(t, ErrAggregateVerify, aggr.VerifyAggregatedN(pubs, msgs, sig))
+	msgs[2] = msgs[0]
+	assert.Equal(t, ErrDuplicateMessage, aggr.VerifyAggregatedN(pubs, msgs, sig))
+	assert.Equal(t, ErrAggregateLength, aggr.VerifyAggregatedN(pubs, msgs[:2], sig))
+
+	_, err = aggr.Aggregate(nil)
+	assert.Equal(t, ErrAggregateEmpty, err)
+}
+
+//RFC 9380 附录J.9.1 BLS12381G1_XMD:SHA-256_SSWU_RO_ 测试向量
+func TestHashToG1Vectors(t *testing.T) {
+	quux := []byte("QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_")
+	vectors := []struct {
+		msg, x, y string
+	}{
+		{"",
+			"052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1",
+			"08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265"},
+		{"abc",
+			"03567bc5ef9c690c2ab2ecdf6a96ef1c139cc0b2f284dca0a9a7943388a49a3aee664ba5379a7655d3c68900be2f6903",
+			"0b9c15f3fe6e5cf4211f346271d7b01c8f3b28be689c8429c85b67af215533311f0b8dfaaa154fa6b88176c229f2885d"},
+		{"abcdef0123456789",
+			"11e0b079dea29a68f0383ee94fed1b940995272407e3bb916bbf268c263ddd57a6a27200a784cbc248e84f357ce82d98",
+			"03a87ae2caf14e8ee52e51fa2ed8eefe80f02457004ba4d486d6aa1f517c0889501dc7413753f9599b099ebcbbd2d709"},
+	}
+	for _, v := range vectors {
+		p := hashToG1([]byte(v.msg), quux)
+		assert.Equal(t, v.x+v.y, hex.EncodeToString(p.Bytes()), v.msg)
+	}
+}
+
+func TestInvalidPoints(t *testing.T) {
+	c, err := crypto.New(Name)
+	assert.Nil(t, err)
+	priv, _ := c.GenKey()
+	msg := []byte("hello bls")
+
+	//无穷远点的公钥和签名
+	var g1 bls12381.G1
+	g1.SetIdentity()
+	var g2 bls12381.G2
+	g2.SetIdentity()
+	sig, err := c.SignatureFromBytes(g1.BytesCompressed())
+	assert.Nil(t, err)
+	pub, err := c.PubKeyFromBytes(g2.BytesCompressed())
+	assert.Nil(t, err)
+	assert.False(t, pub.VerifyBytes(msg, sig))
+	assert.False(t, priv.PubKey().VerifyBytes(msg, sig))
+
+	//不在曲线上的点
+	bad := priv.Sign(msg).Bytes()
+	bad[BLSSignatureLength-1] ^= 0xff
+	sig, err = c.SignatureFromBytes(bad)
+	assert.Nil(t, err)
+	assert.False(t, priv.PubKey().VerifyBytes(msg, sig))
+
+	aggr, err := crypto.ToAggregate(c)
+	assert.Nil(t, err)
+	_, err = aggr.AggregatePublic([]crypto.PubKey{priv.PubKey(), pub})
+	assert.NotNil(t, err)
+}
@@ -53,6 +53,32 @@ func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
 	return SignatureEd25519(*sigBytes), nil
 }
 
+//VerifyBatch 批量验证签名
+func (d Driver) VerifyBatch(pubs []crypto.PubKey, msgs [][]byte, sigs []crypto.Signature) bool {
+	if len(pubs) != len(msgs) || len(pubs) != len(sigs) {
+		return false
+	}
+	pubKeys := make([]*[32]byte, len(pubs))
+	sigBytes := make([]*[64]byte, len(sigs))
+	for i := range pubs {
+		pub, ok := pubs[i].(PubKeyEd25519)
+		if !ok {
+			return false
+		}
+		sig := sigs[i]
+		if wrap, ok := sig.(SignatureS); ok {
+			sig = wrap.Signature
+		}
+		sigEd25519, ok := sig.(SignatureEd25519)
+		if !ok {
+			return false
+		}
+		pubKeys[i] = (*[32]byte)(&pub)
+		sigBytes[i] = (*[64]byte)(&sigEd25519)
+	}
+	return ed25519.VerifyBatch(pubKeys, msgs, sigBytes)
+}
+
 //PrivKeyEd25519 PrivKey
 type PrivKeyEd25519 [64]byte
 
@@ -14,6 +14,7 @@ package ed25519
 // from SUPERCOP.
 
 import (
+	"crypto/rand"
 	"crypto/sha512"
 	"crypto/subtle"
 	"io"
@@ -137,3 +138,88 @@ func Verify(publicKey *[PublicKeySize]byte, message []byte, sig *[SignatureSize]
 	R.ToBytes(&checkR)
 	return subtle.ConstantTimeCompare(sig[:32], checkR[:]) == 1
 }
+
+//order 素数子群的阶 l = 2^252 + 27742317777372353535851937790883648493, 小端
+var order = [32]byte{
+	0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x10,
+}
+
+//torsionFree [l]P 是否为零点, 即P不含小阶分量
+func torsionFree(p *edwards25519.ExtendedGroupElement) bool {
+	var zero, check, identity [32]byte
+	var r edwards25519.ProjectiveGroupElement
+	edwards25519.GeDoubleScalarMultVartime(&r, &order, p, &zero)
+	r.ToBytes(&check)
+	identity[0] = 1
+	return check == identity
+}
+
+// VerifyBatch 使用随机系数z_i批量验证签名, 检查 sum(z_i*R_i) + sum(z_i*h_i*A_i) - sum(z_i*s_i)*B 是否为零点.
+// R和公钥都必须在素数阶子群中, 否则小阶分量可能随z_i的取值被抵消, 使批量验证的结果和Verify不一致;
+// 含有小阶分量时直接返回false, 由逐个验证决定签名是否正确. 返回true时所有签名用Verify验证都通过
+func VerifyBatch(publicKeys []*[PublicKeySize]byte, messages [][]byte, sigs []*[SignatureSize]byte) bool {
+	n := len(publicKeys)
+	if n == 0 || n != len(messages) || n != len(sigs) {
+		return false
+	}
+	scalars := make([]*[32]byte, 0, 2*n)
+	points := make([]*edwards25519.ExtendedGroupElement, 0, 2*n)
+	var zero, sum [32]byte
+	random := make([]byte, 16*n)
+	if _, err := io.ReadFull(rand.Reader, random); err != nil {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		sig := sigs[i]
+		if sig[63]&224 != 0 {
+			return false
+		}
+		A := new(edwards25519.ExtendedGroupElement)
+		if !A.FromBytes(publicKeys[i]) || !torsionFree(A) {
+			return false
+		}
+		//R必须是规范编码, 和Verify中比较编码的行为一致
+		var encodedR, checkR [32]byte
+		copy(encodedR[:], sig[:32])
+		R := new(edwards25519.ExtendedGroupElement)
+		if !R.FromBytes(&encodedR) {
+			return false
+		}
+		R.ToBytes(&checkR)
+		if checkR != encodedR || !torsionFree(R) {
+			return false
+		}
+
+		h := sha512.New()
+		h.Write(sig[:32])
+		h.Write(publicKeys[i][:])
+		h.Write(messages[i])
+		var digest [64]byte
+		h.Sum(digest[:0])
+		var hReduced [32]byte
+		edwards25519.ScReduce(&hReduced, &digest)
+
+		z := new([32]byte)
+		copy(z[:16], random[16*i:16*i+16])
+		var s [32]byte
+		copy(s[:], sig[32:])
+		edwards25519.ScMulAdd(&sum, z, &s, &sum)
+		zh := new([32]byte)
+		edwards25519.ScMulAdd(zh, z, &hReduced, &zero)
+
+		scalars = append(scalars, z, zh)
+		points = append(points, R, A)
+	}
+	//-sum(z_i*s_i)
+	var one, negSum [32]byte
+	one[0] = 1
+	edwards25519.ScMulSub(&negSum, &one, &sum, &zero)
+
+	var check edwards25519.ProjectiveGroupElement
+	edwards25519.GeMultiScalarMultVartime(&check, scalars, points, &negSum)
+	var checkBytes, identity [32]byte
+	check.ToBytes(&checkBytes)
+	identity[0] = 1
+	return checkBytes == identity
+}
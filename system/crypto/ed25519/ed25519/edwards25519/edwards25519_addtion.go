@@ -534,3 +534,61 @@ func GeDsmPrecomp(r *DsmPreCompGroupElement, s *ExtendedGroupElement) {
 		u.ToCached(&r[i+1])
 	}
 }
+
+// GeMultiScalarMultVartime sets r = a[0]*A[0] + ... + a[n-1]*A[n-1] + b*B,
+// 所有的点共用倍点运算, 用于批量验证签名
+func GeMultiScalarMultVartime(r *ProjectiveGroupElement, a []*[32]byte, A []*ExtendedGroupElement, b *[32]byte) {
+	aSlide := make([][256]int8, len(a))
+	Ai := make([]DsmPreCompGroupElement, len(a))
+	var bSlide [256]int8
+	var t CompletedGroupElement
+	var u ExtendedGroupElement
+
+	for j := range a {
+		slide(&aSlide[j], a[j])
+		GeDsmPrecomp(&Ai[j], A[j])
+	}
+	slide(&bSlide, b)
+
+	r.Zero()
+	i := 255
+	for ; i >= 0; i-- {
+		if bSlide[i] != 0 {
+			break
+		}
+		nonzero := false
+		for j := range aSlide {
+			if aSlide[j][i] != 0 {
+				nonzero = true
+				break
+			}
+		}
+		if nonzero {
+			break
+		}
+	}
+
+	for ; i >= 0; i-- {
+		r.Double(&t)
+
+		for j := range aSlide {
+			if aSlide[j][i] > 0 {
+				t.ToExtended(&u)
+				geAdd(&t, &u, &Ai[j][aSlide[j][i]/2])
+			} else if aSlide[j][i] < 0 {
+				t.ToExtended(&u)
+				geSub(&t, &u, &Ai[j][(-aSlide[j][i])/2])
+			}
+		}
+
+		if bSlide[i] > 0 {
+			t.ToExtended(&u)
+			geMixedAdd(&t, &u, &bi[bSlide[i]/2])
+		} else if bSlide[i] < 0 {
+			t.ToExtended(&u)
+			geMixedSub(&t, &u, &bi[(-bSlide[i])/2])
+		}
+
+		t.ToProjective(r)
+	}
+}
@@ -1,12 +1,17 @@
 package ed25519
 
 import (
+	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
 	"testing"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/crypto/ed25519/ed25519"
+	"github.com/33cn/chain33/system/crypto/ed25519/ed25519/edwards25519"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGenKey(t *testing.T) {
@@ -57,3 +62,100 @@ func TestPrivateKeyFromBytes(t *testing.T) {
 
 	assert.True(t, pub2.VerifyBytes(msg, sig))
 }
+
+func TestVerifyBatch(t *testing.T) {
+	d := &Driver{}
+	var pubs []crypto.PubKey
+	var msgs [][]byte
+	var sigs []crypto.Signature
+	for i := 0; i < 20; i++ {
+		priv, err := d.GenKey()
+		assert.Nil(t, err)
+		msg := []byte(fmt.Sprintf("message%d", i))
+		pubs = append(pubs, priv.PubKey())
+		msgs = append(msgs, msg)
+		sigs = append(sigs, priv.Sign(msg))
+	}
+	assert.True(t, d.VerifyBatch(pubs, msgs, sigs))
+	assert.True(t, d.VerifyBatch(pubs[:1], msgs[:1], sigs[:1]))
+	assert.False(t, d.VerifyBatch(pubs, msgs[1:], sigs))
+
+	//其中一个签名错误时整批验证失败
+	msgs[5] = []byte("tampered")
+	assert.False(t, d.VerifyBatch(pubs, msgs, sigs))
+	msgs[5] = []byte("message5")
+	sigs[3], sigs[4] = sigs[4], sigs[3]
+	assert.False(t, d.VerifyBatch(pubs, msgs, sigs))
+}
+
+//smallOrderSign 构造R含有2阶分量的签名: R' = r*B + T, s = r + H(R'||A||m)*a
+func smallOrderSign(t *testing.T, priv PrivKeyEd25519, msg []byte) SignatureEd25519 {
+	digest := sha512.Sum512(priv[:32])
+	var a [32]byte
+	copy(a[:], digest[:32])
+	a[0] &= 248
+	a[31] &= 63
+	a[31] |= 64
+
+	var r [32]byte
+	r[0] = 7
+	var R edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&R, &r)
+	//T = (0, -1)
+	var encodedT [32]byte
+	encodedT[0] = 0xec
+	for i := 1; i < 31; i++ {
+		encodedT[i] = 0xff
+	}
+	encodedT[31] = 0x7f
+	var T edwards25519.ExtendedGroupElement
+	require.True(t, T.FromBytes(&encodedT))
+	var cached edwards25519.CachedGroupElement
+	T.ToCached(&cached)
+	var sum edwards25519.CompletedGroupElement
+	edwards25519.GeAdd(&sum, &R, &cached)
+	var R2 edwards25519.ExtendedGroupElement
+	sum.ToExtended(&R2)
+	var encodedR [32]byte
+	R2.ToBytes(&encodedR)
+
+	h := sha512.New()
+	h.Write(encodedR[:])
+	h.Write(priv[32:])
+	h.Write(msg)
+	var hram [64]byte
+	h.Sum(hram[:0])
+	var hReduced, s [32]byte
+	edwards25519.ScReduce(&hReduced, &hram)
+	edwards25519.ScMulAdd(&s, &hReduced, &a, &r)
+
+	var sig SignatureEd25519
+	copy(sig[:32], encodedR[:])
+	copy(sig[32:], s[:])
+	return sig
+}
+
+func TestVerifyBatchSmallOrder(t *testing.T) {
+	d := &Driver{}
+	priv, err := d.GenKey()
+	require.Nil(t, err)
+	msg := []byte("small order")
+	sig := smallOrderSign(t, priv.(PrivKeyEd25519), msg)
+	pub := priv.PubKey()
+	assert.False(t, pub.VerifyBytes(msg, sig))
+	//随机系数为偶数时2阶分量被抵消, 多次验证结果都必须和Verify一致
+	for i := 0; i < 64; i++ {
+		assert.False(t, d.VerifyBatch([]crypto.PubKey{pub, pub}, [][]byte{msg, msg}, []crypto.Signature{sig, priv.Sign(msg)}))
+	}
+
+	//含有小阶分量的公钥
+	var smallPub [32]byte
+	copy(smallPub[:], []byte{0xec})
+	for i := 1; i < 31; i++ {
+		smallPub[i] = 0xff
+	}
+	smallPub[31] = 0x7f
+	var edSig [64]byte
+	copy(edSig[:], sig[:])
+	assert.False(t, ed25519.VerifyBatch([]*[32]byte{&smallPub}, [][]byte{msg}, []*[64]byte{&edSig}))
+}
@@ -53,6 +53,45 @@ func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
 	return SignatureSecp256k1(b), nil
 }
 
+//VerifyBatch 批量验证签名, ecdsa不支持合并验证, 同一个公钥只解压一次
+func (d Driver) VerifyBatch(pubs []crypto.PubKey, msgs [][]byte, sigs []crypto.Signature) bool {
+	if len(pubs) != len(msgs) || len(pubs) != len(sigs) {
+		return false
+	}
+	parsed := make(map[PubKeySecp256k1]*secp256k1.PublicKey)
+	for i := range pubs {
+		pubKey, ok := pubs[i].(PubKeySecp256k1)
+		if !ok {
+			return false
+		}
+		sig := sigs[i]
+		if wrap, ok := sig.(SignatureS); ok {
+			sig = wrap.Signature
+		}
+		sigSecp256k1, ok := sig.(SignatureSecp256k1)
+		if !ok {
+			return false
+		}
+		pub, ok := parsed[pubKey]
+		if !ok {
+			var err error
+			pub, err = secp256k1.ParsePubKey(pubKey[:], secp256k1.S256())
+			if err != nil {
+				return false
+			}
+			parsed[pubKey] = pub
+		}
+		sig2, err := secp256k1.ParseDERSignature(sigSecp256k1[:], secp256k1.S256())
+		if err != nil {
+			return false
+		}
+		if !sig2.Verify(crypto.Sha256(msgs[i]), pub) {
+			return false
+		}
+	}
+	return true
+}
+
 //PrivKeySecp256k1 PrivKey
 type PrivKeySecp256k1 [32]byte
 
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
@@ -30,11 +32,17 @@ func AccountCmd() *cobra.Command {
 		GetAccountListCmd(),
 		GetBalanceCmd(),
 		ImportKeyCmd(),
+		ImportWatchOnlyCmd(),
 		NewAccountCmd(),
 		SetLabelCmd(),
 		DumpKeysFileCmd(),
 		ImportKeysFileCmd(),
+		ExportKeystoreCmd(),
+		ImportKeystoreCmd(),
+		RecoverAccountsCmd(),
+		HDAccountsCmd(),
 		GetAccountCmd(),
+		MultiSignAddressCmd(),
 	)
 
 	return cmd
@@ -97,7 +105,7 @@ func parseListAccountRes(arg interface{}) (interface{}, error) {
 			Balance:  balanceResult,
 			Frozen:   frozenResult,
 		}
-		result.Wallets = append(result.Wallets, &commandtypes.WalletResult{Acc: accResult, Label: r.Label})
+		result.Wallets = append(result.Wallets, &commandtypes.WalletResult{Acc: accResult, Label: r.Label, HdPath: r.HdPath, WatchOnly: r.WatchOnly})
 	}
 	return result, nil
 }
@@ -267,12 +275,52 @@ func parseImportKeyRes(arg interface{}) (interface{}, error) {
 	res := arg.(*types.WalletAccount)
 	accResult := commandtypes.DecodeAccount(res.GetAcc(), types.Coin)
 	result := commandtypes.WalletResult{
-		Acc:   accResult,
-		Label: res.GetLabel(),
+		Acc:       accResult,
+		Label:     res.GetLabel(),
+		WatchOnly: res.GetWatchOnly(),
 	}
 	return result, nil
 }
 
+// ImportWatchOnlyCmd import watch-only account
+func ImportWatchOnlyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import_watch",
+		Short: "Import watch-only account by address or public key",
+		Run:   importWatchOnly,
+	}
+	addImportWatchOnlyFlags(cmd)
+	return cmd
+}
+
+func addImportWatchOnlyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "address of account")
+	cmd.Flags().StringP("pubkey", "p", "", "public key of account")
+
+	cmd.Flags().StringP("label", "l", "", "label for account")
+	cmd.MarkFlagRequired("label")
+}
+
+func importWatchOnly(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	label, _ := cmd.Flags().GetString("label")
+	if addr == "" && pubkey == "" {
+		fmt.Fprintln(os.Stderr, "addr or pubkey is required")
+		return
+	}
+	params := types.ReqWalletImportWatchOnly{
+		Addr:   addr,
+		Pubkey: pubkey,
+		Label:  label,
+	}
+	var res types.WalletAccount
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ImportWatchOnly", params, &res)
+	ctx.SetResultCb(parseImportKeyRes)
+	ctx.Run()
+}
+
 // NewAccountCmd create an account
 func NewAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -287,13 +335,16 @@ func NewAccountCmd() *cobra.Command {
 func addCreateAccountFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("label", "l", "", "account label")
 	cmd.MarkFlagRequired("label")
+	cmd.Flags().StringP("path", "p", "", "bip44 derivation path, e.g. m/44'/13107'/0'/0/1, use next index if empty")
 }
 
 func createAccount(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	label, _ := cmd.Flags().GetString("label")
+	path, _ := cmd.Flags().GetString("path")
 	params := types.ReqNewAccount{
-		Label: label,
+		Label:  label,
+		HdPath: path,
 	}
 	var res types.WalletAccount
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.NewAccount", params, &res)
@@ -305,8 +356,9 @@ func parseCreateAccountRes(arg interface{}) (interface{}, error) {
 	res := arg.(*types.WalletAccount)
 	accResult := commandtypes.DecodeAccount(res.GetAcc(), types.Coin)
 	result := commandtypes.WalletResult{
-		Acc:   accResult,
-		Label: res.GetLabel(),
+		Acc:    accResult,
+		Label:  res.GetLabel(),
+		HdPath: res.GetHdPath(),
 	}
 	return result, nil
 }
@@ -433,3 +485,148 @@ func importKeys(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ImportPrivkeysFile", params, &res)
 	ctx.Run()
 }
+
+//ExportKeystoreCmd export keystore files
+func ExportKeystoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export_keystore",
+		Short: "Export private keys to keystore v3 files",
+		Run:   exportKeystore,
+	}
+	cmd.Flags().StringP("dir", "d", "", "directory to save keystore files")
+	cmd.MarkFlagRequired("dir")
+	cmd.Flags().StringP("pwd", "p", "", "password needed to encrypt")
+	cmd.MarkFlagRequired("pwd")
+	cmd.Flags().StringP("addr", "a", "", "address of account, export all accounts if empty")
+	return cmd
+}
+
+func exportKeystore(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	dir, _ := cmd.Flags().GetString("dir")
+	pwd, _ := cmd.Flags().GetString("pwd")
+	addr, _ := cmd.Flags().GetString("addr")
+	params := types.ReqKeystoreFile{
+		FileName: dir,
+		Passwd:   pwd,
+		Addr:     addr,
+	}
+	var res types.ReplyStrings
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ExportKeystore", params, &res)
+	ctx.Run()
+}
+
+//ImportKeystoreCmd import keystore files
+func ImportKeystoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import_keystore",
+		Short: "Import private keys from keystore v3 file or directory",
+		Run:   importKeystore,
+	}
+	cmd.Flags().StringP("file", "f", "", "keystore file or directory")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().StringP("pwd", "p", "", "password needed to decrypt")
+	cmd.MarkFlagRequired("pwd")
+	cmd.Flags().StringP("label", "l", "", "label of account, use label in file if empty")
+	return cmd
+}
+
+func importKeystore(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	file, _ := cmd.Flags().GetString("file")
+	pwd, _ := cmd.Flags().GetString("pwd")
+	label, _ := cmd.Flags().GetString("label")
+	params := types.ReqKeystoreFile{
+		FileName: file,
+		Passwd:   pwd,
+		Label:    label,
+	}
+	var res rpctypes.ReplyImportKeystore
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ImportKeystore", params, &res)
+	ctx.SetResultCb(parseImportKeystoreRes)
+	ctx.Run()
+}
+
+func parseImportKeystoreRes(arg interface{}) (interface{}, error) {
+	res := arg.(*rpctypes.ReplyImportKeystore)
+	accounts, err := parseListAccountRes(&rpctypes.WalletAccounts{Wallets: res.Wallets})
+	if err != nil {
+		return nil, err
+	}
+	return &commandtypes.ImportKeystoreResult{Wallets: accounts.(commandtypes.AccountsResult).Wallets, Failed: res.Failed}, nil
+}
+
+//RecoverAccountsCmd recover accounts from seed
+func RecoverAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover",
+		Short: "Recover used accounts from seed",
+		Run:   recoverAccounts,
+	}
+	cmd.Flags().Int32P("gap", "g", 0, "stop after gap consecutive unused addresses, use wallet config if 0")
+	return cmd
+}
+
+func recoverAccounts(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	gap, _ := cmd.Flags().GetInt32("gap")
+	params := types.ReqRecoverAccounts{
+		GapLimit: gap,
+	}
+	var res types.HDAccounts
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.RecoverAccounts", params, &res)
+	ctx.Run()
+}
+
+//HDAccountsCmd list accounts generated from seed
+func HDAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hd_list",
+		Short: "Get accounts generated from seed with derivation path",
+		Run:   listHDAccounts,
+	}
+	return cmd
+}
+
+func listHDAccounts(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res types.HDAccounts
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetHDAccounts", nil, &res)
+	ctx.Run()
+}
+
+// MultiSignAddressCmd get m-of-n multi-sign address
+func MultiSignAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig_address",
+		Short: "Get m-of-n multi-sign address of public keys",
+		Run:   multiSignAddress,
+	}
+	cmd.Flags().StringP("pubkeys", "p", "", "public keys, separated by comma")
+	cmd.MarkFlagRequired("pubkeys")
+	cmd.Flags().Int32P("threshold", "n", 0, "number of signatures required")
+	cmd.MarkFlagRequired("threshold")
+	cmd.Flags().Int32P("signtype", "t", types.SECP256K1, "sign type of public keys, same as wallet sign type")
+	return cmd
+}
+
+func multiSignAddress(cmd *cobra.Command, args []string) {
+	pubkeys, _ := cmd.Flags().GetString("pubkeys")
+	threshold, _ := cmd.Flags().GetInt32("threshold")
+	signType, _ := cmd.Flags().GetInt32("signtype")
+	var keys []*types.MultiSignKey
+	for _, hexkey := range strings.Split(pubkeys, ",") {
+		pubkey, err := common.FromHex(hexkey)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		keys = append(keys, &types.MultiSignKey{Ty: signType, Pubkey: pubkey})
+	}
+	pub, err := types.NewMultiSignPubKey(threshold, keys)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(pub.Address())
+}
@@ -0,0 +1,49 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/spf13/cobra"
+)
+
+//APITokenCmd generate rpc api token
+func APITokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apitoken",
+		Short: "Generate rpc access token signed by tokenSecret",
+		Run:   apiToken,
+	}
+	addAPITokenFlags(cmd)
+	return cmd
+}
+
+func addAPITokenFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("name", "n", "", "api key name in rpc config")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("secret", "s", "", "tokenSecret in rpc config")
+	cmd.MarkFlagRequired("secret")
+	cmd.Flags().DurationP("duration", "d", 30*24*time.Hour, "duration that token is valid for, 0 means never expire")
+}
+
+func apiToken(cmd *cobra.Command, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	secret, _ := cmd.Flags().GetString("secret")
+	duration, _ := cmd.Flags().GetDuration("duration")
+	token := &rpctypes.APIToken{Name: name}
+	if duration > 0 {
+		token.Expire = time.Now().Add(duration).Unix()
+	}
+	s, err := rpctypes.SignAPIToken([]byte(secret), token)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(s)
+}
@@ -0,0 +1,51 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/spf13/cobra"
+)
+
+// DiscoverCmd get OpenRPC document of node
+func DiscoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discover",
+		Short: "Get OpenRPC document of jrpc methods",
+		Run:   discover,
+	}
+	cmd.Flags().StringP("output", "o", "", "output file, print to stdout if not set")
+	return cmd
+}
+
+func discover(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	output, _ := cmd.Flags().GetString("output")
+	var res rpctypes.OpenRPCDoc
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Discover", nil, &res)
+	if output == "" {
+		ctx.Run()
+		return
+	}
+	result, err := ctx.RunResult()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if err := ioutil.WriteFile(output, data, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
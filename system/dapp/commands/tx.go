@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
@@ -33,6 +34,7 @@ func TxCmd() *cobra.Command {
 		DecodeTxCmd(),
 		GetAddrOverviewCmd(),
 		ReWriteRawTxCmd(),
+		CombineMultiSignCmd(),
 	)
 
 	return cmd
@@ -345,3 +347,40 @@ func reWriteRawTx(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ReWriteRawTx", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// CombineMultiSignCmd combine partially multi-signed transactions
+func CombineMultiSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "combine_multisig",
+		Short: "Combine partially multi-signed transactions of the same tx",
+		Run:   combineMultiSign,
+	}
+	cmd.Flags().StringP("data", "d", "", "partially signed transactions, separated by space")
+	cmd.MarkFlagRequired("data")
+	return cmd
+}
+
+func combineMultiSign(cmd *cobra.Command, args []string) {
+	data, _ := cmd.Flags().GetString("data")
+	var txs []*types.Transaction
+	for _, hexTx := range strings.Fields(data) {
+		txByte, err := common.FromHex(hexTx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		var tx types.Transaction
+		err = types.Decode(txByte, &tx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		txs = append(txs, &tx)
+	}
+	tx, err := types.CombineMultiSign(txs...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(common.ToHex(types.Encode(tx)))
+}
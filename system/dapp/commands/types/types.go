@@ -14,10 +14,18 @@ type AccountsResult struct {
 	Wallets []*WalletResult `json:"wallets"`
 }
 
+// ImportKeystoreResult defines import keystore result command
+type ImportKeystoreResult struct {
+	Wallets []*WalletResult               `json:"wallets"`
+	Failed  []*rpctypes.KeystoreFileError `json:"failed,omitempty"`
+}
+
 // WalletResult defines walletresult command
 type WalletResult struct {
-	Acc   *AccountResult `json:"acc,omitempty"`
-	Label string         `json:"label,omitempty"`
+	Acc       *AccountResult `json:"acc,omitempty"`
+	Label     string         `json:"label,omitempty"`
+	HdPath    string         `json:"hdPath,omitempty"`
+	WatchOnly bool           `json:"watchOnly,omitempty"`
 }
 
 // AccountResult defines account result command
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/33cn/chain33/rpc/jsonclient"
@@ -314,6 +315,8 @@ func addSignRawTxFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("expire", "e", "120s", "transaction expire time")
 	cmd.Flags().Float64P("fee", "f", 0, "transaction fee (optional), auto set proper fee if not set or zero fee")
 	cmd.Flags().StringP("to", "t", "", "new to addr (optional)")
+	cmd.Flags().StringP("multisig_pubkeys", "m", "", "public keys of multi-sign account, separated by comma (optional)")
+	cmd.Flags().Int32P("threshold", "n", 0, "number of signatures required by multi-sign account")
 
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
@@ -355,6 +358,8 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	to, _ := cmd.Flags().GetString("to")
 	fee, _ := cmd.Flags().GetFloat64("fee")
 	expire, _ := cmd.Flags().GetString("expire")
+	multiSignPubkeys, _ := cmd.Flags().GetString("multisig_pubkeys")
+	threshold, _ := cmd.Flags().GetInt32("threshold")
 	expire, err := commandtypes.CheckExpireOpt(expire)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -362,13 +367,17 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	}
 	feeInt64 := int64(fee * 1e4)
 	params := types.ReqSignRawTx{
-		Addr:      addr,
-		Privkey:   key,
-		TxHex:     data,
-		Expire:    expire,
-		Index:     index,
-		Fee:       feeInt64 * 1e4,
-		NewToAddr: to,
+		Addr:               addr,
+		Privkey:            key,
+		TxHex:              data,
+		Expire:             expire,
+		Index:              index,
+		Fee:                feeInt64 * 1e4,
+		NewToAddr:          to,
+		MultiSignThreshold: threshold,
+	}
+	if multiSignPubkeys != "" {
+		params.MultiSignPubkeys = strings.Split(multiSignPubkeys, ",")
 	}
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SignRawTx", params, nil)
 	ctx.RunWithoutMarshal()
@@ -279,7 +279,7 @@ func CheckAddress(cfg *types.Chain33Config, addr string, height int64) error {
 	if IsDriverAddress(addr, height) {
 		return nil
 	}
-	err := address.CheckAddress(addr)
+	err := types.CheckToAddress(cfg, height, addr)
 	if !cfg.IsFork(height, "ForkMultiSignAddress") && err == address.ErrCheckVersion {
 		return nil
 	}
@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"bytes"
+
 	"github.com/33cn/chain33/common/listmap"
 	"github.com/33cn/chain33/types"
 )
@@ -84,3 +86,30 @@ func (cache *AccountTxIndex) CanPush(tx *types.Transaction) bool {
 	}
 	return true
 }
+
+//GetConflictTx 返回账户中和tx冲突的交易: 相同的nonce, 或者相同Header的交易组
+func (cache *AccountTxIndex) GetConflictTx(tx *types.Transaction) *types.Transaction {
+	lm, ok := cache.accMap[tx.From()]
+	if !ok {
+		return nil
+	}
+	var conflict *types.Transaction
+	hash := tx.Hash()
+	lm.Walk(func(val interface{}) bool {
+		old := val.(*types.Transaction)
+		if isConflictTx(old, tx) && !bytes.Equal(old.Hash(), hash) {
+			conflict = old
+			return false
+		}
+		return true
+	})
+	return conflict
+}
+
+func isConflictTx(old, tx *types.Transaction) bool {
+	if tx.Nonce != 0 && tx.Nonce == old.Nonce {
+		return true
+	}
+	//交易组的Header是第一笔交易的哈希, 只有同一个交易组才冲突
+	return tx.GroupCount > 0 && old.GroupCount > 0 && len(tx.Header) > 0 && bytes.Equal(tx.Header, old.Header)
+}
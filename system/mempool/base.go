@@ -32,6 +32,7 @@ type Mempool struct {
 	done              chan struct{}
 	removeBlockTicket *time.Ticker
 	cache             *txCache
+	blsLimiter        *verifyLimiter
 }
 
 //GetSync 判断是否mempool 同步
@@ -53,6 +54,9 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	if cfg.PoolCacheSize == 0 {
 		cfg.PoolCacheSize = poolCacheSize
 	}
+	if cfg.MaxBLSVerifyRate == 0 {
+		cfg.MaxBLSVerifyRate = maxBLSVerifyRate
+	}
 	pool.in = make(chan *queue.Message)
 	pool.out = make(<-chan *queue.Message)
 	pool.done = make(chan struct{})
@@ -60,6 +64,10 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	pool.poolHeader = make(chan struct{}, 2)
 	pool.removeBlockTicket = time.NewTicker(time.Minute)
 	pool.cache = newCache(cfg.MaxTxNumPerAccount, cfg.MaxTxLast, cfg.PoolCacheSize)
+	pool.blsLimiter = newVerifyLimiter(cfg.MaxBLSVerifyRate)
+	if cfg.EnableJournal {
+		pool.cache.journal = newJournal(cfg)
+	}
 	return pool
 }
 
@@ -76,6 +84,9 @@ func (mem *Mempool) Close() {
 	mem.removeBlockTicket.Stop()
 	mlog.Info("mempool module closing")
 	mem.wg.Wait()
+	if mem.cache.journal != nil {
+		mem.cache.journal.close()
+	}
 	mlog.Info("mempool module closed")
 }
 
@@ -92,6 +103,11 @@ func (mem *Mempool) SetQueueClient(client queue.Client) {
 
 	mem.wg.Add(1)
 	go mem.eventProcess()
+
+	if mem.cache.journal != nil {
+		mem.wg.Add(1)
+		go mem.journalProcess()
+	}
 }
 
 // Size 返回mempool中txCache大小
@@ -162,10 +178,48 @@ func (mem *Mempool) RemoveTxs(hashList *types.TxHashList) error {
 func (mem *Mempool) PushTx(tx *types.Transaction) error {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
-	err := mem.cache.Push(tx)
+	old, err := mem.getReplaceTx(tx)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		return mem.replaceTx(old, tx)
+	}
+	err = mem.cache.Push(tx)
 	return err
 }
 
+// getReplaceTx 返回tx可以替换的同一账户冲突交易, 新交易手续费需要至少提高ReplaceFeePercent
+func (mem *Mempool) getReplaceTx(tx *types.Transaction) (*types.Transaction, error) {
+	if mem.cfg.ReplaceFeePercent <= 0 {
+		return nil, nil
+	}
+	old := mem.cache.GetConflictTx(tx)
+	if old == nil {
+		return nil, nil
+	}
+	if tx.Fee <= old.Fee || tx.Fee*100 < old.Fee*(100+mem.cfg.ReplaceFeePercent) {
+		return nil, types.ErrReplaceTxFeeTooLow
+	}
+	return old, nil
+}
+
+// replaceTx 删除被替换的交易后放入新交易, 并通知p2p不再接收被替换的交易, 同时向其他节点转发新交易
+func (mem *Mempool) replaceTx(old, tx *types.Transaction) error {
+	mem.cache.Remove(string(old.Hash()))
+	err := mem.cache.Push(tx)
+	if err != nil {
+		//新交易放入失败, 恢复被替换的交易
+		if e := mem.cache.Push(old); e != nil {
+			mlog.Error("replaceTx", "restore tx", common.ToHex(old.Hash()), "err", e)
+		}
+		return err
+	}
+	mlog.Debug("replaceTx", "old", common.ToHex(old.Hash()), "new", common.ToHex(tx.Hash()))
+	mem.sendTxReplacedToP2P(old, tx)
+	return nil
+}
+
 //  setHeader设置mempool.header
 func (mem *Mempool) setHeader(h *types.Header) {
 	mem.proxyMtx.Lock()
@@ -413,6 +467,18 @@ func (mem *Mempool) sendTxToP2P(tx *types.Transaction) {
 	mlog.Debug("tx sent to p2p", "tx.Hash", common.ToHex(tx.Hash()))
 }
 
+// sendTxReplacedToP2P 通知p2p交易old已经被tx替换
+func (mem *Mempool) sendTxReplacedToP2P(old, tx *types.Transaction) {
+	if mem.client == nil {
+		panic("client not bind message queue.")
+	}
+	msg := mem.client.NewMessage("p2p", types.EventTxReplaced, &types.P2PTxReplaced{OldHash: old.Hash(), Tx: tx})
+	err := mem.client.Send(msg, false)
+	if err != nil {
+		mlog.Error("tx replaced sent to p2p", "tx.Hash", common.ToHex(old.Hash()), "err", err)
+	}
+}
+
 // Mempool.checkSync检查并获取mempool同步状态
 func (mem *Mempool) checkSync() {
 	defer func() {
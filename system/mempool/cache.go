@@ -5,6 +5,7 @@
 package mempool
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 )
 
@@ -20,6 +21,13 @@ type QueueCache interface {
 	GetCacheBytes() int64
 }
 
+//EvictQueue 队列满时可以淘汰低优先级交易的排队策略
+//淘汰由txCache完成, 保证账户索引等缓存同步删除
+type EvictQueue interface {
+	//Evict 返回为放入tx需要淘汰的交易, 没有可淘汰的交易返回nil
+	Evict(tx *Item) *Item
+}
+
 // Item 为Mempool中包装交易的数据结构
 type Item struct {
 	Value     *types.Transaction
@@ -34,6 +42,8 @@ type txCache struct {
 	qcache   QueueCache
 	totalFee int64
 	*SHashTxCache
+	//开启交易日志时不为nil
+	journal *journal
 }
 
 //NewTxCache init accountIndex and last cache
@@ -65,6 +75,9 @@ func (cache *txCache) Remove(hash string) {
 	cache.LastTxCache.Remove(tx)
 	cache.totalFee -= tx.Fee
 	cache.SHashTxCache.Remove(tx)
+	if cache.journal != nil {
+		cache.journal.remove(hash)
+	}
 }
 
 //Exist 是否存在
@@ -110,6 +123,9 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 	}
 	item := &Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
 	err := cache.qcache.Push(item)
+	if err == types.ErrMemFull {
+		err = cache.evictAndPush(item)
+	}
 	if err != nil {
 		return err
 	}
@@ -120,9 +136,27 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 	cache.LastTxCache.Push(tx)
 	cache.totalFee += tx.Fee
 	cache.SHashTxCache.Push(tx)
+	if cache.journal != nil {
+		cache.journal.add(tx)
+	}
 	return nil
 }
 
+//evictAndPush 淘汰一笔低优先级的交易后再放入
+func (cache *txCache) evictAndPush(item *Item) error {
+	queue, ok := cache.qcache.(EvictQueue)
+	if !ok {
+		return types.ErrMemFull
+	}
+	evict := queue.Evict(item)
+	if evict == nil {
+		return types.ErrMemFull
+	}
+	mlog.Debug("evictAndPush", "evict", common.ToHex(evict.Value.Hash()), "by", common.ToHex(item.Value.Hash()))
+	cache.Remove(string(evict.Value.Hash()))
+	return cache.qcache.Push(item)
+}
+
 func (cache *txCache) removeExpiredTx(cfg *types.Chain33Config, height, blocktime int64) {
 	var txs []string
 	cache.qcache.Walk(0, func(tx *Item) bool {
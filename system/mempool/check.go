@@ -2,13 +2,13 @@ package mempool
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/33cn/chain33/common"
 
 	"github.com/33cn/chain33/util"
 
-	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 )
@@ -59,17 +59,28 @@ func (mem *Mempool) checkExpireValid(tx *types.Transaction) bool {
 	return true
 }
 
+// hasConflictTx 交易会替换mempool中同一账户的交易时不增加账户的交易数量
+func (mem *Mempool) hasConflictTx(tx *types.Transaction) bool {
+	if mem.cfg.ReplaceFeePercent <= 0 {
+		return false
+	}
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	return mem.cache.GetConflictTx(tx) != nil
+}
+
 // CheckTx 初步检查并筛选交易消息
 func (mem *Mempool) checkTx(msg *queue.Message) *queue.Message {
 	tx := msg.GetData().(types.TxGroup).Tx()
 	// 检查接收地址是否合法
-	if err := address.CheckAddress(tx.To); err != nil {
+	types.AssertConfig(mem.client)
+	if err := types.CheckToAddress(mem.client.GetConfig(), mem.GetHeader().GetHeight(), tx.To); err != nil {
 		msg.Data = types.ErrInvalidAddress
 		return msg
 	}
 	// 检查交易账户在mempool中是否存在过多交易
 	from := tx.From()
-	if mem.TxNumOfAccount(from) >= mem.cfg.MaxTxNumPerAccount {
+	if mem.TxNumOfAccount(from) >= mem.cfg.MaxTxNumPerAccount && !mem.hasConflictTx(tx) {
 		msg.Data = types.ErrManyTx
 		return msg
 	}
@@ -114,6 +125,11 @@ func (mem *Mempool) checkTxs(msg *queue.Message) *queue.Message {
 		msg.Data = err
 		return msg
 	}
+	//BLS验签开销较大, 在进入验签流水线之前限制速率
+	if !mem.blsLimiter.allow(countBLSSign(tx.Transaction, txs)) {
+		msg.Data = types.ErrBLSVerifyLimited
+		return msg
+	}
 	msg.Data = tx
 	//普通交易
 	if txs == nil {
@@ -202,3 +218,47 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 	}
 	return msg
 }
+
+func countBLSSign(tx *types.Transaction, txs *types.Transactions) int {
+	if txs == nil {
+		return types.CountBLSSign(tx.GetSignature())
+	}
+	n := 0
+	for _, tx := range txs.GetTxs() {
+		n += types.CountBLSSign(tx.GetSignature())
+	}
+	return n
+}
+
+// verifyLimiter 令牌桶, 限制每秒验证的签名数量
+type verifyLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newVerifyLimiter(rate int64) *verifyLimiter {
+	return &verifyLimiter{rate: float64(rate), tokens: float64(rate), last: time.Now()}
+}
+
+func (l *verifyLimiter) allow(n int) bool {
+	if n == 0 || l.rate < 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if now.After(l.last) {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.rate {
+			l.tokens = l.rate
+		}
+		l.last = now
+	}
+	if l.tokens < float64(n) {
+		return false
+	}
+	l.tokens -= float64(n)
+	return true
+}
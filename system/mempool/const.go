@@ -14,6 +14,8 @@ var (
 	mempoolExpiredInterval int64 = 600   // mempool内交易过期时间，10分钟
 	maxTxNumPerAccount     int64 = 100   // TODO 每个账户在mempool中最大交易数量，10
 	maxTxLast              int64 = 10
+	checkSignBatchSize           = 256 // 每次批量验证签名的最大交易数量
+	maxBLSVerifyRate       int64 = 500 // 每秒最多验证的BLS签名数量
 	processNum             int
 )
 
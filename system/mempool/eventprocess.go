@@ -20,16 +20,10 @@ func (mem *Mempool) reply() {
 }
 
 func (mem *Mempool) pipeLine() <-chan *queue.Message {
-	//check sign
-	step1 := func(data *queue.Message) *queue.Message {
-		if data.Err() != nil {
-			return data
-		}
-		return mem.checkSign(data)
-	}
+	//check sign, 已经到达的交易批量验证签名
 	chs := make([]<-chan *queue.Message, processNum)
 	for i := 0; i < processNum; i++ {
-		chs[i] = step(mem.done, mem.in, step1)
+		chs[i] = stepBatch(mem.done, mem.in, checkSignBatchSize, mem.checkSignBatch)
 	}
 	out1 := merge(mem.done, chs)
 
@@ -218,6 +212,29 @@ func (mem *Mempool) checkSign(data *queue.Message) *queue.Message {
 	return data
 }
 
+//checkSignBatch 批量验证一组交易消息的签名, 签名错误的消息设置为ErrSign
+func (mem *Mempool) checkSignBatch(msgs []*queue.Message) {
+	var caches []*types.TransactionCache
+	var cacheMsgs []*queue.Message
+	for _, data := range msgs {
+		if data.Err() != nil {
+			continue
+		}
+		if tx, ok := data.GetData().(*types.TransactionCache); ok {
+			caches = append(caches, tx)
+			cacheMsgs = append(cacheMsgs, data)
+			continue
+		}
+		mem.checkSign(data)
+	}
+	for i, ok := range types.CheckCacheSign(caches) {
+		if !ok {
+			mlog.Error("wrong tx", "err", types.ErrSign)
+			cacheMsgs[i].Data = types.ErrSign
+		}
+	}
+}
+
 // eventTxListByHash 通过hash获取tx列表
 func (mem *Mempool) eventTxListByHash(msg *queue.Message) {
 	shashList := msg.GetData().(*types.ReqTxHashList)
@@ -0,0 +1,47 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feerate
+
+import (
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	drivers.Reg("feerate", New)
+}
+
+const defaultBlockTxNumber = 1500
+
+//SubConfig feerate 队列配置
+type SubConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	//下一个区块可以打包的交易数量和字节数, 用于估算进入下一个区块的最低手续费率
+	BlockTxNumber int64 `json:"blockTxNumber"`
+	BlockSize     int64 `json:"blockSize"`
+}
+
+//New 创建按手续费率排序的 mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg SubConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFeeRate
+	}
+	if subcfg.BlockTxNumber == 0 {
+		subcfg.BlockTxNumber = defaultBlockTxNumber
+	}
+	if subcfg.BlockSize == 0 {
+		subcfg.BlockSize = types.MaxBlockSize
+	}
+	c.SetQueueCache(NewQueue(subcfg))
+	return c
+}
@@ -0,0 +1,156 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feerate
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common/skiplist"
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+var _ mempool.EvictQueue = (*Queue)(nil)
+
+//Queue 按照每千字节手续费从高到低排序的队列, 手续费率相同的按照进入时间排序
+type Queue struct {
+	txList    *skiplist.Queue
+	subConfig SubConfig
+}
+
+//NewQueue 创建队列
+func NewQueue(subConfig SubConfig) *Queue {
+	return &Queue{
+		txList:    skiplist.NewQueue(subConfig.PoolCacheSize),
+		subConfig: subConfig,
+	}
+}
+
+//feeRate 交易每千字节的手续费, 和 MinTxFeeRate 单位一致
+func feeRate(tx *types.Transaction, size int64) int64 {
+	if size <= 0 {
+		return tx.Fee
+	}
+	return tx.Fee * 1000 / size
+}
+
+type feeItem struct {
+	item  *mempool.Item
+	hash  []byte
+	score int64
+	size  int64
+}
+
+func newFeeItem(item *mempool.Item) *feeItem {
+	size := int64(types.Size(item.Value))
+	return &feeItem{item: item, hash: item.Value.Hash(), score: feeRate(item.Value, size), size: size}
+}
+
+func (f *feeItem) GetScore() int64 {
+	return f.score
+}
+
+func (f *feeItem) Hash() []byte {
+	return f.hash
+}
+
+//Compare 手续费率相同时先进入的优先
+func (f *feeItem) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*feeItem)
+	if f.item.EnterTime < it.item.EnterTime {
+		return skiplist.Big
+	} else if f.item.EnterTime == it.item.EnterTime {
+		return bytes.Compare(it.hash, f.hash)
+	}
+	return skiplist.Small
+}
+
+func (f *feeItem) ByteSize() int64 {
+	return f.size
+}
+
+//Exist 是否存在
+func (cache *Queue) Exist(hash string) bool {
+	return cache.txList.Exist(hash)
+}
+
+//GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*mempool.Item, error) {
+	item, err := cache.txList.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*feeItem).item, nil
+}
+
+//Push 把给定tx添加到队列; 队列已满时返回 ErrMemFull, 由上层通过 Evict 淘汰交易后再放入
+func (cache *Queue) Push(tx *mempool.Item) error {
+	item := newFeeItem(tx)
+	if cache.Exist(string(item.hash)) {
+		return types.ErrTxExist
+	}
+	if int64(cache.Size()) >= cache.subConfig.PoolCacheSize {
+		return types.ErrMemFull
+	}
+	cache.txList.Insert(string(item.hash), item)
+	return nil
+}
+
+//Evict 队列已满时, 返回手续费率最低且低于tx的交易, 没有可以淘汰的交易返回nil
+func (cache *Queue) Evict(tx *mempool.Item) *mempool.Item {
+	tail := cache.txList.Last()
+	if tail == nil {
+		return nil
+	}
+	item := newFeeItem(tx)
+	if item.score > tail.GetScore() {
+		return tail.(*feeItem).item
+	}
+	return nil
+}
+
+//Remove 删除数据
+func (cache *Queue) Remove(hash string) error {
+	return cache.txList.Remove(hash)
+}
+
+//Size 数据总数
+func (cache *Queue) Size() int {
+	return cache.txList.Size()
+}
+
+//Walk 按手续费率从高到低遍历队列
+func (cache *Queue) Walk(count int, cb func(value *mempool.Item) bool) {
+	cache.txList.Walk(count, func(value skiplist.Scorer) bool {
+		return cb(value.(*feeItem).item)
+	})
+}
+
+//GetProperFee 进入下一个区块需要的手续费率
+//队列中的交易不足一个区块时返回配置的手续费率, 否则需要高于下一个区块中最低的手续费率
+func (cache *Queue) GetProperFee() int64 {
+	var count, size, marginal int64
+	full := false
+	cache.txList.Walk(0, func(value skiplist.Scorer) bool {
+		item := value.(*feeItem)
+		if count >= cache.subConfig.BlockTxNumber || size+item.size > cache.subConfig.BlockSize {
+			full = true
+			return false
+		}
+		count++
+		size += item.size
+		marginal = item.score
+		return true
+	})
+	if !full || marginal+1 < cache.subConfig.ProperFee {
+		return cache.subConfig.ProperFee
+	}
+	return marginal + 1
+}
+
+//GetCacheBytes 获取缓存占用空间大小
+func (cache *Queue) GetCacheBytes() int64 {
+	return cache.txList.GetCacheBytes()
+}
@@ -0,0 +1,100 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feerate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMempool(t *testing.T) {
+	sub, _ := json.Marshal(&SubConfig{PoolCacheSize: 2})
+	module := New(&types.Mempool{}, sub)
+	mem := module.(*mempool.Mempool)
+	mem.Close()
+}
+
+func newItem(payload string, fee int64) *mempool.Item {
+	tx := &types.Transaction{Payload: []byte(payload), Fee: fee}
+	return &mempool.Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
+}
+
+func TestQueueOrder(t *testing.T) {
+	cache := NewQueue(SubConfig{PoolCacheSize: 3, ProperFee: 100000, BlockTxNumber: 10, BlockSize: types.MaxBlockSize})
+	item1 := newItem("1", 10000)
+	item2 := newItem("2", 300000)
+	//同样的手续费, 交易更大费率更低
+	item3 := newItem("3333333333333333333333333333333333333333", 300000)
+	assert.Nil(t, cache.Push(item1))
+	assert.Nil(t, cache.Push(item2))
+	assert.Nil(t, cache.Push(item3))
+	assert.Equal(t, types.ErrTxExist, cache.Push(item1))
+	assert.Equal(t, types.ErrMemFull, cache.Push(newItem("4", 200000)))
+	assert.Equal(t, 3, cache.Size())
+
+	var items []*mempool.Item
+	cache.Walk(0, func(value *mempool.Item) bool {
+		items = append(items, value)
+		return true
+	})
+	assert.Equal(t, []*mempool.Item{item2, item3, item1}, items)
+
+	it, err := cache.GetItem(string(item3.Value.Hash()))
+	assert.Nil(t, err)
+	assert.Equal(t, item3, it)
+	bytes := cache.GetCacheBytes()
+	assert.Nil(t, cache.Remove(string(item3.Value.Hash())))
+	assert.Equal(t, bytes-int64(types.Size(item3.Value)), cache.GetCacheBytes())
+	_, err = cache.GetItem(string(item3.Value.Hash()))
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestQueueEvict(t *testing.T) {
+	mem := mempool.NewMempool(&types.Mempool{PoolCacheSize: 2})
+	defer mem.Close()
+	mem.SetQueueCache(NewQueue(SubConfig{PoolCacheSize: 2, ProperFee: 100000, BlockTxNumber: 10, BlockSize: types.MaxBlockSize}))
+
+	tx1 := &types.Transaction{Payload: []byte("1"), Fee: 200000}
+	tx2 := &types.Transaction{Payload: []byte("2"), Fee: 100000}
+	assert.Nil(t, mem.PushTx(tx1))
+	assert.Nil(t, mem.PushTx(tx2))
+	//费率不高于队列中最低的交易, 不能进入
+	assert.Equal(t, types.ErrMemFull, mem.PushTx(&types.Transaction{Payload: []byte("3"), Fee: 100000}))
+
+	tx4 := &types.Transaction{Payload: []byte("4"), Fee: 300000}
+	assert.Nil(t, mem.PushTx(tx4))
+	assert.Equal(t, 2, mem.Size())
+	for _, tx := range mem.GetLatestTx() {
+		assert.NotEqual(t, tx2.Hash(), tx.Hash())
+	}
+	assert.Equal(t, int64(2), mem.TxNumOfAccount(tx1.From()))
+	assert.Equal(t, int64(types.Size(tx1)+types.Size(tx4)), mem.GetTotalCacheBytes())
+}
+
+func TestGetProperFee(t *testing.T) {
+	cache := NewQueue(SubConfig{PoolCacheSize: 10, ProperFee: 1000, BlockTxNumber: 2, BlockSize: types.MaxBlockSize})
+	//不足一个区块
+	assert.Equal(t, int64(1000), cache.GetProperFee())
+	item1 := newItem("1", 1000000)
+	item2 := newItem("2", 500000)
+	assert.Nil(t, cache.Push(item1))
+	assert.Nil(t, cache.Push(item2))
+	assert.Equal(t, int64(1000), cache.GetProperFee())
+
+	//区块已满, 需要高于区块中最低的费率
+	assert.Nil(t, cache.Push(newItem("3", 100000)))
+	size := int64(types.Size(item2.Value))
+	assert.Equal(t, item2.Value.Fee*1000/size+1, cache.GetProperFee())
+
+	//按区块大小限制
+	cache.subConfig.BlockTxNumber = 10
+	cache.subConfig.BlockSize = int64(types.Size(item1.Value))
+	size = int64(types.Size(item1.Value))
+	assert.Equal(t, item1.Value.Fee*1000/size+1, cache.GetProperFee())
+}
@@ -5,5 +5,6 @@
 package init
 
 import (
+	_ "github.com/33cn/chain33/system/mempool/feerate"  //按照每千字节手续费排序, 满时淘汰费率最低的交易
 	_ "github.com/33cn/chain33/system/mempool/timeline" //最简单的排队模式，按照时间
 )
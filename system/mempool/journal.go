@@ -0,0 +1,155 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+var journalKeyPrefix = []byte("mempool-journal-")
+
+const (
+	defaultJournalPath            = "datadir/mempool"
+	defaultJournalDriver          = "leveldb"
+	defaultJournalCompactInterval = 600
+)
+
+//journal mempool交易日志, 记录进入和移出mempool的交易, 节点重启后重新放入mempool
+type journal struct {
+	db dbm.DB
+}
+
+func newJournal(cfg *types.Mempool) *journal {
+	if cfg.JournalDriver == "" {
+		cfg.JournalDriver = defaultJournalDriver
+	}
+	if cfg.JournalPath == "" {
+		cfg.JournalPath = defaultJournalPath
+	}
+	if cfg.JournalCompactInterval <= 0 {
+		cfg.JournalCompactInterval = defaultJournalCompactInterval
+	}
+	return &journal{db: dbm.NewDB("mempool", cfg.JournalDriver, cfg.JournalPath, 16)}
+}
+
+func journalKey(hash []byte) []byte {
+	return append(append([]byte{}, journalKeyPrefix...), hash...)
+}
+
+func (j *journal) add(tx *types.Transaction) {
+	err := j.db.Set(journalKey(tx.Hash()), types.Encode(tx))
+	if err != nil {
+		mlog.Error("journal add", "err", err)
+	}
+}
+
+func (j *journal) remove(hash string) {
+	err := j.db.Delete(journalKey([]byte(hash)))
+	if err != nil {
+		mlog.Error("journal remove", "err", err)
+	}
+}
+
+//load 读取日志中的所有交易
+func (j *journal) load() []*types.Transaction {
+	var txs []*types.Transaction
+	for _, value := range dbm.NewListHelper(j.db).PrefixScan(journalKeyPrefix) {
+		var tx types.Transaction
+		err := types.Decode(value, &tx)
+		if err != nil {
+			mlog.Error("journal load", "decode err", err)
+			continue
+		}
+		txs = append(txs, &tx)
+	}
+	return txs
+}
+
+//compact 删除已经不在mempool中的交易记录, 并压缩数据库, 返回删除的记录数
+func (j *journal) compact(exist func(hash string) bool) int {
+	it := j.db.Iterator(journalKeyPrefix, nil, false)
+	batch := j.db.NewBatch(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		hash := it.Key()[len(journalKeyPrefix):]
+		if !exist(string(hash)) {
+			batch.Delete(journalKey(hash))
+		}
+	}
+	it.Close()
+	count := batch.ValueLen()
+	if count == 0 {
+		return 0
+	}
+	dbm.MustWrite(batch)
+	err := j.db.CompactRange(nil, nil)
+	if err != nil {
+		mlog.Error("journal compact", "err", err)
+	}
+	return count
+}
+
+func (j *journal) close() {
+	j.db.Close()
+}
+
+//replayJournal mempool同步完成后, 通过正常的交易检查流程重新放入日志中的交易, 过期和重复的交易会被丢弃
+func (mem *Mempool) replayJournal() {
+	for !mem.getSync() || mem.GetHeader() == nil {
+		if mem.isClose() {
+			return
+		}
+		time.Sleep(time.Second)
+	}
+	txs := mem.cache.journal.load()
+	var count int
+	for _, tx := range txs {
+		if mem.isClose() {
+			return
+		}
+		msg := mem.client.NewMessage("mempool", types.EventTx, tx)
+		err := mem.client.Send(msg, true)
+		if err != nil {
+			mlog.Error("replayJournal", "send err", err)
+			return
+		}
+		resp, err := mem.client.Wait(msg)
+		if err != nil {
+			mlog.Error("replayJournal", "wait err", err)
+			return
+		}
+		if reply, ok := resp.GetData().(*types.Reply); ok && reply.GetIsOk() {
+			count++
+		}
+	}
+	mlog.Info("replayJournal", "total", len(txs), "accepted", count)
+	mem.compactJournal()
+}
+
+//compactJournal 删除日志中已经不在mempool的交易
+func (mem *Mempool) compactJournal() {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	count := mem.cache.journal.compact(mem.cache.Exist)
+	mlog.Debug("compactJournal", "removed", count)
+}
+
+//journalProcess 启动时回放日志, 之后定期压缩
+func (mem *Mempool) journalProcess() {
+	defer mem.wg.Done()
+	mem.replayJournal()
+	ticker := time.NewTicker(time.Duration(mem.cfg.JournalCompactInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-mem.done:
+			return
+		case <-ticker.C:
+			mem.compactJournal()
+		}
+	}
+}
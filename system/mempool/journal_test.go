@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempooljournal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	j := newJournal(&types.Mempool{JournalPath: dir})
+	defer j.close()
+	txa := createTx(privKey, toAddr, 10000)
+	txb := createTx(privKey, toAddr, 20000)
+	j.add(txa)
+	j.add(txb)
+	j.add(txb)
+	assert.Equal(t, 2, len(j.load()))
+
+	j.remove(string(txa.Hash()))
+	txs := j.load()
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, txb.Hash(), txs[0].Hash())
+
+	j.add(txa)
+	count := j.compact(func(hash string) bool {
+		return hash == string(txa.Hash())
+	})
+	assert.Equal(t, 1, count)
+	txs = j.load()
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, txa.Hash(), txs[0].Hash())
+	assert.Equal(t, 0, j.compact(func(string) bool { return true }))
+}
+
+func initJournalEnv(dir string) (queue.Queue, *Mempool) {
+	cfg := types.NewChain33Config(types.ReadFile("../../cmd/chain33/chain33.test.toml"))
+	mcfg := cfg.GetModuleConfig()
+	var q = queue.New("channel")
+	q.SetConfig(cfg)
+	blockchainProcess(q)
+	execProcess(q)
+	mcfg.Mempool.EnableJournal = true
+	mcfg.Mempool.JournalPath = dir
+	subConfig := SubConfig{mcfg.Mempool.PoolCacheSize, mcfg.Mempool.MinTxFeeRate}
+	mem := NewMempool(mcfg.Mempool)
+	mem.SetQueueCache(NewSimpleQueue(subConfig))
+	mem.SetQueueClient(q.Client())
+	mem.setSync(true)
+	mem.SetMinFee(cfg.GetMinTxFeeRate())
+	mem.Wait()
+	return q, mem
+}
+
+func TestJournalReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempooljournal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q, mem := initJournalEnv(dir)
+	txa := createTx(privKey, toAddr, 10000)
+	txb := createTx(privKey, toAddr, 20000)
+	for _, tx := range []*types.Transaction{txa, txb} {
+		msg := mem.client.NewMessage("mempool", types.EventTx, tx)
+		assert.Nil(t, mem.client.Send(msg, true))
+		reply, err := mem.client.Wait(msg)
+		assert.Nil(t, err)
+		assert.True(t, reply.GetData().(*types.Reply).GetIsOk())
+	}
+	assert.Equal(t, 2, mem.Size())
+	mem.RemoveTxs(&types.TxHashList{Hashes: [][]byte{txa.Hash()}})
+	//重启前记录一笔已经过期的交易
+	expired := createTx(privKey, toAddr, 30000)
+	expired.Expire = 1
+	expired.Sign(types.SECP256K1, privKey)
+	mem.cache.journal.add(expired)
+	mem.Close()
+	q.Close()
+
+	q, mem = initJournalEnv(dir)
+	defer q.Close()
+	defer mem.Close()
+	for i := 0; i < 100 && mem.Size() == 0; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.Equal(t, 1, mem.Size())
+	assert.True(t, mem.cache.Exist(string(txb.Hash())))
+	//回放后日志中只保留mempool中的交易
+	for i := 0; i < 100 && len(mem.cache.journal.load()) != 1; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	txs := mem.cache.journal.load()
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, txb.Hash(), txs[0].Hash())
+}
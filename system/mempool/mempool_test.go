@@ -7,6 +7,7 @@ package mempool
 import (
 	"errors"
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/33cn/chain33/util"
@@ -169,10 +170,13 @@ func initEnv3() (queue.Queue, queue.Module, queue.Module, *Mempool) {
 }
 
 func initEnv(size int) (queue.Queue, *Mempool) {
+	return initEnvWithCfg(types.NewChain33Config(types.ReadFile("../../cmd/chain33/chain33.test.toml")), size)
+}
+
+func initEnvWithCfg(cfg *types.Chain33Config, size int) (queue.Queue, *Mempool) {
 	if size == 0 {
 		size = 100
 	}
-	cfg := types.NewChain33Config(types.ReadFile("../../cmd/chain33/chain33.test.toml"))
 	mcfg := cfg.GetModuleConfig()
 	var q = queue.New("channel")
 	q.SetConfig(cfg)
@@ -1265,3 +1269,82 @@ func BenchmarkGetTxList(b *testing.B) {
 		mem.getTxList(&types.TxHashList{Hashes: nil, Count: int64(txNum)})
 	}
 }
+
+func TestReplaceTx(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.ReplaceFeePercent = 10
+
+	old := createTx(privKey, toAddr, 10000)
+	assert.Nil(t, mem.PushTx(old))
+	other := createTx(privKey, toAddr, 10000)
+	assert.Nil(t, mem.PushTx(other))
+
+	//相同nonce, 手续费提高不足
+	low := createTx(privKey, toAddr, 20000)
+	low.Nonce = old.Nonce
+	low.Fee = old.Fee * 105 / 100
+	low.Sign(types.SECP256K1, privKey)
+	assert.Equal(t, types.ErrReplaceTxFeeTooLow, mem.PushTx(low))
+
+	tx := createTx(privKey, toAddr, 20000)
+	tx.Nonce = old.Nonce
+	tx.Fee = old.Fee * 110 / 100
+	tx.Sign(types.SECP256K1, privKey)
+	assert.Nil(t, mem.PushTx(tx))
+	assert.Equal(t, 2, mem.Size())
+	assert.Equal(t, int64(2), mem.TxNumOfAccount(old.From()))
+	assert.Nil(t, mem.cache.getTxByHash(string(old.Hash())))
+	assert.NotNil(t, mem.cache.getTxByHash(string(tx.Hash())))
+	for _, last := range mem.GetLatestTx() {
+		assert.NotEqual(t, old.Hash(), last.Hash())
+	}
+	assert.Equal(t, tx.Fee+other.Fee, mem.cache.TotalFee())
+
+	//关闭替换后, 冲突交易正常进入
+	mem.cfg.ReplaceFeePercent = 0
+	dup := createTx(privKey, toAddr, 30000)
+	dup.Nonce = tx.Nonce
+	dup.Sign(types.SECP256K1, privKey)
+	assert.Nil(t, mem.PushTx(dup))
+	assert.Equal(t, 3, mem.Size())
+}
+
+func TestIsConflictTx(t *testing.T) {
+	old := &types.Transaction{Nonce: 1, GroupCount: 2, Expire: 100, Header: []byte("header")}
+	assert.True(t, isConflictTx(old, &types.Transaction{Nonce: 1}))
+	//过期时间和交易数量相同的不同交易组不冲突
+	assert.False(t, isConflictTx(old, &types.Transaction{Nonce: 2, GroupCount: 2, Expire: 100, Header: []byte("other")}))
+	assert.True(t, isConflictTx(old, &types.Transaction{Nonce: 2, GroupCount: 2, Expire: 200, Header: []byte("header")}))
+	assert.False(t, isConflictTx(old, &types.Transaction{Nonce: 2, Header: []byte("header")}))
+}
+
+func TestBLSVerifyLimit(t *testing.T) {
+	//local链上所有的fork都已开启
+	cfgstr := strings.Replace(types.ReadFile("../../cmd/chain33/chain33.test.toml"), `Title="chain33"`, `Title="local"`, 1)
+	q, mem := initEnvWithCfg(types.NewChain33Config(cfgstr), 0)
+	defer q.Close()
+	defer mem.Close()
+	mem.blsLimiter = newVerifyLimiter(1)
+
+	cr, err := crypto.New(types.GetSignName("", types.BLS))
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	for i, expect := range []error{nil, types.ErrBLSVerifyLimited} {
+		tx := &types.Transaction{Execer: []byte("coins"), Payload: types.Encode(transfer), Fee: 1000000, Expire: 0, To: toAddr, Nonce: int64(i)}
+		tx.Sign(types.BLS, priv)
+		msg := mem.client.NewMessage("mempool", types.EventTx, tx)
+		mem.client.Send(msg, true)
+		resp, _ := mem.client.Wait(msg)
+		if expect == nil {
+			assert.NotEqual(t, types.ErrBLSVerifyLimited.Error(), string(resp.GetData().(*types.Reply).GetMsg()))
+		} else {
+			assert.Equal(t, expect.Error(), string(resp.GetData().(*types.Reply).GetMsg()))
+		}
+	}
+
+	l := newVerifyLimiter(-1)
+	assert.True(t, l.allow(100))
+}
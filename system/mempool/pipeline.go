@@ -27,6 +27,38 @@ func step(done <-chan struct{}, in <-chan *queue.Message, cb func(*queue.Message
 	return out
 }
 
+//stepBatch 每次从in中读取已经到达的一批消息(最多size条)一起处理, 不等待后续的消息
+func stepBatch(done <-chan struct{}, in <-chan *queue.Message, size int, cb func([]*queue.Message)) <-chan *queue.Message {
+	out := make(chan *queue.Message)
+	go func() {
+		defer close(out)
+		for n := range in {
+			batch := []*queue.Message{n}
+		collect:
+			for len(batch) < size {
+				select {
+				case m, ok := <-in:
+					if !ok {
+						break collect
+					}
+					batch = append(batch, m)
+				default:
+					break collect
+				}
+			}
+			cb(batch)
+			for _, m := range batch {
+				select {
+				case out <- m:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
 func merge(done <-chan struct{}, cs []<-chan *queue.Message) <-chan *queue.Message {
 	var wg sync.WaitGroup
 	out := make(chan *queue.Message)
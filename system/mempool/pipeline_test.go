@@ -54,6 +54,28 @@ func TestMutiStep(t *testing.T) {
 	close(done)
 }
 
+func TestStepBatch(t *testing.T) {
+	done := make(chan struct{})
+	in := make(chan *queue.Message, 10)
+	for i := 0; i < 10; i++ {
+		in <- &queue.Message{ID: int64(i)}
+	}
+	var sizes []int
+	cb := func(msgs []*queue.Message) {
+		sizes = append(sizes, len(msgs))
+		for _, msg := range msgs {
+			msg.ID += 100
+		}
+	}
+	out := stepBatch(done, in, 4, cb)
+	for i := 0; i < 10; i++ {
+		msg := <-out
+		assert.Equal(t, int64(i+100), msg.ID)
+	}
+	assert.Equal(t, []int{4, 4, 2}, sizes)
+	close(done)
+}
+
 func BenchmarkStep(b *testing.B) {
 	done := make(chan struct{})
 	in := make(chan *queue.Message)
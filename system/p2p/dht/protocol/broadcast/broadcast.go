@@ -80,6 +80,7 @@ func (protocol *broadcastProtocol) InitProtocol(env *prototypes.P2PEnv) {
 	//注册事件处理函数
 	prototypes.RegisterEventHandler(types.EventTxBroadcast, protocol.handleBroadCastEvent)
 	prototypes.RegisterEventHandler(types.EventBlockBroadcast, protocol.handleBroadCastEvent)
+	prototypes.RegisterEventHandler(types.EventTxReplaced, protocol.handleTxReplacedEvent)
 
 	//ttl至少设为2
 	if subCfg.LightTxTTL <= 1 {
@@ -147,6 +148,19 @@ func (protocol *broadcastProtocol) handleBroadCastEvent(msg *queue.Message) {
 	}
 }
 
+// 处理mempool替换交易事件, 不再接收被替换的交易, 并将替换的交易转发给其他节点
+// 替换的交易可能是从其他节点接收的, 这里不检查接收过滤, 保证本节点完成替换后总会转发
+func (protocol *broadcastProtocol) handleTxReplacedEvent(msg *queue.Message) {
+	req, ok := msg.GetData().(*types.P2PTxReplaced)
+	if !ok || req.GetTx() == nil {
+		log.Error("handleTxReplacedEvent", "receive unexpect msg", msg)
+		return
+	}
+	protocol.txFilter.Add(hex.EncodeToString(req.GetOldHash()), struct{}{})
+	protocol.txFilter.Add(hex.EncodeToString(req.GetTx().Hash()), struct{}{})
+	protocol.ps.FIFOPub(req.GetTx(), psTxTopic)
+}
+
 // 发送广播数据到节点, 支持延迟关闭内部stream，主要考虑多个节点并行发送情况，不需要等待关闭
 func (protocol *broadcastProtocol) sendPeer(data interface{}, pid, version string) error {
 
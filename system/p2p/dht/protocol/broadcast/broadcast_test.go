@@ -10,6 +10,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/33cn/chain33/system/p2p/dht/net"
 	"github.com/libp2p/go-libp2p"
@@ -142,6 +143,26 @@ func TestBroadCastEvent(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestTxReplacedEvent(t *testing.T) {
+	protocol := newTestProtocol()
+	out := protocol.ps.Sub(psTxTopic)
+	defer protocol.ps.Unsub(out)
+	oldHash := hex.EncodeToString(tx.Hash())
+	//替换的交易从其他节点接收, 也需要转发
+	protocol.txFilter.Add(hex.EncodeToString(tx1.Hash()), true)
+	protocol.handleTxReplacedEvent(protocol.QueueClient.NewMessage("p2p", types.EventTxReplaced, &types.P2PTxReplaced{OldHash: tx.Hash(), Tx: tx1}))
+	assert.True(t, protocol.txFilter.Contains(oldHash))
+	select {
+	case data := <-out:
+		assert.Equal(t, tx1.Hash(), data.(*types.Transaction).Hash())
+	case <-time.After(time.Second):
+		t.Error("replaced tx not published")
+	}
+	//被替换的交易不再提交到mempool
+	assert.Nil(t, protocol.recvTx(&types.P2PTx{Tx: tx}, testPidStr))
+	protocol.handleTxReplacedEvent(protocol.QueueClient.NewMessage("p2p", types.EventTxReplaced, &types.ReqHashes{}))
+}
+
 func Test_util(t *testing.T) {
 	proto := newTestProtocol()
 	handler := &broadcastHandler{}
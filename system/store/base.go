@@ -57,6 +57,11 @@ type SubStore interface {
 	CommitUpgrade(hash *types.ReqHash) ([]byte, error)
 }
 
+// StateChecker 可以判断状态是否存在的store, 比如开启裁剪的mavl, 历史状态可能已经被删除
+type StateChecker interface {
+	HasState(statehash []byte) bool
+}
+
 // BaseStore 基础的store结构体
 type BaseStore struct {
 	db      dbm.DB
@@ -194,6 +199,10 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 		go func() {
 			defer store.wg.Done()
 			req := msg.GetData().(*types.StoreList)
+			if checker, ok := store.child.(StateChecker); ok && !checker.HasState(req.StateHash) {
+				msg.Reply(client.NewMessage("", types.EventStoreListReply, types.ErrStatePruned))
+				return
+			}
 			query := NewStoreListQuery(store.child, req)
 			msg.Reply(client.NewMessage("", types.EventStoreListReply, query.Run()))
 		}()
@@ -219,14 +219,58 @@ func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, mavls.treeCfg, fn)
 }
 
-// ProcEvent not support message
+// HasState 状态的根节点是否存在, 被裁剪掉的历史状态返回false
+func (mavls *Store) HasState(statehash []byte) bool {
+	if _, ok := mavls.trees.Load(string(statehash)); ok {
+		return true
+	}
+	tree := mavl.NewTree(mavls.GetDB(), true, mavls.treeCfg)
+	return tree.Load(statehash) == nil
+}
+
+// ProcEvent 处理mavl特有的消息, 目前只支持状态证明查询
 func (mavls *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
+	if msg.Ty == types.EventStoreGetProof {
+		client := mavls.GetQueueClient()
+		reply, err := mavls.GetProof(msg.GetData().(*types.StoreGet))
+		if err != nil {
+			msg.Reply(client.NewMessage("", types.EventStoreGetProofReply, err))
+			return
+		}
+		msg.Reply(client.NewMessage("", types.EventStoreGetProofReply, reply))
+		return
+	}
 	msg.ReplyErr("Store", types.ErrActionNotSupport)
 }
 
+// GetProof 获取keys在指定状态下的value及默克尔证明, key不存在时不返回证明
+func (mavls *Store) GetProof(req *types.StoreGet) (*types.ReplyStateProof, error) {
+	tree := mavl.NewTree(mavls.GetDB(), true, mavls.treeCfg)
+	err := tree.Load(req.StateHash)
+	if err != nil {
+		mlog.Error("store mavl GetProof", "err", err, "StateHash", common.ToHex(req.StateHash))
+		if err == mavl.ErrNodeNotExist {
+			return nil, types.ErrStatePruned
+		}
+		return nil, err
+	}
+	reply := &types.ReplyStateProof{StateHash: req.StateHash}
+	for _, key := range req.Keys {
+		value, proof := tree.ConstructProof(key)
+		item := &types.StateProof{Key: key}
+		if proof != nil {
+			item.Value = value
+			item.Exists = true
+			item.Proof = &types.MAVLProof{LeafHash: proof.LeafHash, InnerNodes: proof.InnerNodes, RootHash: proof.RootHash}
+		}
+		reply.Proofs = append(reply.Proofs, item)
+	}
+	return reply, nil
+}
+
 // Del ...
 func (mavls *Store) Del(req *types.StoreDel) ([]byte, error) {
 	//not support
@@ -18,6 +18,7 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/queue"
 	drivers "github.com/33cn/chain33/system/store"
+	mavldb "github.com/33cn/chain33/system/store/mavl/db"
 	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -190,6 +191,79 @@ func TestProcEvent(t *testing.T) {
 	store.ProcEvent(&queue.Message{})
 }
 
+func TestGetProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	os.RemoveAll(dir)       //删除已存在目录
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil, nil).(*Store)
+	assert.NotNil(t, store)
+
+	var kv []*types.KeyValue
+	for i := 0; i < 10; i++ {
+		kv = append(kv, &types.KeyValue{Key: []byte(fmt.Sprintf("k%d", i)), Value: []byte(fmt.Sprintf("v%d", i))})
+	}
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+
+	keys := [][]byte{[]byte("k3"), []byte("k8"), []byte("none")}
+	reply, err := store.GetProof(&types.StoreGet{StateHash: hash, Keys: keys})
+	assert.Nil(t, err)
+	assert.Equal(t, hash, reply.StateHash)
+	assert.Len(t, reply.Proofs, 3)
+	for i, item := range reply.Proofs[:2] {
+		assert.True(t, item.Exists)
+		assert.Equal(t, keys[i], item.Key)
+		proof := &mavldb.Proof{LeafHash: item.Proof.LeafHash, InnerNodes: item.Proof.InnerNodes, RootHash: item.Proof.RootHash}
+		assert.True(t, proof.Verify(item.Key, item.Value, hash))
+		//篡改value后证明失败
+		assert.False(t, proof.Verify(item.Key, []byte("fake"), hash))
+	}
+	assert.Equal(t, []byte("v3"), reply.Proofs[0].Value)
+	assert.False(t, reply.Proofs[2].Exists)
+	assert.Nil(t, reply.Proofs[2].Proof)
+
+	//不存在的状态
+	_, err = store.GetProof(&types.StoreGet{StateHash: common.Sha256([]byte("none")), Keys: keys})
+	assert.Equal(t, types.ErrStatePruned, err)
+}
+
+func TestHasState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil, nil).(*Store)
+	assert.NotNil(t, store)
+	q := queue.New("channel")
+	store.SetQueueClient(q.Client())
+	defer store.Close()
+
+	kv := []*types.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}}
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+	memHash, err := store.MemSet(&types.StoreSet{StateHash: hash, KV: kv, Height: 1}, true)
+	assert.Nil(t, err)
+	assert.True(t, store.HasState(hash))
+	assert.True(t, store.HasState(memHash))
+	assert.True(t, store.HasState(drivers.EmptyRoot[:]))
+	pruned := common.Sha256([]byte("pruned"))
+	assert.False(t, store.HasState(pruned))
+
+	client := q.Client()
+	list := func(statehash []byte) *queue.Message {
+		msg := client.NewMessage("store", types.EventStoreList, &types.StoreList{StateHash: statehash, Count: 10, Mode: 1})
+		assert.Nil(t, client.Send(msg, true))
+		reply, _ := client.Wait(msg)
+		return reply
+	}
+	reply := list(hash)
+	assert.Equal(t, 1, len(reply.GetData().(*types.StoreListReply).Keys))
+	reply = list(pruned)
+	assert.Equal(t, types.ErrStatePruned, reply.Err())
+}
+
 func TestDel(t *testing.T) {
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
@@ -0,0 +1,187 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+)
+
+/*
+批量验证交易签名:
+1. 交易按照签名算法分组, 每组按照固定的数量分成多个批次并行验证, 批次的划分和节点的cpu数量无关
+2. 签名算法实现了 crypto.BatchCrypto 时整批验证, BatchCrypto 的结果必须和逐个验证一致
+3. 整批验证失败, 或者算法不支持批量验证时, 逐个验证找出错误的签名
+4. 多重签名以及无法解析的签名单独验证
+5. 交易的签名是各自独立的, 不能使用聚合签名验证: 聚合验证通过时, 其中的单个签名不一定正确
+*/
+
+//batchSignSize 每个批次的签名数量
+const batchSignSize = 64
+
+type signTask struct {
+	index int
+	data  []byte
+	pub   crypto.PubKey
+	sig   crypto.Signature
+}
+
+//signData 签名的数据, 交易组中的交易也只签名自己
+func (tx *Transaction) signData() []byte {
+	copytx := *tx
+	copytx.Signature = nil
+	//如果新版本扩展了字段，使用旧的数据结构解析，新字段将放在未识别字段中，导致验签失败，需要置空
+	copytx.XXX_unrecognized = nil
+	copytx.UnsetCacheHash()
+	return Encode(&copytx)
+}
+
+//BatchCheckSign 批量验证交易的签名, 返回每笔交易的签名是否正确, 和逐笔调用 Transaction.CheckSign 的结果一致
+func BatchCheckSign(txs []*Transaction) []bool {
+	results := make([]bool, len(txs))
+	drivers := make(map[string]crypto.Crypto)
+	groups := make(map[string][]*signTask)
+	var names []string
+	var singles []int
+	for i, tx := range txs {
+		sign := tx.GetSignature()
+		if sign == nil {
+			continue
+		}
+		if sign.Ty == MultiSign {
+			singles = append(singles, i)
+			continue
+		}
+		name := GetSignName(string(tx.Execer), int(sign.Ty))
+		c, ok := drivers[name]
+		if !ok {
+			var err error
+			c, err = crypto.New(name)
+			if err != nil {
+				continue
+			}
+			drivers[name] = c
+			names = append(names, name)
+		}
+		pub, err := c.PubKeyFromBytes(sign.Pubkey)
+		if err != nil {
+			continue
+		}
+		sig, err := c.SignatureFromBytes(sign.Signature)
+		if err != nil {
+			continue
+		}
+		groups[name] = append(groups[name], &signTask{index: i, data: tx.signData(), pub: pub, sig: sig})
+	}
+
+	var jobs []func()
+	for _, name := range names {
+		c, tasks := drivers[name], groups[name]
+		for start := 0; start < len(tasks); start += batchSignSize {
+			end := start + batchSignSize
+			if end > len(tasks) {
+				end = len(tasks)
+			}
+			batch := tasks[start:end]
+			jobs = append(jobs, func() { verifyBatch(c, batch, results) })
+		}
+	}
+	for _, i := range singles {
+		index := i
+		jobs = append(jobs, func() { results[index] = txs[index].checkSign() })
+	}
+	runJobs(jobs, runtime.NumCPU())
+	return results
+}
+
+//CheckCacheSign 批量验证交易(组)的签名, 交易组中所有交易的签名都正确时才通过, 验证结果缓存在交易中
+func CheckCacheSign(caches []*TransactionCache) []bool {
+	results := make([]bool, len(caches))
+	var txs []*Transaction
+	var owners []int
+	for i, tx := range caches {
+		if tx.signok != 0 {
+			continue
+		}
+		tx.signok = 2
+		group, err := tx.GetTxGroup()
+		if err != nil {
+			continue
+		}
+		//先设置为正确, 交易(组)中有错误的签名时再设置为2
+		tx.signok = 1
+		if group == nil {
+			txs = append(txs, tx.Transaction)
+			owners = append(owners, i)
+			continue
+		}
+		for _, gtx := range group.Txs {
+			txs = append(txs, gtx)
+			owners = append(owners, i)
+		}
+	}
+	for j, ok := range BatchCheckSign(txs) {
+		if !ok {
+			caches[owners[j]].signok = 2
+		}
+	}
+	for i, tx := range caches {
+		results[i] = tx.signok == 1
+	}
+	return results
+}
+
+func verifyBatch(c crypto.Crypto, tasks []*signTask, results []bool) {
+	if batch, err := crypto.ToBatch(c); err == nil && len(tasks) > 1 {
+		pubs := make([]crypto.PubKey, len(tasks))
+		msgs := make([][]byte, len(tasks))
+		sigs := make([]crypto.Signature, len(tasks))
+		for i, task := range tasks {
+			pubs[i], msgs[i], sigs[i] = task.pub, task.data, task.sig
+		}
+		if batch.VerifyBatch(pubs, msgs, sigs) {
+			setBatchOk(tasks, results)
+			return
+		}
+	}
+	//逐个验证, 找出错误的签名
+	for _, task := range tasks {
+		results[task.index] = task.pub.VerifyBytes(task.data, task.sig)
+	}
+}
+
+func setBatchOk(tasks []*signTask, results []bool) {
+	for _, task := range tasks {
+		results[task.index] = true
+	}
+}
+
+//runJobs 使用固定数量的goroutine执行任务
+func runJobs(jobs []func(), n int) {
+	if len(jobs) <= 1 {
+		for _, job := range jobs {
+			job()
+		}
+		return
+	}
+	ch := make(chan func(), len(jobs))
+	for _, job := range jobs {
+		ch <- job
+	}
+	close(ch)
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			for job := range ch {
+				job()
+			}
+		}()
+	}
+	wg.Wait()
+}
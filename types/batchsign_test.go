@@ -0,0 +1,96 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	_ "github.com/33cn/chain33/system/crypto/init"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genSignedTxs(t *testing.T, ty int32, n int) []*Transaction {
+	cr, err := crypto.New(GetSignName("", int(ty)))
+	require.Nil(t, err)
+	var txs []*Transaction
+	for i := 0; i < n; i++ {
+		priv, err := cr.GenKey()
+		require.Nil(t, err)
+		tx := &Transaction{Execer: []byte("coins"), Payload: []byte("none"), Fee: 100000, Nonce: int64(i), To: "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"}
+		tx.Sign(ty, priv)
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+func TestBatchCheckSign(t *testing.T) {
+	txs := genSignedTxs(t, SECP256K1, 20)
+	txs = append(txs, genSignedTxs(t, ED25519, 40)...)
+	for _, ok := range BatchCheckSign(txs) {
+		assert.True(t, ok)
+	}
+
+	//错误的签名只影响对应的交易
+	txs[3].Fee++
+	txs[30].Nonce++
+	txs[31].Signature = nil
+	txs[32].Signature.Signature = []byte("bad")
+	bad := map[int]bool{3: true, 30: true, 31: true, 32: true}
+	results := BatchCheckSign(txs)
+	require.Equal(t, len(txs), len(results))
+	for i, ok := range results {
+		assert.Equal(t, !bad[i], ok, "index %d", i)
+		assert.Equal(t, txs[i].CheckSign(), ok, "index %d", i)
+	}
+}
+
+func TestCheckCacheSign(t *testing.T) {
+	txs := genSignedTxs(t, ED25519, 20)
+	txs[7].Fee++
+	caches := make([]*TransactionCache, len(txs))
+	for i, tx := range txs {
+		caches[i] = NewTransactionCache(tx)
+	}
+	results := CheckCacheSign(caches)
+	for i, ok := range results {
+		assert.Equal(t, i != 7, ok)
+		assert.Equal(t, ok, caches[i].CheckSign())
+	}
+}
+
+// aggrCrypto 聚合验证总是通过, 单个验证总是失败
+type aggrCrypto struct{}
+
+func (aggrCrypto) GenKey() (crypto.PrivKey, error)                     { return nil, nil }
+func (aggrCrypto) SignatureFromBytes([]byte) (crypto.Signature, error) { return nil, nil }
+func (aggrCrypto) PrivKeyFromBytes([]byte) (crypto.PrivKey, error)     { return nil, nil }
+func (aggrCrypto) PubKeyFromBytes([]byte) (crypto.PubKey, error)       { return nil, nil }
+func (aggrCrypto) Aggregate(sigs []crypto.Signature) (crypto.Signature, error) {
+	return nil, nil
+}
+func (aggrCrypto) AggregatePublic(pubs []crypto.PubKey) (crypto.PubKey, error) { return nil, nil }
+func (aggrCrypto) VerifyAggregatedOne(pubs []crypto.PubKey, m []byte, sig crypto.Signature) error {
+	return nil
+}
+func (aggrCrypto) VerifyAggregatedN(pubs []crypto.PubKey, ms [][]byte, sig crypto.Signature) error {
+	return nil
+}
+
+type badPub struct{}
+
+func (badPub) Bytes() []byte                                     { return nil }
+func (badPub) KeyString() string                                 { return "" }
+func (badPub) VerifyBytes(msg []byte, sig crypto.Signature) bool { return false }
+func (badPub) Equals(crypto.PubKey) bool                         { return false }
+
+func TestVerifyBatchNotAggregate(t *testing.T) {
+	//各自签名的交易不能用聚合验证代替逐个验证
+	tasks := []*signTask{{index: 0, pub: badPub{}}, {index: 1, pub: badPub{}}}
+	results := []bool{true, true}
+	verifyBatch(aggrCrypto{}, tasks, results)
+	assert.Equal(t, []bool{false, false}, results)
+}
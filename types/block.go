@@ -6,8 +6,6 @@ package types
 
 import (
 	"bytes"
-	"runtime"
-	"sync"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
@@ -102,69 +100,9 @@ func (block *Block) CheckSign(cfg *Chain33Config) bool {
 		}
 	}
 	//检查交易的签名
-	cpu := runtime.NumCPU()
-	ok := checkAll(block.Txs, cpu)
-	return ok
-}
-
-func gen(done <-chan struct{}, task []*Transaction) <-chan *Transaction {
-	ch := make(chan *Transaction)
-	go func() {
-		defer func() {
-			close(ch)
-		}()
-		for i := 0; i < len(task); i++ {
-			select {
-			case ch <- task[i]:
-			case <-done:
-				return
-			}
-		}
-	}()
-	return ch
-}
-
-type result struct {
-	isok bool
-}
-
-func check(data *Transaction) bool {
-	return data.CheckSign()
-}
-
-func checksign(done <-chan struct{}, taskes <-chan *Transaction, c chan<- result) {
-	for task := range taskes {
-		select {
-		case c <- result{check(task)}:
-		case <-done:
-			return
-		}
-	}
-}
-
-func checkAll(task []*Transaction, n int) bool {
-	done := make(chan struct{})
-	defer close(done)
-
-	taskes := gen(done, task)
-
-	// Start a fixed number of goroutines to read and digest files.
-	c := make(chan result) // HLc
-	var wg sync.WaitGroup
-	wg.Add(n)
-	for i := 0; i < n; i++ {
-		go func() {
-			checksign(done, taskes, c) // HLc
-			wg.Done()
-		}()
-	}
-	go func() {
-		wg.Wait()
-		close(c) // HLc
-	}()
-	// End of pipeline. OMIT
-	for r := range c {
-		if !r.isok {
+	for i, ok := range BatchCheckSign(block.Txs) {
+		if !ok {
+			tlog.Error("block CheckSign", "height", block.Height, "index", i, "txhash", common.ToHex(block.Txs[i].Hash()))
 			return false
 		}
 	}
@@ -173,6 +111,9 @@ func checkAll(task []*Transaction, n int) bool {
 
 // CheckSign 检测签名
 func CheckSign(data []byte, execer string, sign *Signature) bool {
+	if sign.GetTy() == MultiSign {
+		return checkMultiSign(data, execer, sign)
+	}
 	//GetDefaultSign: 系统内置钱包，非插件中的签名
 	c, err := crypto.New(GetSignName(execer, int(sign.Ty)))
 	if err != nil {
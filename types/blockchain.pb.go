@@ -4,10 +4,14 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1210,7 +1214,11 @@ type ChainExecutor struct {
 	StateHash []byte `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
 	Param     []byte `protobuf:"bytes,4,opt,name=param,proto3" json:"param,omitempty"`
 	//扩展字段，用于额外的用途
-	Extra                []byte   `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
+	Extra []byte `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
+	//查询指定高度的历史状态, 为0时查询最新状态, stateHash 不为空时忽略
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	//为true时总是按照height查询, 用于查询创世区块(height为0)的状态
+	ByHeight             bool     `protobuf:"varint,7,opt,name=byHeight,proto3" json:"byHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1276,7 +1284,21 @@ func (m *ChainExecutor) GetExtra() []byte {
 	return nil
 }
 
-//  通过block hash记录block的操作类型及add/del：1/2
+func (m *ChainExecutor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ChainExecutor) GetByHeight() bool {
+	if m != nil {
+		return m.ByHeight
+	}
+	return false
+}
+
+// 通过block hash记录block的操作类型及add/del：1/2
 type BlockSequence struct {
 	Hash                 []byte   `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
 	Type                 int64    `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
@@ -2755,10 +2777,12 @@ func (m *PushWithStatus) GetStatus() int32 {
 }
 
 type PushSubscribes struct {
-	Pushes               []*PushSubscribeReq `protobuf:"bytes,1,rep,name=pushes,proto3" json:"pushes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Pushes []*PushSubscribeReq `protobuf:"bytes,1,rep,name=pushes,proto3" json:"pushes,omitempty"`
+	//与pushes一一对应的推送统计
+	Stats                []*PushStat `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *PushSubscribes) Reset()         { *m = PushSubscribes{} }
@@ -2793,6 +2817,276 @@ func (m *PushSubscribes) GetPushes() []*PushSubscribeReq {
 	return nil
 }
 
+func (m *PushSubscribes) GetStats() []*PushStat {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// 每个订阅者的推送统计
+type PushStat struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	LastPushSeq          int64    `protobuf:"varint,3,opt,name=lastPushSeq,proto3" json:"lastPushSeq,omitempty"`
+	PostCount            int64    `protobuf:"varint,4,opt,name=postCount,proto3" json:"postCount,omitempty"`
+	PostFailCount        int64    `protobuf:"varint,5,opt,name=postFailCount,proto3" json:"postFailCount,omitempty"`
+	PostBytes            int64    `protobuf:"varint,6,opt,name=postBytes,proto3" json:"postBytes,omitempty"`
+	ContinueFailCount    int32    `protobuf:"varint,7,opt,name=continueFailCount,proto3" json:"continueFailCount,omitempty"`
+	LastSuccessTime      int64    `protobuf:"varint,8,opt,name=lastSuccessTime,proto3" json:"lastSuccessTime,omitempty"`
+	LastFailTime         int64    `protobuf:"varint,9,opt,name=lastFailTime,proto3" json:"lastFailTime,omitempty"`
+	LastError            string   `protobuf:"bytes,10,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	NextRetryTime        int64    `protobuf:"varint,11,opt,name=nextRetryTime,proto3" json:"nextRetryTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushStat) Reset()         { *m = PushStat{} }
+func (m *PushStat) String() string { return proto.CompactTextString(m) }
+func (*PushStat) ProtoMessage()    {}
+func (*PushStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{49}
+}
+
+func (m *PushStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushStat.Unmarshal(m, b)
+}
+func (m *PushStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushStat.Marshal(b, m, deterministic)
+}
+func (m *PushStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushStat.Merge(m, src)
+}
+func (m *PushStat) XXX_Size() int {
+	return xxx_messageInfo_PushStat.Size(m)
+}
+func (m *PushStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushStat proto.InternalMessageInfo
+
+func (m *PushStat) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PushStat) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PushStat) GetLastPushSeq() int64 {
+	if m != nil {
+		return m.LastPushSeq
+	}
+	return 0
+}
+
+func (m *PushStat) GetPostCount() int64 {
+	if m != nil {
+		return m.PostCount
+	}
+	return 0
+}
+
+func (m *PushStat) GetPostFailCount() int64 {
+	if m != nil {
+		return m.PostFailCount
+	}
+	return 0
+}
+
+func (m *PushStat) GetPostBytes() int64 {
+	if m != nil {
+		return m.PostBytes
+	}
+	return 0
+}
+
+func (m *PushStat) GetContinueFailCount() int32 {
+	if m != nil {
+		return m.ContinueFailCount
+	}
+	return 0
+}
+
+func (m *PushStat) GetLastSuccessTime() int64 {
+	if m != nil {
+		return m.LastSuccessTime
+	}
+	return 0
+}
+
+func (m *PushStat) GetLastFailTime() int64 {
+	if m != nil {
+		return m.LastFailTime
+	}
+	return 0
+}
+
+func (m *PushStat) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *PushStat) GetNextRetryTime() int64 {
+	if m != nil {
+		return m.NextRetryTime
+	}
+	return 0
+}
+
+// grpc 和文件方式推送的数据, signature 为节点对数据的HMAC-SHA256签名
+type PushData struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type                 int32    `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	Encode               string   `protobuf:"bytes,4,opt,name=encode,proto3" json:"encode,omitempty"`
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp            int64    `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature            string   `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushData) Reset()         { *m = PushData{} }
+func (m *PushData) String() string { return proto.CompactTextString(m) }
+func (*PushData) ProtoMessage()    {}
+func (*PushData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{50}
+}
+
+func (m *PushData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushData.Unmarshal(m, b)
+}
+func (m *PushData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushData.Marshal(b, m, deterministic)
+}
+func (m *PushData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushData.Merge(m, src)
+}
+func (m *PushData) XXX_Size() int {
+	return xxx_messageInfo_PushData.Size(m)
+}
+func (m *PushData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushData proto.InternalMessageInfo
+
+func (m *PushData) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PushData) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *PushData) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *PushData) GetEncode() string {
+	if m != nil {
+		return m.Encode
+	}
+	return ""
+}
+
+func (m *PushData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *PushData) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PushData) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type PushAck struct {
+	Seq                  int64    `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	IsOk                 bool     `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Msg                  string   `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushAck) Reset()         { *m = PushAck{} }
+func (m *PushAck) String() string { return proto.CompactTextString(m) }
+func (*PushAck) ProtoMessage()    {}
+func (*PushAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{51}
+}
+
+func (m *PushAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushAck.Unmarshal(m, b)
+}
+func (m *PushAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushAck.Marshal(b, m, deterministic)
+}
+func (m *PushAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushAck.Merge(m, src)
+}
+func (m *PushAck) XXX_Size() int {
+	return xxx_messageInfo_PushAck.Size(m)
+}
+func (m *PushAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushAck proto.InternalMessageInfo
+
+func (m *PushAck) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *PushAck) GetIsOk() bool {
+	if m != nil {
+		return m.IsOk
+	}
+	return false
+}
+
+func (m *PushAck) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
 type ReplySubscribePush struct {
 	IsOk                 bool     `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
 	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -2805,7 +3099,7 @@ func (m *ReplySubscribePush) Reset()         { *m = ReplySubscribePush{} }
 func (m *ReplySubscribePush) String() string { return proto.CompactTextString(m) }
 func (*ReplySubscribePush) ProtoMessage()    {}
 func (*ReplySubscribePush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{49}
+	return fileDescriptor_e9ac6287ce250c9a, []int{52}
 }
 
 func (m *ReplySubscribePush) XXX_Unmarshal(b []byte) error {
@@ -2891,6 +3185,9 @@ func init() {
 	proto.RegisterMapType((map[string]bool)(nil), "types.PushSubscribeReq.ContractEntry")
 	proto.RegisterType((*PushWithStatus)(nil), "types.PushWithStatus")
 	proto.RegisterType((*PushSubscribes)(nil), "types.PushSubscribes")
+	proto.RegisterType((*PushStat)(nil), "types.PushStat")
+	proto.RegisterType((*PushData)(nil), "types.PushData")
+	proto.RegisterType((*PushAck)(nil), "types.PushAck")
 	proto.RegisterType((*ReplySubscribePush)(nil), "types.ReplySubscribePush")
 }
 
@@ -2899,118 +3196,245 @@ func init() {
 }
 
 var fileDescriptor_e9ac6287ce250c9a = []byte{
-	// 1800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x8f, 0x1b, 0x49,
-	0x11, 0xd7, 0xcc, 0xd8, 0x5e, 0xbb, 0xbc, 0xde, 0xdb, 0x0c, 0x2b, 0xb0, 0x56, 0x70, 0xb7, 0xd7,
-	0xe4, 0x82, 0x09, 0xc1, 0x41, 0xbb, 0xa7, 0x5c, 0x14, 0x90, 0xe0, 0xe2, 0x04, 0xed, 0x2a, 0x77,
-	0xb9, 0x30, 0xbb, 0x09, 0x12, 0x6f, 0xb3, 0xe3, 0x5e, 0x7b, 0x58, 0xcf, 0x1f, 0x4f, 0xf7, 0x2c,
-	0xf6, 0x3d, 0xf1, 0x8c, 0xc4, 0x17, 0x41, 0x7c, 0x0c, 0x5e, 0x78, 0xb9, 0xaf, 0xc0, 0x57, 0x41,
-	0x55, 0xdd, 0x3d, 0xd3, 0xe3, 0x78, 0x43, 0x22, 0xc4, 0x03, 0x6f, 0x5d, 0xd5, 0x55, 0x5d, 0xbf,
-	0xaa, 0xae, 0xaa, 0xae, 0x19, 0xd8, 0xbf, 0x5c, 0x64, 0xd1, 0x75, 0x34, 0x0f, 0xe3, 0x74, 0x9c,
-	0x17, 0x99, 0xcc, 0xfc, 0xb6, 0x5c, 0xe7, 0x5c, 0x1c, 0xde, 0x91, 0x45, 0x98, 0x8a, 0x30, 0x92,
-	0x71, 0xa6, 0x77, 0x0e, 0x77, 0xa3, 0x2c, 0x49, 0x0c, 0xc5, 0xfe, 0xee, 0x42, 0xe7, 0x94, 0x87,
-	0x53, 0x5e, 0xf8, 0x43, 0xd8, 0xb9, 0xe1, 0x85, 0x88, 0xb3, 0x74, 0xe8, 0x1c, 0x39, 0x23, 0x2f,
-	0x30, 0xa4, 0xff, 0x31, 0x40, 0x1e, 0x16, 0x3c, 0x95, 0xa7, 0xa1, 0x98, 0x0f, 0xdd, 0x23, 0x67,
-	0xb4, 0x1b, 0x58, 0x1c, 0xff, 0xfb, 0xd0, 0x91, 0x2b, 0xda, 0xf3, 0x68, 0x4f, 0x53, 0xfe, 0x0f,
-	0xa1, 0x27, 0x64, 0x28, 0x39, 0x6d, 0xb5, 0x68, 0xab, 0x66, 0xa0, 0xd6, 0x9c, 0xc7, 0xb3, 0xb9,
-	0x1c, 0xb6, 0xc9, 0x9c, 0xa6, 0x50, 0x8b, 0xdc, 0xb9, 0x88, 0x13, 0x3e, 0xec, 0xd0, 0x56, 0xcd,
-	0x40, 0x94, 0x72, 0x35, 0xc9, 0xca, 0x54, 0x0e, 0x7b, 0x0a, 0xa5, 0x26, 0x7d, 0x1f, 0x5a, 0x73,
-	0x34, 0x04, 0x64, 0x88, 0xd6, 0x88, 0x7c, 0x1a, 0x5f, 0x5d, 0xc5, 0x51, 0xb9, 0x90, 0xeb, 0x61,
-	0xff, 0xc8, 0x19, 0x0d, 0x02, 0x8b, 0xe3, 0x8f, 0xa1, 0x27, 0xe2, 0x59, 0x1a, 0xca, 0xb2, 0xe0,
-	0xc3, 0xee, 0x91, 0x33, 0xea, 0x1f, 0xef, 0x8f, 0x29, 0x74, 0xe3, 0x73, 0xc3, 0x0f, 0x6a, 0x11,
-	0xf6, 0x2f, 0x17, 0xda, 0x4f, 0x11, 0xcb, 0xff, 0x49, 0xb4, 0xfe, 0x93, 0xff, 0x87, 0xd0, 0x4d,
-	0xc2, 0x38, 0x25, 0x93, 0xbb, 0x64, 0xb2, 0xa2, 0x51, 0x97, 0xd6, 0xca, 0xea, 0x80, 0x8e, 0xb6,
-	0x38, 0x1f, 0x1a, 0x3b, 0xff, 0x2e, 0x78, 0x72, 0x25, 0x86, 0x3b, 0x47, 0xde, 0xa8, 0x7f, 0xec,
-	0x6b, 0xc9, 0x8b, 0x3a, 0x3f, 0x03, 0xdc, 0x66, 0x0f, 0xa0, 0x43, 0x01, 0x16, 0x3e, 0x83, 0x76,
-	0x2c, 0x79, 0x22, 0x86, 0x0e, 0x69, 0xec, 0x6a, 0x0d, 0xda, 0x0d, 0xd4, 0x16, 0xcb, 0xa1, 0x4b,
-	0xf4, 0x39, 0x5f, 0xfa, 0xfb, 0xe0, 0xa5, 0x65, 0xa2, 0x6f, 0x03, 0x97, 0xfe, 0x3d, 0xf0, 0x04,
-	0x5f, 0xd2, 0x15, 0xf4, 0x8f, 0x0f, 0x6c, 0xfd, 0x73, 0xbe, 0x2c, 0x79, 0x1a, 0xf1, 0x00, 0x05,
-	0xfc, 0xfb, 0xd0, 0x99, 0x72, 0x19, 0xc6, 0x0b, 0xba, 0x91, 0x1a, 0x1c, 0x89, 0x3e, 0xa3, 0x9d,
-	0x40, 0x4b, 0xb0, 0x5f, 0x40, 0xcf, 0x9c, 0x20, 0xfc, 0x1f, 0x43, 0x4b, 0xf0, 0xa5, 0x41, 0xf8,
-	0xd1, 0x86, 0x85, 0x80, 0x36, 0xd9, 0x6f, 0x34, 0xc6, 0x57, 0xf1, 0x14, 0x31, 0xe6, 0xf1, 0x94,
-	0x30, 0xf6, 0x02, 0x5c, 0xa2, 0x97, 0x74, 0x5d, 0x1a, 0xe5, 0x86, 0x97, 0xb4, 0xc5, 0x1e, 0xc3,
-	0xae, 0x05, 0x45, 0xf8, 0xa3, 0x66, 0x64, 0xb6, 0xc1, 0xd5, 0xf1, 0x19, 0xc3, 0x8e, 0xaa, 0x6e,
-	0xc4, 0xda, 0x50, 0x1a, 0x68, 0x25, 0xb5, 0x6d, 0xe4, 0x4f, 0x01, 0xb4, 0xfc, 0x76, 0xb4, 0x23,
-	0xd8, 0x99, 0xab, 0x7d, 0x8d, 0x77, 0xaf, 0x71, 0x8c, 0x08, 0xcc, 0x36, 0x9b, 0xc3, 0x80, 0xf0,
-	0x7c, 0x73, 0xc3, 0x8b, 0x9b, 0x98, 0xff, 0xc9, 0xff, 0x14, 0x5a, 0xb8, 0x47, 0xa7, 0xbd, 0x65,
-	0x9e, 0xb6, 0xec, 0xda, 0x76, 0x9b, 0xb5, 0x7d, 0x08, 0x5d, 0x55, 0x25, 0x5c, 0x0c, 0xbd, 0x23,
-	0x0f, 0xf3, 0xd4, 0xd0, 0xec, 0x6f, 0x0e, 0xf4, 0x2d, 0xd7, 0xeb, 0x88, 0x3a, 0xb7, 0x46, 0xd4,
-	0x1f, 0x43, 0xb7, 0xe0, 0x11, 0x8f, 0x73, 0x89, 0x8e, 0xd8, 0x41, 0x0c, 0x14, 0xfb, 0x59, 0x28,
-	0xc3, 0xa0, 0x92, 0xf1, 0x3f, 0x01, 0xf7, 0xc5, 0x1b, 0xb2, 0x5c, 0x5f, 0xf3, 0x0b, 0xbe, 0x7e,
-	0x13, 0x2e, 0x4a, 0x1e, 0xb8, 0x2f, 0xde, 0xf8, 0xf7, 0x60, 0x2f, 0x2f, 0xf8, 0xcd, 0xb9, 0x0c,
-	0x65, 0x29, 0xac, 0x0a, 0xde, 0xe0, 0xb2, 0x47, 0xd0, 0x0d, 0xcc, 0xa1, 0xf7, 0x2d, 0x10, 0xea,
-	0x52, 0xf6, 0x9a, 0x20, 0x6a, 0x00, 0x6c, 0x04, 0xbe, 0x66, 0x4e, 0xe6, 0x3c, 0xba, 0xbe, 0x58,
-	0x7d, 0x15, 0x0b, 0x6a, 0x79, 0xbc, 0x28, 0x94, 0x76, 0x2f, 0xa0, 0x35, 0x5b, 0x43, 0x7f, 0x82,
-	0x0f, 0x81, 0x32, 0xea, 0xdf, 0x85, 0x41, 0x54, 0x16, 0xd4, 0x7c, 0x54, 0x21, 0xab, 0xfa, 0x68,
-	0x32, 0xfd, 0x23, 0xe8, 0x27, 0x3c, 0xc9, 0xb3, 0x6c, 0x71, 0x1e, 0x7f, 0xcb, 0x75, 0xf4, 0x6d,
-	0x96, 0xcf, 0x60, 0x37, 0x11, 0xb3, 0xdf, 0x95, 0xbc, 0xe4, 0x24, 0xe2, 0x91, 0x48, 0x83, 0xc7,
-	0x42, 0xe8, 0x05, 0x7c, 0xa9, 0xcb, 0xf7, 0x00, 0xda, 0x42, 0x86, 0x85, 0x31, 0xa8, 0x08, 0x4c,
-	0x29, 0x9e, 0x4e, 0xb5, 0x01, 0x5c, 0xe2, 0xd5, 0xc6, 0xe2, 0x59, 0x5d, 0x7e, 0xdd, 0xa0, 0xa2,
-	0x4d, 0x02, 0xb6, 0xc8, 0x3d, 0x5c, 0xb2, 0x4f, 0xa1, 0xff, 0xb5, 0x85, 0xca, 0x87, 0x96, 0x40,
-	0x34, 0xca, 0x06, 0xad, 0xd9, 0x7d, 0xd8, 0x0f, 0x78, 0xbe, 0x58, 0x13, 0x0e, 0xed, 0x5f, 0xdd,
-	0x3d, 0x1d, 0xbb, 0x7b, 0xb2, 0x7f, 0x3a, 0xba, 0x9c, 0x9f, 0x66, 0xd3, 0xb5, 0xe9, 0x50, 0xce,
-	0x3b, 0x3b, 0xd4, 0x07, 0xe7, 0x8e, 0xdd, 0x63, 0xbd, 0x77, 0xf6, 0xd8, 0xd6, 0x5b, 0x3d, 0xd6,
-	0xbc, 0x69, 0x6d, 0xeb, 0x4d, 0xab, 0x7d, 0xe9, 0x34, 0x7c, 0xf9, 0xa3, 0xee, 0x12, 0x1a, 0x45,
-	0x03, 0xa7, 0xf3, 0x1e, 0x38, 0x8d, 0x2d, 0x77, 0xab, 0x2d, 0xaf, 0x61, 0xeb, 0x01, 0xc0, 0x99,
-	0x98, 0x84, 0xe5, 0x6c, 0x2e, 0x5f, 0xe7, 0xe8, 0xc5, 0x99, 0x88, 0x88, 0x2a, 0x73, 0x8a, 0x70,
-	0x37, 0xb0, 0x38, 0xec, 0x31, 0xec, 0x9d, 0x89, 0x97, 0x32, 0x9f, 0x50, 0x63, 0x5c, 0xa7, 0x11,
-	0x96, 0x4b, 0x2c, 0x52, 0x99, 0x47, 0x94, 0x2b, 0xeb, 0x34, 0xd2, 0x5a, 0x1b, 0x5c, 0xf6, 0x57,
-	0x07, 0x06, 0x94, 0xcd, 0xcf, 0x57, 0x3c, 0x2a, 0x65, 0x56, 0x20, 0xa2, 0x69, 0x11, 0xdf, 0xf0,
-	0x42, 0xb7, 0x25, 0x4d, 0x61, 0x94, 0xaf, 0xca, 0x34, 0x7a, 0x19, 0x26, 0x2a, 0x7d, 0x7b, 0x41,
-	0x45, 0x37, 0x5f, 0x56, 0x6f, 0xf3, 0x65, 0x3d, 0x80, 0x76, 0x1e, 0x16, 0x61, 0xa2, 0x2b, 0x56,
-	0x11, 0xc8, 0xe5, 0x2b, 0x59, 0x84, 0x3a, 0xf4, 0x8a, 0x60, 0x5f, 0xe8, 0xae, 0x66, 0xde, 0x0f,
-	0x0c, 0x1a, 0x9d, 0xea, 0xa8, 0xa0, 0xd1, 0x81, 0x3e, 0xb4, 0x2e, 0xd6, 0xb9, 0xa9, 0x22, 0x5a,
-	0xb3, 0x5f, 0xc1, 0x5e, 0x43, 0x11, 0xab, 0xbf, 0xd1, 0x8f, 0xb7, 0x3f, 0x4f, 0xba, 0x2d, 0xff,
-	0xd9, 0x81, 0x83, 0x57, 0x61, 0x11, 0x52, 0x28, 0xec, 0x5e, 0xf7, 0x39, 0xf4, 0xa9, 0xa1, 0xe9,
-	0xe7, 0xcb, 0xb9, 0xf5, 0xf9, 0xb2, 0xc5, 0x30, 0x56, 0x42, 0x5b, 0xd0, 0x20, 0x2b, 0x1a, 0xe3,
-	0x1b, 0x0b, 0xbc, 0x23, 0x5d, 0x8c, 0x9a, 0x62, 0x4f, 0x60, 0x80, 0x08, 0x2e, 0x56, 0xe6, 0x11,
-	0xfa, 0x69, 0x13, 0xff, 0xf7, 0xb4, 0x51, 0x5b, 0xc8, 0xc0, 0xff, 0x87, 0x03, 0xbb, 0x36, 0x1f,
-	0x23, 0x84, 0xd2, 0xa6, 0x6c, 0x71, 0xed, 0x7f, 0x86, 0xa9, 0x86, 0x8f, 0x81, 0x7e, 0x59, 0x36,
-	0x5e, 0x08, 0xbd, 0xe9, 0xff, 0x1c, 0x7a, 0xd2, 0x60, 0xd8, 0x68, 0xc8, 0x95, 0xd9, 0x5a, 0x02,
-	0xaf, 0x3e, 0x9a, 0xc7, 0x8b, 0xa9, 0x3d, 0x54, 0x55, 0x0c, 0xbc, 0xe4, 0x38, 0x9d, 0xf2, 0x15,
-	0x5d, 0xf2, 0x20, 0x50, 0x04, 0x86, 0x20, 0x2f, 0xb2, 0xec, 0x4a, 0x0c, 0x3b, 0xf4, 0xd4, 0x68,
-	0x8a, 0xfd, 0xc5, 0x81, 0x6e, 0xe5, 0x42, 0xa5, 0xea, 0xd8, 0xaa, 0x0c, 0x5c, 0xb9, 0xd2, 0x0e,
-	0x6c, 0x6b, 0x20, 0xae, 0x5c, 0xf9, 0x0f, 0x60, 0x47, 0xd7, 0xdc, 0xc6, 0xb8, 0x61, 0x97, 0xa5,
-	0x11, 0xb1, 0xc0, 0xb4, 0x1a, 0x60, 0xae, 0xb0, 0xcb, 0x2d, 0x55, 0x54, 0x9f, 0xae, 0x2f, 0x62,
-	0xb9, 0xe0, 0xef, 0xdd, 0x72, 0x0f, 0xa0, 0x2d, 0x51, 0x81, 0xec, 0xf7, 0x02, 0x45, 0x90, 0x47,
-	0xe2, 0x9c, 0x2f, 0x29, 0x4c, 0xdd, 0x40, 0x11, 0xec, 0x06, 0xe0, 0xb7, 0xf1, 0x82, 0xeb, 0x6f,
-	0x84, 0x23, 0xe8, 0xd3, 0xa1, 0x8d, 0xb7, 0xc4, 0x66, 0x59, 0xf5, 0xe9, 0x36, 0xea, 0x73, 0xbb,
-	0x4d, 0x7c, 0xf1, 0xb9, 0x90, 0x2f, 0xb9, 0xd4, 0x56, 0x0d, 0x89, 0x0f, 0xe5, 0xf3, 0x74, 0xaa,
-	0x66, 0xed, 0x5b, 0xba, 0xf7, 0xb6, 0x8e, 0xc5, 0x16, 0xd0, 0x53, 0x58, 0xff, 0xbb, 0x91, 0xb0,
-	0xce, 0x46, 0xef, 0x1d, 0xd9, 0xc8, 0x8e, 0xcd, 0xbc, 0x44, 0xe3, 0xe0, 0xdd, 0xc6, 0x38, 0xb8,
-	0xdf, 0x50, 0xa9, 0xe7, 0xc1, 0xef, 0x1c, 0x54, 0x42, 0x07, 0xf0, 0xf6, 0x6e, 0x75, 0xae, 0x0a,
-	0x98, 0x6b, 0x07, 0xcc, 0xb8, 0xec, 0x59, 0x4d, 0xfa, 0xdd, 0x39, 0xfe, 0x31, 0x00, 0xdd, 0xcf,
-	0x59, 0x95, 0xe8, 0xed, 0xc0, 0xe2, 0x60, 0x2b, 0xae, 0x84, 0x95, 0x4c, 0x87, 0x32, 0x7a, 0x83,
-	0x6b, 0x0f, 0x67, 0x3b, 0x74, 0x88, 0x21, 0xd9, 0x23, 0xe8, 0xd7, 0xfe, 0x08, 0xff, 0x27, 0xcd,
-	0xc6, 0x70, 0xa7, 0x0a, 0x83, 0x11, 0x31, 0x6d, 0xe1, 0x5b, 0x80, 0x09, 0xda, 0xa0, 0xae, 0x56,
-	0xfb, 0xeb, 0xd8, 0xfe, 0x36, 0xd1, 0xbb, 0x6f, 0xa1, 0x6f, 0xf8, 0xee, 0x6d, 0xfa, 0x6e, 0x61,
-	0x6e, 0x35, 0x31, 0x4b, 0x2a, 0x1f, 0x85, 0xc9, 0x94, 0xcf, 0x87, 0xdd, 0xc4, 0x01, 0xb4, 0x23,
-	0x3a, 0xd9, 0xa3, 0x93, 0x15, 0x81, 0x78, 0xa6, 0x71, 0xc1, 0xa9, 0xda, 0xb5, 0xcd, 0x9a, 0xc1,
-	0x02, 0x9c, 0xe2, 0xf2, 0xc5, 0xba, 0x69, 0x77, 0xbb, 0xe7, 0xf7, 0x4c, 0x18, 0xdd, 0x46, 0x36,
-	0x51, 0xae, 0x9e, 0xa5, 0x57, 0x99, 0x89, 0xe2, 0x17, 0x7a, 0x82, 0x41, 0xde, 0x07, 0x55, 0xca,
-	0xaf, 0xe1, 0x8e, 0xd5, 0x41, 0x4e, 0x2b, 0x5f, 0xeb, 0xcb, 0xf3, 0xb4, 0x8d, 0xed, 0x11, 0x60,
-	0xa7, 0xd0, 0x9d, 0x24, 0xb9, 0x2a, 0xd1, 0xf7, 0x19, 0xba, 0x87, 0xb0, 0x13, 0x25, 0xb9, 0xf5,
-	0x55, 0x6c, 0x48, 0xf6, 0x39, 0x40, 0x35, 0x85, 0x89, 0xda, 0x73, 0xe7, 0x6d, 0xcf, 0x51, 0xc2,
-	0x78, 0xfe, 0x08, 0x76, 0x27, 0xf3, 0x32, 0xc5, 0x81, 0x27, 0x2b, 0xa6, 0x4a, 0x2f, 0xbd, 0xca,
-	0x36, 0xf5, 0x48, 0x46, 0x47, 0x0c, 0xb7, 0xd9, 0x85, 0xd6, 0x43, 0xde, 0xd7, 0x62, 0xa6, 0x72,
-	0xa8, 0x4c, 0xaf, 0xad, 0x87, 0xbc, 0x66, 0xd4, 0x4d, 0xd5, 0xdd, 0xd2, 0x54, 0xbd, 0xaa, 0xa9,
-	0xb2, 0x04, 0x7a, 0xd5, 0xa9, 0xf8, 0xc2, 0xd2, 0x09, 0x2f, 0xab, 0xee, 0x53, 0xd1, 0x4d, 0x73,
-	0xee, 0xad, 0xe6, 0xbc, 0x2d, 0xe6, 0x5a, 0xb5, 0xb9, 0x19, 0x7c, 0x14, 0xf0, 0x65, 0xc3, 0xff,
-	0xff, 0xcd, 0xc4, 0xfd, 0x9d, 0x0b, 0xfb, 0xaf, 0x4a, 0x31, 0x3f, 0x2f, 0x2f, 0x45, 0x54, 0xc4,
-	0x97, 0x3c, 0xe0, 0x4b, 0xcc, 0xa7, 0x14, 0x27, 0x2d, 0x95, 0xb1, 0xb4, 0x46, 0xd5, 0xd7, 0xc1,
-	0x57, 0x3a, 0x45, 0x70, 0x89, 0xd9, 0xc8, 0xd3, 0x28, 0x9b, 0x9a, 0xa6, 0xaf, 0x29, 0xfc, 0x96,
-	0x58, 0x84, 0x42, 0x9a, 0x8e, 0xab, 0xdd, 0x6a, 0xf0, 0xb0, 0xf0, 0x91, 0x3e, 0xb5, 0xff, 0x79,
-	0x58, 0x1c, 0xfc, 0xae, 0x41, 0x4a, 0x0d, 0xf9, 0x18, 0xc9, 0x0e, 0x99, 0x68, 0x32, 0xab, 0x41,
-	0x43, 0x75, 0x2c, 0x35, 0x68, 0x7c, 0x09, 0xdd, 0x28, 0x4b, 0x65, 0x11, 0x46, 0x72, 0xd8, 0xa5,
-	0x4c, 0xf9, 0xcc, 0xcc, 0x2e, 0x1b, 0x6e, 0x8e, 0x27, 0x5a, 0xee, 0x79, 0x2a, 0x8b, 0x75, 0x50,
-	0xa9, 0x1d, 0xfe, 0x12, 0x06, 0x8d, 0x2d, 0xf4, 0xfd, 0x9a, 0xaf, 0xcd, 0x97, 0xf2, 0x35, 0x5f,
-	0xe3, 0x65, 0xdc, 0xe0, 0xd7, 0x21, 0xc5, 0xa3, 0x1b, 0x28, 0xe2, 0x89, 0xfb, 0xd8, 0x61, 0xaf,
-	0x61, 0x0f, 0x0d, 0xfd, 0x3e, 0x96, 0x73, 0xfd, 0x8d, 0xf6, 0x33, 0x68, 0xe5, 0xa5, 0xce, 0xbd,
-	0xfe, 0xf1, 0x0f, 0x6e, 0x41, 0x13, 0x90, 0x10, 0x06, 0x55, 0x90, 0x9a, 0xee, 0x86, 0x9a, 0x62,
-	0x5f, 0xaa, 0x63, 0x2b, 0x0d, 0xe1, 0x3f, 0x84, 0x0e, 0x6a, 0x70, 0x53, 0x10, 0xb7, 0x1e, 0xac,
-	0xc5, 0xd8, 0x13, 0xdd, 0x9e, 0xaa, 0x4d, 0x94, 0xc4, 0x18, 0xc6, 0xe2, 0x9b, 0x6b, 0x3d, 0xa1,
-	0xd3, 0x1a, 0xfd, 0x4d, 0xc4, 0xcc, 0xdc, 0x75, 0x22, 0x66, 0x4f, 0x3f, 0xf9, 0xc3, 0x8f, 0x66,
-	0xb1, 0x9c, 0x97, 0x97, 0xe3, 0x28, 0x4b, 0x1e, 0x9e, 0x9c, 0x44, 0xe9, 0x43, 0xfa, 0x1f, 0x79,
-	0x72, 0xf2, 0x90, 0xac, 0x5e, 0x76, 0xe8, 0x87, 0xe3, 0xc9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xd5, 0x6a, 0x77, 0xb7, 0xac, 0x14, 0x00, 0x00,
+	// 2048 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x73, 0xdc, 0x48,
+	0x11, 0x47, 0xd2, 0xfe, 0xed, 0xdd, 0x75, 0x1c, 0xe1, 0x82, 0x2d, 0x17, 0xdc, 0xf9, 0x86, 0x24,
+	0x2c, 0x21, 0x38, 0x57, 0xce, 0x55, 0x2e, 0x15, 0xa0, 0x20, 0x76, 0x72, 0x65, 0x57, 0xee, 0x72,
+	0x41, 0x76, 0x42, 0x15, 0x6f, 0xb2, 0x76, 0xec, 0x15, 0xde, 0x95, 0xb4, 0x9a, 0x91, 0xd9, 0xbd,
+	0x27, 0x9e, 0xf9, 0x28, 0xd4, 0x7d, 0x09, 0xaa, 0x78, 0xe1, 0xe5, 0xbe, 0x02, 0xdf, 0x83, 0x27,
+	0xaa, 0x7b, 0x66, 0xa4, 0xd1, 0x7a, 0x37, 0x24, 0x45, 0xf1, 0xc0, 0xdb, 0x74, 0x4f, 0xf7, 0xf4,
+	0xaf, 0x7b, 0xba, 0x7b, 0x5a, 0x82, 0xed, 0xf3, 0x69, 0x1a, 0x5d, 0x45, 0x93, 0x30, 0x4e, 0xf6,
+	0xb3, 0x3c, 0x95, 0xa9, 0xdf, 0x94, 0xcb, 0x8c, 0x8b, 0xdd, 0xdb, 0x32, 0x0f, 0x13, 0x11, 0x46,
+	0x32, 0x4e, 0xf5, 0xce, 0x6e, 0x3f, 0x4a, 0x67, 0x33, 0x43, 0xb1, 0x6f, 0x5d, 0x68, 0x1d, 0xf3,
+	0x70, 0xcc, 0x73, 0x7f, 0x08, 0xed, 0x6b, 0x9e, 0x8b, 0x38, 0x4d, 0x86, 0xce, 0x9e, 0x33, 0xf2,
+	0x02, 0x43, 0xfa, 0x1f, 0x01, 0x64, 0x61, 0xce, 0x13, 0x79, 0x1c, 0x8a, 0xc9, 0xd0, 0xdd, 0x73,
+	0x46, 0xfd, 0xc0, 0xe2, 0xf8, 0x3f, 0x80, 0x96, 0x5c, 0xd0, 0x9e, 0x47, 0x7b, 0x9a, 0xf2, 0x7f,
+	0x04, 0x5d, 0x21, 0x43, 0xc9, 0x69, 0xab, 0x41, 0x5b, 0x15, 0x03, 0xb5, 0x26, 0x3c, 0xbe, 0x9c,
+	0xc8, 0x61, 0x93, 0xcc, 0x69, 0x0a, 0xb5, 0xc8, 0x9d, 0xb3, 0x78, 0xc6, 0x87, 0x2d, 0xda, 0xaa,
+	0x18, 0x88, 0x52, 0x2e, 0x8e, 0xd2, 0x22, 0x91, 0xc3, 0xae, 0x42, 0xa9, 0x49, 0xdf, 0x87, 0xc6,
+	0x04, 0x0d, 0x01, 0x19, 0xa2, 0x35, 0x22, 0x1f, 0xc7, 0x17, 0x17, 0x71, 0x54, 0x4c, 0xe5, 0x72,
+	0xd8, 0xdb, 0x73, 0x46, 0x83, 0xc0, 0xe2, 0xf8, 0xfb, 0xd0, 0x15, 0xf1, 0x65, 0x12, 0xca, 0x22,
+	0xe7, 0xc3, 0xce, 0x9e, 0x33, 0xea, 0x1d, 0x6c, 0xef, 0x53, 0xe8, 0xf6, 0x4f, 0x0d, 0x3f, 0xa8,
+	0x44, 0xd8, 0x3f, 0x5d, 0x68, 0x1e, 0x22, 0x96, 0xff, 0x93, 0x68, 0xfd, 0x27, 0xff, 0x77, 0xa1,
+	0x33, 0x0b, 0xe3, 0x84, 0x4c, 0xf6, 0xc9, 0x64, 0x49, 0xa3, 0x2e, 0xad, 0x95, 0xd5, 0x01, 0x1d,
+	0x6d, 0x71, 0x3e, 0x34, 0x76, 0xfe, 0x1d, 0xf0, 0xe4, 0x42, 0x0c, 0xdb, 0x7b, 0xde, 0xa8, 0x77,
+	0xe0, 0x6b, 0xc9, 0xb3, 0x2a, 0x3f, 0x03, 0xdc, 0x66, 0x0f, 0xa0, 0x45, 0x01, 0x16, 0x3e, 0x83,
+	0x66, 0x2c, 0xf9, 0x4c, 0x0c, 0x1d, 0xd2, 0xe8, 0x6b, 0x0d, 0xda, 0x0d, 0xd4, 0x16, 0xcb, 0xa0,
+	0x43, 0xf4, 0x29, 0x9f, 0xfb, 0xdb, 0xe0, 0x25, 0xc5, 0x4c, 0xdf, 0x06, 0x2e, 0xfd, 0x7b, 0xe0,
+	0x09, 0x3e, 0xa7, 0x2b, 0xe8, 0x1d, 0xec, 0xd8, 0xfa, 0xa7, 0x7c, 0x5e, 0xf0, 0x24, 0xe2, 0x01,
+	0x0a, 0xf8, 0xf7, 0xa1, 0x35, 0xe6, 0x32, 0x8c, 0xa7, 0x74, 0x23, 0x15, 0x38, 0x12, 0x7d, 0x4e,
+	0x3b, 0x81, 0x96, 0x60, 0x9f, 0x42, 0xd7, 0x9c, 0x20, 0xfc, 0x9f, 0x40, 0x43, 0xf0, 0xb9, 0x41,
+	0x78, 0x6b, 0xc5, 0x42, 0x40, 0x9b, 0xec, 0xb7, 0x1a, 0xe3, 0xeb, 0x78, 0x8c, 0x18, 0xb3, 0x78,
+	0x4c, 0x18, 0xbb, 0x01, 0x2e, 0xd1, 0x4b, 0xba, 0x2e, 0x8d, 0x72, 0xc5, 0x4b, 0xda, 0x62, 0x4f,
+	0xa0, 0x6f, 0x41, 0x11, 0xfe, 0xa8, 0x1e, 0x99, 0x75, 0x70, 0x75, 0x7c, 0xf6, 0xa1, 0xad, 0xaa,
+	0x1b, 0xb1, 0xd6, 0x94, 0x06, 0x5a, 0x49, 0x6d, 0x1b, 0xf9, 0x63, 0x00, 0x2d, 0xbf, 0x1e, 0xed,
+	0x08, 0xda, 0x13, 0xb5, 0xaf, 0xf1, 0x6e, 0xd5, 0x8e, 0x11, 0x81, 0xd9, 0x66, 0x13, 0x18, 0x10,
+	0x9e, 0xaf, 0xaf, 0x79, 0x7e, 0x1d, 0xf3, 0x3f, 0xf9, 0x9f, 0x40, 0x03, 0xf7, 0xe8, 0xb4, 0x1b,
+	0xe6, 0x69, 0xcb, 0xae, 0x6d, 0xb7, 0x5e, 0xdb, 0xbb, 0xd0, 0x51, 0x55, 0xc2, 0xc5, 0xd0, 0xdb,
+	0xf3, 0x30, 0x4f, 0x0d, 0xcd, 0xfe, 0xea, 0x40, 0xcf, 0x72, 0xbd, 0x8a, 0xa8, 0xb3, 0x31, 0xa2,
+	0xfe, 0x3e, 0x74, 0x72, 0x1e, 0xf1, 0x38, 0x93, 0xe8, 0x88, 0x1d, 0xc4, 0x40, 0xb1, 0x9f, 0x87,
+	0x32, 0x0c, 0x4a, 0x19, 0xff, 0x63, 0x70, 0x5f, 0xbe, 0x1d, 0x7a, 0xb5, 0x6b, 0x7e, 0xc9, 0x97,
+	0x6f, 0xc3, 0x69, 0xc1, 0x03, 0xf7, 0xe5, 0x5b, 0xff, 0x1e, 0x6c, 0x65, 0x39, 0xbf, 0x3e, 0x95,
+	0xa1, 0x2c, 0x84, 0x55, 0xc1, 0x2b, 0x5c, 0xf6, 0x18, 0x3a, 0x81, 0x39, 0xf4, 0xbe, 0x05, 0x42,
+	0x5d, 0xca, 0x56, 0x1d, 0x44, 0x05, 0x80, 0x8d, 0xc0, 0xd7, 0xcc, 0xa3, 0x09, 0x8f, 0xae, 0xce,
+	0x16, 0x5f, 0xc6, 0x82, 0x5a, 0x1e, 0xcf, 0x73, 0xa5, 0xdd, 0x0d, 0x68, 0xcd, 0x96, 0xd0, 0x3b,
+	0xc2, 0x87, 0x40, 0x19, 0xf5, 0xef, 0xc0, 0x20, 0x2a, 0x72, 0x6a, 0x3e, 0xaa, 0x90, 0x55, 0x7d,
+	0xd4, 0x99, 0xfe, 0x1e, 0xf4, 0x66, 0x7c, 0x96, 0xa5, 0xe9, 0xf4, 0x34, 0xfe, 0x86, 0xeb, 0xe8,
+	0xdb, 0x2c, 0x9f, 0x41, 0x7f, 0x26, 0x2e, 0x7f, 0x57, 0xf0, 0x82, 0x93, 0x88, 0x47, 0x22, 0x35,
+	0x1e, 0x0b, 0xa1, 0x1b, 0xf0, 0xb9, 0x2e, 0xdf, 0x1d, 0x68, 0x0a, 0x19, 0xe6, 0xc6, 0xa0, 0x22,
+	0x30, 0xa5, 0x78, 0x32, 0xd6, 0x06, 0x70, 0x89, 0x57, 0x1b, 0x8b, 0xe7, 0x55, 0xf9, 0x75, 0x82,
+	0x92, 0x36, 0x09, 0xd8, 0x20, 0xf7, 0x70, 0xc9, 0x3e, 0x81, 0xde, 0x57, 0x16, 0x2a, 0x1f, 0x1a,
+	0x02, 0xd1, 0x28, 0x1b, 0xb4, 0x66, 0xf7, 0x61, 0x3b, 0xe0, 0xd9, 0x74, 0x49, 0x38, 0xb4, 0x7f,
+	0x55, 0xf7, 0x74, 0xec, 0xee, 0xc9, 0xfe, 0xe1, 0xe8, 0x72, 0x3e, 0x4c, 0xc7, 0x4b, 0xd3, 0xa1,
+	0x9c, 0x77, 0x76, 0xa8, 0x0f, 0xce, 0x1d, 0xbb, 0xc7, 0x7a, 0xef, 0xec, 0xb1, 0x8d, 0x1b, 0x3d,
+	0xd6, 0xbc, 0x69, 0x4d, 0xeb, 0x4d, 0xab, 0x7c, 0x69, 0xd5, 0x7c, 0xf9, 0xa3, 0xee, 0x12, 0x1a,
+	0x45, 0x0d, 0xa7, 0xf3, 0x1e, 0x38, 0x8d, 0x2d, 0x77, 0xad, 0x2d, 0xaf, 0x66, 0xeb, 0x01, 0xc0,
+	0x89, 0x38, 0x0a, 0x8b, 0xcb, 0x89, 0x7c, 0x93, 0xa1, 0x17, 0x27, 0x22, 0x22, 0xaa, 0xc8, 0x28,
+	0xc2, 0x9d, 0xc0, 0xe2, 0xb0, 0x27, 0xb0, 0x75, 0x22, 0x5e, 0xc9, 0xec, 0x88, 0x1a, 0xe3, 0x32,
+	0x89, 0xb0, 0x5c, 0x62, 0x91, 0xc8, 0x2c, 0x42, 0x8e, 0x58, 0x26, 0x91, 0xd6, 0x5a, 0xe1, 0xb2,
+	0xbf, 0x39, 0x30, 0xa0, 0x6c, 0x7e, 0xb1, 0xe0, 0x51, 0x21, 0xd3, 0x1c, 0x11, 0x8d, 0xf3, 0xf8,
+	0x9a, 0xe7, 0xba, 0x2d, 0x69, 0x0a, 0xa3, 0x7c, 0x51, 0x24, 0xd1, 0xab, 0x70, 0xa6, 0xd2, 0xb7,
+	0x1b, 0x94, 0x74, 0xfd, 0x65, 0xf5, 0x56, 0x5f, 0xd6, 0x1d, 0x68, 0x66, 0x61, 0x1e, 0xce, 0x74,
+	0xc5, 0x2a, 0x02, 0xb9, 0x7c, 0x21, 0xf3, 0x50, 0x87, 0x5e, 0x11, 0x9b, 0x62, 0x8f, 0xd6, 0xcf,
+	0x97, 0xfa, 0x16, 0xdb, 0x2a, 0x89, 0x0d, 0xcd, 0x3e, 0x87, 0x41, 0xed, 0xcd, 0xc1, 0x40, 0x13,
+	0x12, 0x47, 0x05, 0x9a, 0x40, 0xf8, 0xd0, 0x38, 0x5b, 0x66, 0xa6, 0xf2, 0x68, 0xcd, 0x7e, 0x05,
+	0x5b, 0x35, 0x45, 0xec, 0x18, 0xb5, 0x1e, 0xbe, 0xfe, 0x49, 0xd3, 0xad, 0xfc, 0xcf, 0x0e, 0xec,
+	0xbc, 0x0e, 0xf3, 0x90, 0xc2, 0x67, 0xf7, 0xc7, 0xcf, 0xa0, 0x47, 0x4d, 0x50, 0x3f, 0x79, 0xce,
+	0xc6, 0x27, 0xcf, 0x16, 0x43, 0x0f, 0x85, 0xb6, 0xa0, 0x41, 0x96, 0x34, 0x46, 0x25, 0x16, 0x78,
+	0xaf, 0xba, 0x80, 0x35, 0xc5, 0x9e, 0xc2, 0x00, 0x11, 0x9c, 0x2d, 0xcc, 0xc3, 0xf5, 0xb3, 0x3a,
+	0xfe, 0xef, 0x6b, 0xa3, 0xb6, 0x90, 0x81, 0xff, 0x77, 0x07, 0xfa, 0x36, 0x1f, 0x23, 0x84, 0xd2,
+	0xa6, 0xd4, 0x71, 0xed, 0xdf, 0xc5, 0xeb, 0xc0, 0x07, 0x64, 0xe8, 0xae, 0x7b, 0x55, 0xf4, 0xa6,
+	0xff, 0x0b, 0xe8, 0x4a, 0x83, 0x61, 0xa5, 0x89, 0x97, 0x66, 0x2b, 0x09, 0x4c, 0x97, 0x68, 0x12,
+	0x4f, 0xc7, 0xf6, 0x20, 0x56, 0x32, 0x30, 0x31, 0xe2, 0x64, 0xcc, 0x17, 0x94, 0x18, 0x83, 0x40,
+	0x11, 0x18, 0x82, 0x2c, 0x4f, 0xd3, 0x0b, 0x31, 0x6c, 0xd1, 0xf3, 0xa4, 0x29, 0xf6, 0x17, 0x07,
+	0x3a, 0xa5, 0x0b, 0xa5, 0xaa, 0x63, 0xab, 0x32, 0x70, 0xe5, 0x62, 0xe8, 0xd6, 0xae, 0xc1, 0x6e,
+	0x3a, 0xae, 0x5c, 0xf8, 0x0f, 0xa0, 0xad, 0xeb, 0x74, 0x65, 0x44, 0xb1, 0x4b, 0xd9, 0x88, 0x58,
+	0x60, 0x1a, 0x35, 0x30, 0x17, 0xd8, 0x19, 0xe7, 0x2a, 0xaa, 0x87, 0xcb, 0xb3, 0x58, 0x4e, 0xf9,
+	0x7b, 0xb7, 0xe9, 0x1d, 0x68, 0x4a, 0x54, 0x20, 0xfb, 0xdd, 0x40, 0x11, 0xe4, 0x91, 0x38, 0xe5,
+	0x73, 0x0a, 0x53, 0x27, 0x50, 0x04, 0xbb, 0x06, 0xf8, 0x22, 0x9e, 0x72, 0xfd, 0x5d, 0xb1, 0x07,
+	0x3d, 0x3a, 0xb4, 0xf6, 0xfe, 0xd8, 0x2c, 0xab, 0xa6, 0xdd, 0x5a, 0x4d, 0xaf, 0xb7, 0x89, 0x53,
+	0x02, 0x17, 0xf2, 0x15, 0x97, 0xda, 0xaa, 0x21, 0xf1, 0x71, 0x7d, 0x91, 0x8c, 0xd5, 0x7c, 0xbe,
+	0xa1, 0xe3, 0xaf, 0xeb, 0x72, 0x6c, 0x0a, 0x5d, 0x85, 0xf5, 0xbf, 0x1b, 0x23, 0xab, 0x6c, 0xf4,
+	0xde, 0x91, 0x8d, 0xec, 0xc0, 0xcc, 0x58, 0x34, 0x42, 0xde, 0xa9, 0x8d, 0x90, 0xdb, 0x35, 0x95,
+	0x6a, 0x86, 0xfc, 0xce, 0x41, 0x25, 0x74, 0x00, 0x6f, 0x6f, 0xa3, 0x73, 0x65, 0xc0, 0x5c, 0x3b,
+	0x60, 0xc6, 0x65, 0xcf, 0x6a, 0xec, 0xef, 0xce, 0xf1, 0x8f, 0x00, 0xe8, 0x7e, 0x4e, 0xca, 0x44,
+	0x6f, 0x06, 0x16, 0x07, 0xdb, 0x77, 0x29, 0xac, 0x64, 0x5a, 0x94, 0xd1, 0x2b, 0x5c, 0x7b, 0xa0,
+	0x6b, 0xd3, 0x21, 0x86, 0x64, 0x8f, 0xa1, 0x57, 0xf9, 0x23, 0xfc, 0x9f, 0xd6, 0x1b, 0xc3, 0xed,
+	0x32, 0x0c, 0x46, 0xc4, 0xb4, 0x85, 0x6f, 0x00, 0x8e, 0xd0, 0x06, 0x75, 0xb5, 0xca, 0x5f, 0xc7,
+	0xf6, 0xb7, 0x8e, 0xde, 0xbd, 0x81, 0xbe, 0xe6, 0xbb, 0xb7, 0xea, 0xbb, 0x85, 0xb9, 0x51, 0xc7,
+	0x2c, 0xa9, 0x7c, 0x14, 0x26, 0x53, 0x3e, 0x1f, 0x76, 0x13, 0x3b, 0xd0, 0x8c, 0xe8, 0x64, 0x8f,
+	0x4e, 0x56, 0x04, 0xe2, 0x19, 0xc7, 0x39, 0xa7, 0x6a, 0xd7, 0x36, 0x2b, 0x06, 0x0b, 0x70, 0xf2,
+	0xcb, 0xa6, 0xcb, 0xba, 0xdd, 0xf5, 0x9e, 0xdf, 0x33, 0x61, 0x74, 0x6b, 0xd9, 0x44, 0xb9, 0x7a,
+	0x92, 0x5c, 0xa4, 0x26, 0x8a, 0x9f, 0x43, 0xb7, 0xe4, 0x7d, 0x50, 0xa5, 0xfc, 0x06, 0x6e, 0x5b,
+	0x1d, 0xe4, 0xb8, 0xf4, 0xb5, 0xba, 0x3c, 0x4f, 0xdb, 0x58, 0x1f, 0x01, 0x76, 0x0c, 0x9d, 0xa3,
+	0x59, 0xa6, 0x4a, 0xf4, 0x7d, 0x06, 0xf5, 0x21, 0xb4, 0xa3, 0x59, 0x66, 0x7d, 0x49, 0x1b, 0x92,
+	0x7d, 0x06, 0x50, 0x4e, 0x6e, 0xc2, 0xbf, 0x67, 0x63, 0x58, 0xf1, 0x1c, 0x25, 0x8c, 0xe7, 0x8f,
+	0xa1, 0x7f, 0x34, 0x29, 0x12, 0x1c, 0x92, 0xd2, 0x7c, 0xac, 0xf4, 0x92, 0x8b, 0x74, 0x55, 0x8f,
+	0x64, 0x74, 0xc4, 0x70, 0x9b, 0x9d, 0x41, 0xbf, 0xe4, 0x7d, 0x25, 0x2e, 0x55, 0x0e, 0x15, 0xc9,
+	0x95, 0xf5, 0x90, 0x57, 0x8c, 0xaa, 0xa9, 0xba, 0x6b, 0x9a, 0xaa, 0x57, 0x36, 0x55, 0x36, 0x83,
+	0x6e, 0x79, 0x2a, 0xbe, 0xb0, 0x74, 0xc2, 0xab, 0xb2, 0xfb, 0x94, 0x74, 0xdd, 0x9c, 0xbb, 0xd1,
+	0x9c, 0xb7, 0xc6, 0x5c, 0xa3, 0x32, 0x77, 0x09, 0xb7, 0x02, 0x3e, 0xaf, 0xf9, 0xff, 0xbf, 0x99,
+	0xd2, 0xbf, 0x73, 0x61, 0xfb, 0x75, 0x21, 0x26, 0xa7, 0xc5, 0xb9, 0x88, 0xf2, 0xf8, 0x9c, 0x07,
+	0x7c, 0x8e, 0xf9, 0x94, 0xe0, 0x74, 0xa6, 0x32, 0x96, 0xd6, 0xa8, 0xfa, 0x26, 0xf8, 0x52, 0xa7,
+	0x08, 0x2e, 0x31, 0x1b, 0x79, 0x12, 0xa5, 0x63, 0xd3, 0xf4, 0x35, 0x85, 0xdf, 0x1f, 0xd3, 0x50,
+	0x48, 0xd3, 0x71, 0xb5, 0x5b, 0x35, 0x1e, 0x16, 0x3e, 0xd2, 0xc7, 0xf6, 0x7f, 0x12, 0x8b, 0x83,
+	0xdf, 0x42, 0x48, 0xa9, 0x0f, 0x03, 0x8c, 0x64, 0x8b, 0x4c, 0xd4, 0x99, 0xe5, 0xa0, 0xa1, 0x3a,
+	0x16, 0xad, 0xfd, 0x67, 0xd0, 0x89, 0xd2, 0x44, 0xe6, 0x61, 0x24, 0x87, 0x1d, 0xca, 0x94, 0xbb,
+	0x66, 0x76, 0x59, 0x71, 0x73, 0xff, 0x48, 0xcb, 0xbd, 0x48, 0x64, 0xbe, 0x0c, 0x4a, 0xb5, 0xdd,
+	0x5f, 0xc2, 0xa0, 0xb6, 0x85, 0xbe, 0x5f, 0xf1, 0xa5, 0xf9, 0xba, 0xbe, 0xe2, 0x4b, 0xbc, 0x8c,
+	0x6b, 0xfc, 0xa2, 0xa4, 0x78, 0x74, 0x02, 0x45, 0x3c, 0x75, 0x9f, 0x38, 0xec, 0x0d, 0x6c, 0xa1,
+	0xa1, 0xdf, 0xc7, 0x72, 0xa2, 0xbf, 0xeb, 0x7e, 0x0e, 0x8d, 0xac, 0xd0, 0xb9, 0xd7, 0x3b, 0xf8,
+	0xe1, 0x06, 0x34, 0x01, 0x09, 0x61, 0x50, 0x05, 0xa9, 0xe9, 0x6e, 0xa8, 0x29, 0x36, 0x81, 0xad,
+	0x9a, 0x86, 0xf0, 0x1f, 0x42, 0x0b, 0x35, 0xb8, 0x29, 0x88, 0x8d, 0x07, 0x6b, 0x31, 0xff, 0x2e,
+	0x25, 0x50, 0xf9, 0x29, 0x74, 0xcb, 0x96, 0x97, 0xa1, 0x0c, 0xd4, 0x2e, 0xfb, 0x97, 0x0b, 0x1d,
+	0xc3, 0x5b, 0x9b, 0x09, 0x1b, 0x20, 0xe2, 0xf4, 0x80, 0xd7, 0x43, 0xba, 0x7c, 0xae, 0x33, 0xdc,
+	0x66, 0x61, 0x6d, 0x64, 0xa9, 0x90, 0x55, 0xcb, 0xf6, 0x82, 0x8a, 0x81, 0x77, 0x8e, 0xc4, 0x17,
+	0x61, 0x3c, 0x55, 0x12, 0x2a, 0x2d, 0xea, 0x4c, 0x73, 0xc6, 0xe1, 0x52, 0x72, 0x61, 0xfe, 0xa2,
+	0x95, 0x0c, 0xff, 0x01, 0xdc, 0xc6, 0x6b, 0x8c, 0x93, 0x82, 0x57, 0xe7, 0xa8, 0xf4, 0xb8, 0xb9,
+	0xe1, 0x8f, 0xe0, 0x16, 0x65, 0x65, 0x11, 0x45, 0x5c, 0x08, 0xfa, 0x2f, 0xd7, 0xa1, 0x13, 0x57,
+	0xd9, 0x26, 0xa7, 0x51, 0x95, 0xc4, 0xba, 0x55, 0x4e, 0x1b, 0x1e, 0x22, 0x43, 0xfa, 0x45, 0x9e,
+	0xa7, 0x39, 0xfd, 0xda, 0xec, 0x06, 0x15, 0x03, 0xbd, 0x4b, 0xf8, 0x42, 0x06, 0x5c, 0xe6, 0x4b,
+	0x3a, 0xa2, 0xa7, 0xbc, 0xab, 0x31, 0xd9, 0xb7, 0x8e, 0x0a, 0x3e, 0x4e, 0x89, 0x9b, 0xca, 0xd0,
+	0x4c, 0x38, 0x9e, 0x9a, 0x65, 0x4c, 0x11, 0x78, 0x56, 0x11, 0x54, 0xa5, 0xd9, 0xa8, 0x95, 0xa6,
+	0x0f, 0x8d, 0x71, 0x28, 0xcd, 0x97, 0x12, 0xad, 0x11, 0xb6, 0x8c, 0x67, 0x5c, 0xc8, 0x70, 0x96,
+	0x99, 0x80, 0x96, 0x0c, 0xdc, 0xad, 0x7e, 0x1d, 0xb6, 0x95, 0x53, 0x25, 0x83, 0x3d, 0x83, 0x36,
+	0xa2, 0x7d, 0x16, 0x5d, 0x19, 0x60, 0x4e, 0x0d, 0x58, 0x2c, 0xbe, 0xbe, 0xd2, 0x25, 0x42, 0x6b,
+	0x94, 0x9a, 0x89, 0x4b, 0xdd, 0x30, 0x70, 0xc9, 0x9e, 0xea, 0x47, 0xb3, 0x4c, 0x59, 0x3c, 0xb0,
+	0xd4, 0x75, 0x6e, 0xea, 0xba, 0xa5, 0xee, 0xc1, 0xaf, 0xa1, 0x8f, 0xd2, 0x34, 0x59, 0x5f, 0xd3,
+	0xd7, 0x43, 0x83, 0xb4, 0xed, 0xd4, 0xc6, 0x48, 0xee, 0x6e, 0x59, 0x8c, 0x67, 0xd1, 0x15, 0xfb,
+	0xde, 0xc8, 0xf9, 0xd4, 0x39, 0xfc, 0xf8, 0x0f, 0x3f, 0xbe, 0x8c, 0xe5, 0xa4, 0x38, 0xdf, 0x8f,
+	0xd2, 0xd9, 0xc3, 0x47, 0x8f, 0xa2, 0xe4, 0x21, 0xfd, 0x98, 0x7f, 0xf4, 0xe8, 0x21, 0x89, 0x9f,
+	0xb7, 0xe8, 0xcf, 0xfb, 0xa3, 0x7f, 0x0f, 0x00, 0x84, 0x8e, 0xf1, 0xfd, 0xb5, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PushReceiverClient is the client API for PushReceiver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PushReceiverClient interface {
+	Push(ctx context.Context, opts ...grpc.CallOption) (PushReceiver_PushClient, error)
+}
+
+type pushReceiverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPushReceiverClient(cc grpc.ClientConnInterface) PushReceiverClient {
+	return &pushReceiverClient{cc}
+}
+
+func (c *pushReceiverClient) Push(ctx context.Context, opts ...grpc.CallOption) (PushReceiver_PushClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PushReceiver_serviceDesc.Streams[0], "/types.PushReceiver/Push", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pushReceiverPushClient{stream}
+	return x, nil
+}
+
+type PushReceiver_PushClient interface {
+	Send(*PushData) error
+	Recv() (*PushAck, error)
+	grpc.ClientStream
+}
+
+type pushReceiverPushClient struct {
+	grpc.ClientStream
+}
+
+func (x *pushReceiverPushClient) Send(m *PushData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pushReceiverPushClient) Recv() (*PushAck, error) {
+	m := new(PushAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PushReceiverServer is the server API for PushReceiver service.
+type PushReceiverServer interface {
+	Push(PushReceiver_PushServer) error
+}
+
+// UnimplementedPushReceiverServer can be embedded to have forward compatible implementations.
+type UnimplementedPushReceiverServer struct {
+}
+
+func (*UnimplementedPushReceiverServer) Push(srv PushReceiver_PushServer) error {
+	return status.Errorf(codes.Unimplemented, "method Push not implemented")
+}
+
+func RegisterPushReceiverServer(s *grpc.Server, srv PushReceiverServer) {
+	s.RegisterService(&_PushReceiver_serviceDesc, srv)
+}
+
+func _PushReceiver_Push_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PushReceiverServer).Push(&pushReceiverPushServer{stream})
+}
+
+type PushReceiver_PushServer interface {
+	Send(*PushAck) error
+	Recv() (*PushData, error)
+	grpc.ServerStream
+}
+
+type pushReceiverPushServer struct {
+	grpc.ServerStream
+}
+
+func (x *pushReceiverPushServer) Send(m *PushAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pushReceiverPushServer) Recv() (*PushData, error) {
+	m := new(PushData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _PushReceiver_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "types.PushReceiver",
+	HandlerType: (*PushReceiverServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Push",
+			Handler:       _PushReceiver_Push_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "blockchain.proto",
 }
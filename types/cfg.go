@@ -59,7 +59,7 @@ type Log struct {
 
 // Mempool 配置
 type Mempool struct {
-	// mempool队列名称，可配，timeline，score，price
+	// mempool队列名称，可配，timeline，score，price，feerate
 	Name string `json:"name,omitempty"`
 	// mempool缓存容量大小，默认10240
 	PoolCacheSize int64 `json:"poolCacheSize,omitempty"`
@@ -76,6 +76,17 @@ type Mempool struct {
 	MaxTxFee int64 `json:"maxTxFee,omitempty"`
 	// 目前execCheck效率较低，支持关闭交易execCheck，提升性能
 	DisableExecCheck bool `json:"disableExecCheck,omitempty"`
+	// 替换同一账户冲突交易需要提高的手续费百分比, 0表示不允许替换
+	ReplaceFeePercent int64 `json:"replaceFeePercent,omitempty"`
+	// 是否开启交易日志, 节点重启后恢复mempool中的交易
+	EnableJournal bool `json:"enableJournal,omitempty"`
+	// 交易日志数据库类型和路径, 默认leveldb, datadir/mempool
+	JournalDriver string `json:"journalDriver,omitempty"`
+	JournalPath   string `json:"journalPath,omitempty"`
+	// 交易日志压缩间隔, 单位秒, 默认600
+	JournalCompactInterval int64 `json:"journalCompactInterval,omitempty"`
+	// 每秒最多验证的BLS签名数量, 超过限制的交易直接拒绝, 默认500, 小于0表示不限制
+	MaxBLSVerifyRate int64 `json:"maxBLSVerifyRate,omitempty"`
 }
 
 // Consensus 配置
@@ -109,6 +120,8 @@ type Wallet struct {
 	// 钱包发送交易签名方式
 	SignType string `json:"signType,omitempty"`
 	CoinType string `json:"coinType,omitempty"`
+	// 通过seed恢复账户时, 连续多少个地址没有交易后停止
+	HDGapLimit int32 `json:"hdGapLimit,omitempty"`
 }
 
 // Store 配置
@@ -172,6 +185,10 @@ type BlockChain struct {
 	EnableIfDelLocalChunk bool `json:"enableIfDelLocalChunk,omitempty"`
 	// 使能注册推送区块、区块头或交易回执
 	EnablePushSubscribe bool `json:"EnablePushSubscribe,omitempty"`
+	// 推送数据HMAC-SHA256签名的key, 为空时不签名
+	PushSignKey string `json:"pushSignKey,omitempty"`
+	// file方式推送时日志文件所在的目录, 为空时不支持file方式推送
+	PushFileDir string `json:"pushFileDir,omitempty"`
 	// 当前活跃区块的缓存数量
 	MaxActiveBlockNum int `json:"maxActiveBlockNum,omitempty"`
 	// 当前活跃区块的缓存大小M为单位
@@ -226,6 +243,42 @@ type RPC struct {
 	CertFile string `json:"certFile,omitempty"`
 	// 私钥文件
 	KeyFile string `json:"keyFile,omitempty"`
+	// 是否开启websocket订阅, 与jrpc共用端口, 路径为/ws, 需要开启isRecordBlockSequence
+	EnableWebSocket bool `json:"enableWebSocket,omitempty"`
+	// 允许建立websocket连接的浏览器Origin, “*”允许所有, 默认为空只允许不带Origin的非浏览器客户端
+	WsOrigins []string `json:"wsOrigins,omitempty"`
+	// 是否开启api key认证, 开启后非本地请求需要携带api key或者签名的token
+	EnableAPIKey bool `json:"enableAPIKey,omitempty"`
+	// 本地请求是否也需要api key认证
+	APIKeyForLoopback bool `json:"apiKeyForLoopback,omitempty"`
+	// 签名token使用的hmac密钥, 为空时不支持token
+	TokenSecret string `json:"tokenSecret,omitempty"`
+	// api key列表
+	APIKeys []*RPCAPIKey `json:"apiKeys,omitempty"`
+	// 是否开启以太坊风格的jrpc接口(eth_blockNumber等), 需要使用JSON-RPC 2.0调用
+	EnableEthRPC bool `json:"enableEthRPC,omitempty"`
+	// eth_chainId和net_version返回的链ID
+	EthChainID int64 `json:"ethChainID,omitempty"`
+	// 是否开启模拟执行交易接口SimulateTransaction
+	EnableSimulateTx bool `json:"enableSimulateTx,omitempty"`
+	// 模拟执行交易每秒允许的请求数量, 0表示不限制
+	SimulateTxRate float64 `json:"simulateTxRate,omitempty"`
+	// 模拟执行交易令牌桶容量, 默认和simulateTxRate相同
+	SimulateTxBurst int `json:"simulateTxBurst,omitempty"`
+}
+
+// RPCAPIKey rpc访问的api key配置
+type RPCAPIKey struct {
+	// 名称, token通过名称对应到api key
+	Name string `json:"name,omitempty"`
+	// api key, 为空时只能使用token访问
+	Key string `json:"key,omitempty"`
+	// 允许调用的方法, 为空或者“*”时允许调用所有方法, jrpc和grpc共用
+	Methods []string `json:"methods,omitempty"`
+	// 每秒允许的请求数量, 0表示不限制
+	Rate float64 `json:"rate,omitempty"`
+	// 令牌桶容量, 默认和rate相同
+	Burst int `json:"burst,omitempty"`
 }
 
 // Exec 配置
@@ -238,6 +291,8 @@ type Exec struct {
 	Alias            []string `json:"alias,omitempty"`
 	// 是否保存token交易信息
 	SaveTokenTxList bool `json:"saveTokenTxList,omitempty"`
+	// 是否开启交易并行执行, 需要到达ForkParallelExec高度
+	EnableParallelExec bool `json:"enableParallelExec,omitempty"`
 }
 
 // Pprof 配置
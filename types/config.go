@@ -64,6 +64,20 @@ type ChainParam struct {
 	PowLimitBits uint32
 }
 
+// GasParam 交易执行资源计量参数, 为0表示不计量或者不限制
+type GasParam struct {
+	TxGasLimit    int64
+	BlockGasLimit int64
+	//每单位gas需要的最少手续费
+	GasPrice      int64
+	TxByteGas     int64
+	StateReadGas  int64
+	StateWriteGas int64
+	//只计量ExecLocalSameTime执行器写入的localdb
+	SameTimeLocalWriteGas int64
+	WriteByteGas          int64
+}
+
 //RegFork Reg 注册每个模块的自动初始化函数
 func RegFork(name string, create Create) {
 	if create == nil {
@@ -289,6 +303,21 @@ func (c *Chain33Config) GetP(height int64) *ChainParam {
 	return chain
 }
 
+// GetGasParam 获取交易执行资源计量参数
+func (c *Chain33Config) GetGasParam(height int64) *GasParam {
+	conf := Conf(c, "mver.exec.gas")
+	gas := &GasParam{}
+	gas.TxGasLimit = conf.MGInt("txGasLimit", height)
+	gas.BlockGasLimit = conf.MGInt("blockGasLimit", height)
+	gas.GasPrice = conf.MGInt("gasPrice", height)
+	gas.TxByteGas = conf.MGInt("txByteGas", height)
+	gas.StateReadGas = conf.MGInt("stateReadGas", height)
+	gas.StateWriteGas = conf.MGInt("stateWriteGas", height)
+	gas.SameTimeLocalWriteGas = conf.MGInt("sameTimeLocalWriteGas", height)
+	gas.WriteByteGas = conf.MGInt("writeByteGas", height)
+	return gas
+}
+
 // GetMinerExecs 获取挖矿的合约名单
 func (c *Chain33Config) GetMinerExecs() []string {
 	return c.minerExecs
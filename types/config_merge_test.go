@@ -218,6 +218,10 @@ ForkLocalDBAccess=1
 ForkBase58AddressCheck=1800000
 ForkTicketFundAddrV1=-1
 ForkRootHash=1
+ForkParallelExec=-1
+ForkExecGas=-1
+ForkTxMultiSign=-1
+ForkTxBLSSign=-1
 [fork.sub.coins]
 Enable=0
 
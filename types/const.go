@@ -70,6 +70,8 @@ const (
 //ty = 3 -> sm2
 //ty = 4 -> onetimeed25519
 //ty = 5 -> RingBaseonED25519
+//ty = 6 -> bls
+//ty = 7 -> multisign, m-of-n多重签名, 没有对应的crypto驱动
 //ty = 1+offset(1<<8) ->auth_ecdsa
 //ty = 2+offset(1<<8) -> auth_sm2
 const (
@@ -77,6 +79,8 @@ const (
 	SECP256K1 = 1
 	ED25519   = 2
 	SM2       = 3
+	BLS       = 6
+	MultiSign = 7
 )
 
 //log type
@@ -98,6 +102,7 @@ const (
 	TyLogRollback        = 13
 	TyLogMint            = 14
 	TyLogBurn            = 15
+	TyLogGas             = 16
 )
 
 //SystemLog 系统log日志
@@ -117,6 +122,7 @@ var SystemLog = map[int64]*LogInfo{
 	TyLogRollback:        {reflect.TypeOf(LocalDBSet{}), "LogRollback"},
 	TyLogMint:            {reflect.TypeOf(ReceiptAccountMint{}), "LogMint"},
 	TyLogBurn:            {reflect.TypeOf(ReceiptAccountBurn{}), "LogBurn"},
+	TyLogGas:             {reflect.TypeOf(ReceiptExecGas{}), "LogGas"},
 }
 
 //exec type
@@ -203,6 +203,117 @@ func (m *MAVLProof) GetRootHash() []byte {
 	return nil
 }
 
+// 单个key的状态证明, key不存在时exists为false且没有proof
+type StateProof struct {
+	Key                  []byte     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte     `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Exists               bool       `protobuf:"varint,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	Proof                *MAVLProof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{3}
+}
+
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProof.Unmarshal(m, b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return xxx_messageInfo_StateProof.Size(m)
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+func (m *StateProof) GetProof() *MAVLProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type ReplyStateProof struct {
+	StateHash            []byte        `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Proofs               []*StateProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyStateProof) Reset()         { *m = ReplyStateProof{} }
+func (m *ReplyStateProof) String() string { return proto.CompactTextString(m) }
+func (*ReplyStateProof) ProtoMessage()    {}
+func (*ReplyStateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{4}
+}
+
+func (m *ReplyStateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyStateProof.Unmarshal(m, b)
+}
+func (m *ReplyStateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyStateProof.Marshal(b, m, deterministic)
+}
+func (m *ReplyStateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyStateProof.Merge(m, src)
+}
+func (m *ReplyStateProof) XXX_Size() int {
+	return xxx_messageInfo_ReplyStateProof.Size(m)
+}
+func (m *ReplyStateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyStateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyStateProof proto.InternalMessageInfo
+
+func (m *ReplyStateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReplyStateProof) GetProofs() []*StateProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type StoreNode struct {
 	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -219,7 +330,7 @@ func (m *StoreNode) Reset()         { *m = StoreNode{} }
 func (m *StoreNode) String() string { return proto.CompactTextString(m) }
 func (*StoreNode) ProtoMessage()    {}
 func (*StoreNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{3}
+	return fileDescriptor_8817812184a13374, []int{5}
 }
 
 func (m *StoreNode) XXX_Unmarshal(b []byte) error {
@@ -294,7 +405,7 @@ func (m *LocalDBSet) Reset()         { *m = LocalDBSet{} }
 func (m *LocalDBSet) String() string { return proto.CompactTextString(m) }
 func (*LocalDBSet) ProtoMessage()    {}
 func (*LocalDBSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{4}
+	return fileDescriptor_8817812184a13374, []int{6}
 }
 
 func (m *LocalDBSet) XXX_Unmarshal(b []byte) error {
@@ -344,7 +455,7 @@ func (m *LocalDBList) Reset()         { *m = LocalDBList{} }
 func (m *LocalDBList) String() string { return proto.CompactTextString(m) }
 func (*LocalDBList) ProtoMessage()    {}
 func (*LocalDBList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{5}
+	return fileDescriptor_8817812184a13374, []int{7}
 }
 
 func (m *LocalDBList) XXX_Unmarshal(b []byte) error {
@@ -412,7 +523,7 @@ func (m *LocalDBGet) Reset()         { *m = LocalDBGet{} }
 func (m *LocalDBGet) String() string { return proto.CompactTextString(m) }
 func (*LocalDBGet) ProtoMessage()    {}
 func (*LocalDBGet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{6}
+	return fileDescriptor_8817812184a13374, []int{8}
 }
 
 func (m *LocalDBGet) XXX_Unmarshal(b []byte) error {
@@ -458,7 +569,7 @@ func (m *LocalReplyValue) Reset()         { *m = LocalReplyValue{} }
 func (m *LocalReplyValue) String() string { return proto.CompactTextString(m) }
 func (*LocalReplyValue) ProtoMessage()    {}
 func (*LocalReplyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{7}
+	return fileDescriptor_8817812184a13374, []int{9}
 }
 
 func (m *LocalReplyValue) XXX_Unmarshal(b []byte) error {
@@ -499,7 +610,7 @@ func (m *StoreSet) Reset()         { *m = StoreSet{} }
 func (m *StoreSet) String() string { return proto.CompactTextString(m) }
 func (*StoreSet) ProtoMessage()    {}
 func (*StoreSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{8}
+	return fileDescriptor_8817812184a13374, []int{10}
 }
 
 func (m *StoreSet) XXX_Unmarshal(b []byte) error {
@@ -553,7 +664,7 @@ func (m *StoreDel) Reset()         { *m = StoreDel{} }
 func (m *StoreDel) String() string { return proto.CompactTextString(m) }
 func (*StoreDel) ProtoMessage()    {}
 func (*StoreDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{9}
+	return fileDescriptor_8817812184a13374, []int{11}
 }
 
 func (m *StoreDel) XXX_Unmarshal(b []byte) error {
@@ -601,7 +712,7 @@ func (m *StoreSetWithSync) Reset()         { *m = StoreSetWithSync{} }
 func (m *StoreSetWithSync) String() string { return proto.CompactTextString(m) }
 func (*StoreSetWithSync) ProtoMessage()    {}
 func (*StoreSetWithSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{10}
+	return fileDescriptor_8817812184a13374, []int{12}
 }
 
 func (m *StoreSetWithSync) XXX_Unmarshal(b []byte) error {
@@ -655,7 +766,7 @@ func (m *StoreGet) Reset()         { *m = StoreGet{} }
 func (m *StoreGet) String() string { return proto.CompactTextString(m) }
 func (*StoreGet) ProtoMessage()    {}
 func (*StoreGet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{11}
+	return fileDescriptor_8817812184a13374, []int{13}
 }
 
 func (m *StoreGet) XXX_Unmarshal(b []byte) error {
@@ -701,7 +812,7 @@ func (m *StoreReplyValue) Reset()         { *m = StoreReplyValue{} }
 func (m *StoreReplyValue) String() string { return proto.CompactTextString(m) }
 func (*StoreReplyValue) ProtoMessage()    {}
 func (*StoreReplyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{12}
+	return fileDescriptor_8817812184a13374, []int{14}
 }
 
 func (m *StoreReplyValue) XXX_Unmarshal(b []byte) error {
@@ -730,12 +841,14 @@ func (m *StoreReplyValue) GetValues() [][]byte {
 }
 
 type StoreList struct {
-	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	Start                []byte   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End                  []byte   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
-	Suffix               []byte   `protobuf:"bytes,4,opt,name=suffix,proto3" json:"suffix,omitempty"`
-	Count                int64    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	Mode                 int64    `protobuf:"varint,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	StateHash []byte `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Start     []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End       []byte `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Suffix    []byte `protobuf:"bytes,4,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	Count     int64  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Mode      int64  `protobuf:"varint,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	//stateHash 为空并且 height 大于0时使用该高度的状态
+	Height               int64    `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -745,7 +858,7 @@ func (m *StoreList) Reset()         { *m = StoreList{} }
 func (m *StoreList) String() string { return proto.CompactTextString(m) }
 func (*StoreList) ProtoMessage()    {}
 func (*StoreList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{13}
+	return fileDescriptor_8817812184a13374, []int{15}
 }
 
 func (m *StoreList) XXX_Unmarshal(b []byte) error {
@@ -808,6 +921,13 @@ func (m *StoreList) GetMode() int64 {
 	return 0
 }
 
+func (m *StoreList) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type StoreListReply struct {
 	Start                []byte   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
 	End                  []byte   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
@@ -827,7 +947,7 @@ func (m *StoreListReply) Reset()         { *m = StoreListReply{} }
 func (m *StoreListReply) String() string { return proto.CompactTextString(m) }
 func (*StoreListReply) ProtoMessage()    {}
 func (*StoreListReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{14}
+	return fileDescriptor_8817812184a13374, []int{16}
 }
 
 func (m *StoreListReply) XXX_Unmarshal(b []byte) error {
@@ -923,7 +1043,7 @@ func (m *PruneData) Reset()         { *m = PruneData{} }
 func (m *PruneData) String() string { return proto.CompactTextString(m) }
 func (*PruneData) ProtoMessage()    {}
 func (*PruneData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{15}
+	return fileDescriptor_8817812184a13374, []int{17}
 }
 
 func (m *PruneData) XXX_Unmarshal(b []byte) error {
@@ -963,7 +1083,7 @@ func (m *StoreValuePool) Reset()         { *m = StoreValuePool{} }
 func (m *StoreValuePool) String() string { return proto.CompactTextString(m) }
 func (*StoreValuePool) ProtoMessage()    {}
 func (*StoreValuePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{16}
+	return fileDescriptor_8817812184a13374, []int{18}
 }
 
 func (m *StoreValuePool) XXX_Unmarshal(b []byte) error {
@@ -995,6 +1115,8 @@ func init() {
 	proto.RegisterType((*LeafNode)(nil), "types.LeafNode")
 	proto.RegisterType((*InnerNode)(nil), "types.InnerNode")
 	proto.RegisterType((*MAVLProof)(nil), "types.MAVLProof")
+	proto.RegisterType((*StateProof)(nil), "types.StateProof")
+	proto.RegisterType((*ReplyStateProof)(nil), "types.ReplyStateProof")
 	proto.RegisterType((*StoreNode)(nil), "types.StoreNode")
 	proto.RegisterType((*LocalDBSet)(nil), "types.LocalDBSet")
 	proto.RegisterType((*LocalDBList)(nil), "types.LocalDBList")
@@ -1016,46 +1138,50 @@ func init() {
 }
 
 var fileDescriptor_8817812184a13374 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0x45, 0x92, 0x9d, 0x48, 0x93, 0xd0, 0x18, 0x11, 0x8a, 0x08, 0x29, 0x49, 0x75, 0x72, 0x29,
-	0x38, 0xa5, 0xee, 0xb1, 0x87, 0x26, 0x04, 0xd2, 0x62, 0xb7, 0x04, 0x05, 0x5c, 0xe8, 0xa1, 0xb0,
-	0x91, 0xc6, 0x91, 0x88, 0xbd, 0xeb, 0x4a, 0xab, 0x12, 0xf5, 0xd2, 0x8f, 0xe8, 0xa9, 0xbf, 0xd5,
-	0x2f, 0x2a, 0x3b, 0xbb, 0xb2, 0x64, 0x50, 0x93, 0xe6, 0x36, 0x6f, 0xbd, 0x7a, 0xef, 0xcd, 0x9b,
-	0x59, 0x0c, 0x6e, 0x72, 0x3d, 0x5a, 0xe5, 0x42, 0x0a, 0xbf, 0x2f, 0xab, 0x15, 0x16, 0x07, 0xbb,
-	0xb1, 0x58, 0x2e, 0x05, 0xd7, 0x87, 0xe1, 0x57, 0x70, 0xa7, 0xc8, 0xe6, 0x9f, 0x44, 0x82, 0xfe,
-	0x00, 0x9c, 0x5b, 0xac, 0x02, 0xeb, 0xd8, 0x1a, 0xee, 0x46, 0xaa, 0xf4, 0xf7, 0xa1, 0xff, 0x9d,
-	0x2d, 0x4a, 0x0c, 0x6c, 0x3a, 0xd3, 0xc0, 0x7f, 0x0a, 0x5b, 0x29, 0x66, 0x37, 0xa9, 0x0c, 0x9c,
-	0x63, 0x6b, 0xd8, 0x8f, 0x0c, 0xf2, 0x7d, 0xe8, 0x15, 0xd9, 0x0f, 0x0c, 0x7a, 0x74, 0x4a, 0x75,
-	0xf8, 0x0d, 0xbc, 0x0f, 0x9c, 0x63, 0x4e, 0x02, 0x07, 0xe0, 0x2e, 0x70, 0x2e, 0xdf, 0xb3, 0x22,
-	0x35, 0x2a, 0x6b, 0xec, 0x1f, 0x82, 0x97, 0x2b, 0x16, 0xfa, 0x51, 0xcb, 0x35, 0x07, 0x8f, 0x92,
-	0x2c, 0xc1, 0xfb, 0x78, 0x3a, 0x9b, 0x5e, 0xe6, 0x42, 0xcc, 0xb5, 0x24, 0x9b, 0x6f, 0x4a, 0x6a,
-	0xec, 0xbf, 0x02, 0xc8, 0x6a, 0x6f, 0x45, 0x60, 0x1f, 0x3b, 0xc3, 0x9d, 0xd7, 0x83, 0x11, 0xa5,
-	0x34, 0x5a, 0x9b, 0x8e, 0x5a, 0x77, 0x14, 0x5b, 0x2e, 0x84, 0xf6, 0xe8, 0x68, 0xb6, 0x1a, 0x87,
-	0xbf, 0x2d, 0xf0, 0xae, 0xa4, 0xc8, 0xf1, 0x51, 0x59, 0xb6, 0x23, 0x71, 0xee, 0x8b, 0xa4, 0xf7,
-	0xef, 0x48, 0xfa, 0x9d, 0x91, 0x6c, 0xb5, 0x22, 0x39, 0x05, 0x98, 0x8a, 0x98, 0x2d, 0xce, 0xcf,
-	0xae, 0x50, 0xfa, 0x47, 0x60, 0x4f, 0x66, 0xa6, 0xdf, 0x3d, 0xd3, 0xef, 0x04, 0xab, 0x99, 0x32,
-	0x14, 0xd9, 0x93, 0x99, 0xa2, 0x90, 0x77, 0x59, 0x42, 0xc4, 0x4e, 0x44, 0x75, 0xf8, 0x13, 0x76,
-	0x0c, 0xc5, 0x34, 0x2b, 0xa4, 0x52, 0x5f, 0xe5, 0x38, 0xcf, 0xee, 0x4c, 0x8b, 0x06, 0xd5, 0x7d,
-	0xdb, 0x4d, 0xdf, 0x87, 0xe0, 0x25, 0x59, 0x8e, 0xb1, 0xcc, 0x04, 0x37, 0xd3, 0x6b, 0x0e, 0x54,
-	0x2a, 0xb1, 0x28, 0xb9, 0x34, 0x13, 0xd4, 0xa0, 0xd3, 0xc0, 0x9b, 0x75, 0x0f, 0x17, 0x48, 0x37,
-	0x6e, 0xb1, 0xd2, 0x53, 0xdb, 0x8d, 0xa8, 0xee, 0xfc, 0xea, 0x05, 0xec, 0xd1, 0x57, 0x11, 0xae,
-	0x16, 0xba, 0x43, 0x65, 0x9d, 0xb2, 0xaf, 0x3f, 0x36, 0x28, 0x64, 0xe0, 0xd2, 0xfc, 0x54, 0x44,
-	0x87, 0xe0, 0x15, 0x92, 0x49, 0x6c, 0xed, 0x4d, 0x73, 0xf0, 0x70, 0x80, 0x9b, 0xeb, 0xea, 0xd4,
-	0xb3, 0x09, 0xdf, 0x19, 0x89, 0x73, 0x5c, 0x3c, 0x20, 0xd1, 0x30, 0xd8, 0x1b, 0x0c, 0x4b, 0x18,
-	0xd4, 0x26, 0x3f, 0x67, 0x32, 0xbd, 0xaa, 0x78, 0xec, 0xbf, 0x04, 0xb7, 0x50, 0x67, 0x05, 0x4a,
-	0x22, 0x6a, 0x4c, 0xd5, 0x57, 0xa3, 0xf5, 0x05, 0x5a, 0x8f, 0x8a, 0xc7, 0x44, 0xeb, 0x46, 0x54,
-	0xfb, 0x01, 0x6c, 0x97, 0xab, 0x9b, 0x9c, 0x25, 0x48, 0x7e, 0xdd, 0xa8, 0x86, 0xe1, 0x5b, 0x63,
-	0xf8, 0xe2, 0xc1, 0x4c, 0x3a, 0x06, 0xa2, 0xc2, 0xa7, 0xaf, 0xff, 0x23, 0xfc, 0x5f, 0xf5, 0xeb,
-	0xa1, 0xed, 0xba, 0x5f, 0x6a, 0x1f, 0xfa, 0x85, 0x64, 0xb9, 0xac, 0x5f, 0x12, 0x01, 0xb5, 0x79,
-	0xc8, 0x13, 0xf3, 0x88, 0x54, 0xa9, 0xb4, 0x8a, 0x72, 0xae, 0x76, 0x54, 0x3f, 0x1e, 0x83, 0x9a,
-	0x9d, 0xd3, 0x8b, 0xd2, 0xec, 0xdc, 0x52, 0x24, 0xfa, 0xdd, 0x38, 0x11, 0xd5, 0xe1, 0x1f, 0x0b,
-	0x9e, 0xac, 0x5d, 0x51, 0x17, 0x8d, 0xb8, 0xd5, 0x21, 0x6e, 0x77, 0x89, 0x3b, 0xdd, 0xe2, 0xbd,
-	0xb6, 0xf8, 0x00, 0x1c, 0x5e, 0x2e, 0x8d, 0x21, 0x55, 0x76, 0xd9, 0x51, 0x73, 0xe2, 0x78, 0x27,
-	0x27, 0x58, 0x05, 0xdb, 0x44, 0x5a, 0xc3, 0x75, 0xfa, 0x6e, 0xeb, 0x39, 0x34, 0x51, 0x7b, 0x1b,
-	0x51, 0x3f, 0x07, 0xef, 0x32, 0x2f, 0x39, 0x9e, 0x33, 0xc9, 0x94, 0x9d, 0x94, 0x15, 0x69, 0x11,
-	0x58, 0x74, 0x47, 0x83, 0x70, 0x68, 0xda, 0xa6, 0x99, 0x5d, 0x0a, 0xb1, 0x68, 0x91, 0x59, 0x6d,
-	0xb2, 0xb3, 0xa3, 0x2f, 0xcf, 0x6e, 0x32, 0x99, 0x96, 0xd7, 0xa3, 0x58, 0x2c, 0x4f, 0xc6, 0xe3,
-	0x98, 0x9f, 0xc4, 0x29, 0xcb, 0xf8, 0x78, 0x7c, 0x42, 0x2b, 0x78, 0xbd, 0x45, 0xff, 0x33, 0xe3,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xe6, 0x2e, 0x76, 0x88, 0x06, 0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0x46, 0x92, 0xed, 0x48, 0x13, 0xd3, 0xb8, 0x22, 0x14, 0x11, 0x52, 0x92, 0xea, 0x50, 0x1c,
+	0x0a, 0x4e, 0xa9, 0x7b, 0xec, 0xa1, 0x09, 0x81, 0xb4, 0xd8, 0x2d, 0x61, 0x0d, 0x2e, 0xe4, 0x50,
+	0x50, 0xa4, 0x75, 0x24, 0x62, 0x6b, 0x5d, 0xed, 0xaa, 0x58, 0xbd, 0xf4, 0x39, 0xfa, 0x0c, 0x7d,
+	0x9b, 0x3e, 0x51, 0xd9, 0xd9, 0xd5, 0x8f, 0x41, 0x4d, 0x9a, 0xdb, 0x7c, 0xab, 0xdd, 0x6f, 0xbe,
+	0x99, 0x6f, 0x06, 0x81, 0x1d, 0xdd, 0x8c, 0xd6, 0x19, 0x13, 0xcc, 0xed, 0x8a, 0x62, 0x4d, 0xf9,
+	0x41, 0x3f, 0x64, 0xab, 0x15, 0x4b, 0xd5, 0xa1, 0xff, 0x15, 0xec, 0x29, 0x0d, 0x16, 0x9f, 0x59,
+	0x44, 0xdd, 0x01, 0x58, 0x77, 0xb4, 0xf0, 0x8c, 0x63, 0x63, 0xd8, 0x27, 0x32, 0x74, 0xf7, 0xa1,
+	0xfb, 0x3d, 0x58, 0xe6, 0xd4, 0x33, 0xf1, 0x4c, 0x01, 0xf7, 0x19, 0xf4, 0x62, 0x9a, 0xdc, 0xc6,
+	0xc2, 0xb3, 0x8e, 0x8d, 0x61, 0x97, 0x68, 0xe4, 0xba, 0xd0, 0xe1, 0xc9, 0x0f, 0xea, 0x75, 0xf0,
+	0x14, 0x63, 0xff, 0x1b, 0x38, 0x1f, 0xd3, 0x94, 0x66, 0x98, 0xe0, 0x00, 0xec, 0x25, 0x5d, 0x88,
+	0x0f, 0x01, 0x8f, 0x75, 0x96, 0x0a, 0xbb, 0x87, 0xe0, 0x64, 0x92, 0x05, 0x3f, 0xaa, 0x74, 0xf5,
+	0xc1, 0xa3, 0x52, 0xe6, 0xe0, 0x7c, 0x3a, 0x9b, 0x4f, 0xaf, 0x32, 0xc6, 0x16, 0x2a, 0x65, 0xb0,
+	0xd8, 0x4e, 0xa9, 0xb0, 0xfb, 0x1a, 0x20, 0x29, 0xb5, 0x71, 0xcf, 0x3c, 0xb6, 0x86, 0xbb, 0x6f,
+	0x06, 0x23, 0xec, 0xd2, 0xa8, 0x12, 0x4d, 0x1a, 0x77, 0x24, 0x5b, 0xc6, 0x98, 0xd2, 0x68, 0x29,
+	0xb6, 0x12, 0xfb, 0x02, 0x60, 0x26, 0x02, 0x41, 0x55, 0xde, 0x47, 0xf4, 0x92, 0x6e, 0x12, 0x2e,
+	0x38, 0xf2, 0xd9, 0x44, 0x23, 0xf7, 0x25, 0x74, 0xd7, 0x92, 0x08, 0x2b, 0xab, 0x65, 0x55, 0x85,
+	0x11, 0xf5, 0xd9, 0xbf, 0x86, 0x3d, 0x42, 0xd7, 0xcb, 0xa2, 0x91, 0xfa, 0x10, 0x1c, 0x2e, 0x51,
+	0xa3, 0xe6, 0xfa, 0xc0, 0x3d, 0x81, 0x1e, 0xbe, 0x2c, 0x0b, 0x7e, 0xaa, 0x99, 0x6b, 0x02, 0xa2,
+	0x2f, 0xf8, 0xbf, 0x0c, 0x70, 0x66, 0x82, 0x65, 0xf4, 0x51, 0xd3, 0xd1, 0x34, 0xd9, 0xba, 0xcf,
+	0xe4, 0xce, 0xbf, 0x4d, 0xee, 0xb6, 0x9a, 0xdc, 0x6b, 0x98, 0x7c, 0x06, 0x30, 0x65, 0x61, 0xb0,
+	0xbc, 0x38, 0x9f, 0x51, 0xe1, 0x1e, 0x81, 0x39, 0x99, 0xeb, 0x82, 0xf6, 0x74, 0x41, 0x13, 0x5a,
+	0xcc, 0xa5, 0x20, 0x62, 0x4e, 0xe6, 0x92, 0x42, 0x6c, 0x92, 0x08, 0x89, 0x2d, 0x82, 0xb1, 0xff,
+	0x13, 0x76, 0x35, 0xc5, 0x34, 0xe1, 0x42, 0x66, 0x5f, 0x67, 0x74, 0x91, 0x6c, 0x74, 0x89, 0x1a,
+	0x95, 0x75, 0x9b, 0x75, 0xdd, 0x87, 0xe0, 0x44, 0x49, 0x46, 0x43, 0x91, 0xb0, 0x54, 0xcf, 0x63,
+	0x7d, 0x20, 0xbb, 0x12, 0xb2, 0x3c, 0x15, 0x7a, 0x26, 0x15, 0x68, 0x15, 0xf0, 0xb6, 0xaa, 0xe1,
+	0x92, 0xe2, 0x8d, 0x3b, 0x5a, 0x28, 0x5b, 0xfa, 0x04, 0xe3, 0xd6, 0x57, 0x27, 0xb0, 0x87, 0xaf,
+	0xd0, 0xf6, 0x79, 0x39, 0x44, 0xd8, 0xfb, 0xf2, 0xb1, 0x46, 0x7e, 0x00, 0x36, 0xfa, 0x27, 0x5b,
+	0x74, 0xff, 0x54, 0x3c, 0xd8, 0xc0, 0xed, 0x05, 0xb4, 0x4a, 0x6f, 0xfc, 0xf7, 0x3a, 0xc5, 0x05,
+	0x5d, 0x3e, 0x90, 0xa2, 0x66, 0x30, 0xb7, 0x18, 0x56, 0x30, 0x28, 0x45, 0x7e, 0x49, 0x44, 0x3c,
+	0x2b, 0xd2, 0xd0, 0x7d, 0x05, 0x36, 0x97, 0x67, 0x9c, 0x0a, 0x24, 0xaa, 0x45, 0x95, 0x57, 0x49,
+	0x75, 0x01, 0xc7, 0xa3, 0x48, 0x43, 0xa4, 0xb5, 0x09, 0xc6, 0xae, 0x07, 0x3b, 0xf9, 0xfa, 0x36,
+	0x0b, 0x22, 0xaa, 0xf7, 0xaa, 0x84, 0xfe, 0x3b, 0x2d, 0xf8, 0xf2, 0xc1, 0x9e, 0xb4, 0x18, 0x22,
+	0x9b, 0x8f, 0xaf, 0xff, 0xa3, 0xf9, 0xbf, 0xcb, 0xed, 0xc1, 0xe9, 0xba, 0x3f, 0xd5, 0x3e, 0x74,
+	0xb9, 0x08, 0x32, 0x51, 0x6e, 0x12, 0x02, 0x39, 0x79, 0x34, 0x8d, 0xf4, 0x12, 0xc9, 0x50, 0xe6,
+	0xe2, 0xf9, 0x42, 0xce, 0xa8, 0x5a, 0x1e, 0x8d, 0xea, 0x99, 0x53, 0x83, 0x52, 0xcf, 0xdc, 0x8a,
+	0x45, 0x6a, 0x6f, 0x2c, 0x82, 0x71, 0xc3, 0x85, 0x9d, 0x2d, 0x17, 0xfe, 0x18, 0xf0, 0xa4, 0x52,
+	0x8b, 0xd5, 0xd5, 0xa2, 0x8c, 0x16, 0x51, 0x66, 0x9b, 0x28, 0xab, 0x5d, 0x54, 0xa7, 0x29, 0x6a,
+	0x00, 0x56, 0x9a, 0xaf, 0xb4, 0x50, 0x19, 0xb6, 0xca, 0xf4, 0x60, 0x27, 0xa5, 0x1b, 0x31, 0xa1,
+	0x05, 0xea, 0xec, 0x93, 0x12, 0x56, 0xae, 0xd8, 0x8d, 0x35, 0xa9, 0x2d, 0x70, 0xb6, 0x2c, 0x78,
+	0x01, 0xce, 0x55, 0x96, 0xa7, 0xf4, 0x22, 0x10, 0x81, 0x94, 0x13, 0x07, 0x3c, 0xe6, 0x9e, 0x81,
+	0x77, 0x14, 0xf0, 0x87, 0xba, 0x6c, 0xf4, 0xf2, 0x8a, 0xb1, 0x65, 0x83, 0xcc, 0x68, 0x92, 0x9d,
+	0x1f, 0x5d, 0x3f, 0xbf, 0x4d, 0x44, 0x9c, 0xdf, 0x8c, 0x42, 0xb6, 0x3a, 0x1d, 0x8f, 0xc3, 0xf4,
+	0x34, 0x8c, 0x83, 0x24, 0x1d, 0x8f, 0x4f, 0x71, 0x34, 0x6f, 0x7a, 0xf8, 0x47, 0x1d, 0xff, 0x1d,
+	0x00, 0xa2, 0xfa, 0xdb, 0x60, 0x72, 0x07, 0x00, 0x00,
 }
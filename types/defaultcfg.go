@@ -122,6 +122,16 @@ targetTimePerBlock = 16
 targetTimespan = 288 #only for test
 targetTimePerBlock = 2
 
+[mver.exec.gas]
+txGasLimit = 2000000
+blockGasLimit = 100000000
+gasPrice = 10
+txByteGas = 10
+stateReadGas = 100
+stateWriteGas = 1000
+sameTimeLocalWriteGas = 500
+writeByteGas = 10
+
 [consensus.sub.para]
 ParaRemoteGrpcClient="localhost:8802"
 #主链指定高度的区块开始同步
@@ -85,6 +85,7 @@ var (
 	ErrTxDup                      = errors.New("ErrTxDup")
 	ErrNotSync                    = errors.New("ErrNotSync")
 	ErrSize                       = errors.New("ErrSize")
+	ErrReplaceTxFeeTooLow         = errors.New("ErrReplaceTxFeeTooLow")
 
 	// ErrHashNotExist BlockChain Error Types
 	ErrHashNotExist           = errors.New("ErrHashNotExist")
@@ -132,6 +133,13 @@ var (
 	ErrNewWalletFromSeed    = errors.New("ErrNewWalletFromSeed")
 	ErrNewKeyPair           = errors.New("ErrNewKeyPair")
 	ErrPrivkeyToPub         = errors.New("ErrPrivkeyToPub")
+	ErrDecryptPrivkey       = errors.New("ErrDecryptPrivkey")
+	ErrKeystoreFormat       = errors.New("ErrKeystoreFormat")
+	ErrKeystorePasswd       = errors.New("ErrKeystorePasswd")
+	ErrKeystoreSignType     = errors.New("ErrKeystoreSignType")
+	ErrDerivationPath       = errors.New("ErrDerivationPath")
+	ErrWatchOnlyAccount     = errors.New("ErrWatchOnlyAccountCannotSign")
+	ErrAccountExist         = errors.New("ErrAccountExist")
 
 	ErrOnlyTicketUnLocked = errors.New("ErrOnlyTicketUnLocked")
 	ErrNewCrypto          = errors.New("ErrNewCrypto")
@@ -164,6 +172,9 @@ var (
 	ErrNotAllowKey                = errors.New("ErrNotAllowKey")
 	ErrNotAllowMemSetKey          = errors.New("ErrNotAllowMemSetKey")
 	ErrNotAllowMemSetLocalKey     = errors.New("ErrNotAllowMemSetLocalKey")
+	ErrTxGasLimit                 = errors.New("ErrTxGasLimit")
+	ErrBlockGasLimit              = errors.New("ErrBlockGasLimit")
+	ErrGasFeeNotEnough            = errors.New("ErrGasFeeNotEnough")
 	ErrDataBaseDamage             = errors.New("ErrDataBaseDamage")
 	ErrIndex                      = errors.New("ErrIndex")
 	ErrTxGroupParaCount           = errors.New("ErrTxGroupParaCount")
@@ -191,14 +202,28 @@ var (
 	ErrDisableWrite = errors.New("ErrDisableWrite")
 	ErrDisableRead  = errors.New("ErrDisableRead")
 
-	ErrConsensusHashErr   = errors.New("ErrConsensusHashErr")
-	ErrMaxCountPerTime    = errors.New("ErrMaxCountPerTime")
-	ErrInValidFileHeader  = errors.New("ErrInValidFileHeader")
-	ErrFileExists         = errors.New("ErrFileExists")
-	ErrSubscriberExist    = errors.New("ErrSubscriberExist")
-	ErrTooManySubscriber  = errors.New("ErrTooManySubscriber")
-	ErrPushNotSupport     = errors.New("ErrPushNotSupport")
-	ErrNotAllowModifyPush = errors.New("ErrNotAllowModifyPush")
-	ErrTxReceiptReduced   = errors.New("ErrTxReceiptReduced")
-	ErrPushNotSubscribed  = errors.New("ErrPushNotSubscribed")
+	ErrConsensusHashErr        = errors.New("ErrConsensusHashErr")
+	ErrMaxCountPerTime         = errors.New("ErrMaxCountPerTime")
+	ErrInValidFileHeader       = errors.New("ErrInValidFileHeader")
+	ErrFileExists              = errors.New("ErrFileExists")
+	ErrSubscriberExist         = errors.New("ErrSubscriberExist")
+	ErrTooManySubscriber       = errors.New("ErrTooManySubscriber")
+	ErrPushNotSupport          = errors.New("ErrPushNotSupport")
+	ErrNotAllowModifyPush      = errors.New("ErrNotAllowModifyPush")
+	ErrTxReceiptReduced        = errors.New("ErrTxReceiptReduced")
+	ErrPushNotSubscribed       = errors.New("ErrPushNotSubscribed")
+	ErrPushTransportNotSupport = errors.New("ErrPushTransportNotSupport")
+	ErrStateProofVerify        = errors.New("ErrStateProofVerify")
+	ErrStateProofNotExist      = errors.New("ErrStateProofNotExist")
+	ErrMultiSignPubKey         = errors.New("ErrMultiSignPubKey")
+	ErrMultiSignKeyNotFound    = errors.New("ErrMultiSignKeyNotFound")
+	ErrMultiSignNotMatch       = errors.New("ErrMultiSignNotMatch")
+	ErrMultiSignNotActive      = errors.New("ErrMultiSignNotActive")
+	ErrBLSSignNotActive        = errors.New("ErrBLSSignNotActive")
+	ErrBLSVerifyLimited        = errors.New("ErrBLSVerifyLimited")
+	ErrAPIKeyInvalid           = errors.New("ErrAPIKeyInvalid")
+	ErrAPITokenExpired         = errors.New("ErrAPITokenExpired")
+	ErrRateLimited             = errors.New("ErrRateLimited")
+	ErrStatePruned             = errors.New("ErrStatePruned")
+	ErrLocalDBNotHistory       = errors.New("ErrLocalDBNotHistory")
 )
@@ -132,6 +132,10 @@ const (
 
 	EventReExecBlock  = 142
 	EventTxListByHash = 143
+	EventTxReplaced   = 144
+	//store
+	EventStoreGetProof      = 145
+	EventStoreGetProofReply = 146
 	//exec
 	EventBlockChainQuery = 212
 	EventConsensusQuery  = 213
@@ -309,6 +313,10 @@ var eventName = map[int]string{
 	EventGetProperFee:   "EventGetProperFee",
 	EventReplyProperFee: "EventReplyProperFee",
 	EventTxListByHash:   "EventTxListByHash",
+	EventTxReplaced:     "EventTxReplaced",
+	//store
+	EventStoreGetProof:      "EventStoreGetProof",
+	EventStoreGetProofReply: "EventStoreGetProofReply",
 	// block chain
 	EventGetLastBlockMainSequence:   "EventGetLastBlockMainSequence",
 	EventReplyLastBlockMainSequence: "EventReplyLastBlockMainSequence",
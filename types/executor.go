@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"math/rand"
 	"reflect"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -74,6 +75,16 @@ func RegistorExecutor(exec string, util ExecutorType) {
 	}
 }
 
+// ListExecutorNames 获取所有注册的执行器名称, 按照名称排序
+func ListExecutorNames() []string {
+	names := make([]string, 0, len(executorMap))
+	for name := range executorMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // LoadExecutorType 加载执行器
 func LoadExecutorType(execstr string) ExecutorType {
 	//尽可能的加载执行器
@@ -738,6 +738,87 @@ func (m *HistoryCertStore) GetNxtHeight() int64 {
 	return 0
 }
 
+// 交易执行消耗的资源
+type ReceiptExecGas struct {
+	GasUsed    int64 `protobuf:"varint,1,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	StateRead  int64 `protobuf:"varint,2,opt,name=stateRead,proto3" json:"stateRead,omitempty"`
+	StateWrite int64 `protobuf:"varint,3,opt,name=stateWrite,proto3" json:"stateWrite,omitempty"`
+	LocalWrite int64 `protobuf:"varint,4,opt,name=localWrite,proto3" json:"localWrite,omitempty"`
+	//写入的key和value的字节数
+	WriteBytes           int64    `protobuf:"varint,5,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	TxBytes              int64    `protobuf:"varint,6,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptExecGas) Reset()         { *m = ReceiptExecGas{} }
+func (m *ReceiptExecGas) String() string { return proto.CompactTextString(m) }
+func (*ReceiptExecGas) ProtoMessage()    {}
+func (*ReceiptExecGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{12}
+}
+
+func (m *ReceiptExecGas) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptExecGas.Unmarshal(m, b)
+}
+func (m *ReceiptExecGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptExecGas.Marshal(b, m, deterministic)
+}
+func (m *ReceiptExecGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptExecGas.Merge(m, src)
+}
+func (m *ReceiptExecGas) XXX_Size() int {
+	return xxx_messageInfo_ReceiptExecGas.Size(m)
+}
+func (m *ReceiptExecGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptExecGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptExecGas proto.InternalMessageInfo
+
+func (m *ReceiptExecGas) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ReceiptExecGas) GetStateRead() int64 {
+	if m != nil {
+		return m.StateRead
+	}
+	return 0
+}
+
+func (m *ReceiptExecGas) GetStateWrite() int64 {
+	if m != nil {
+		return m.StateWrite
+	}
+	return 0
+}
+
+func (m *ReceiptExecGas) GetLocalWrite() int64 {
+	if m != nil {
+		return m.LocalWrite
+	}
+	return 0
+}
+
+func (m *ReceiptExecGas) GetWriteBytes() int64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *ReceiptExecGas) GetTxBytes() int64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "types.Genesis")
 	proto.RegisterType((*ExecTxList)(nil), "types.ExecTxList")
@@ -751,6 +832,7 @@ func init() {
 	proto.RegisterType((*ReceiptConfig)(nil), "types.ReceiptConfig")
 	proto.RegisterType((*ReplyConfig)(nil), "types.ReplyConfig")
 	proto.RegisterType((*HistoryCertStore)(nil), "types.HistoryCertStore")
+	proto.RegisterType((*ReceiptExecGas)(nil), "types.ReceiptExecGas")
 }
 
 func init() {
@@ -758,47 +840,52 @@ func init() {
 }
 
 var fileDescriptor_12d1cdcda51e000f = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xed, 0x6a, 0xdb, 0x4a,
-	0x10, 0xbd, 0x92, 0xec, 0x38, 0x1e, 0xfb, 0x86, 0x64, 0xef, 0xa5, 0x88, 0xd0, 0x26, 0x46, 0x49,
-	0x53, 0x43, 0x8b, 0x03, 0x36, 0x7d, 0x80, 0xc6, 0x94, 0x26, 0xd0, 0x14, 0xaa, 0xb8, 0x7f, 0xf2,
-	0xa3, 0xb0, 0x59, 0x8f, 0xed, 0x25, 0xf6, 0xae, 0x58, 0x8d, 0x82, 0xf5, 0x36, 0x7d, 0x96, 0xd2,
-	0x07, 0x2b, 0xbb, 0x92, 0x2d, 0xd1, 0xa4, 0x85, 0xfe, 0xd3, 0x9c, 0x73, 0x74, 0x76, 0xce, 0xec,
-	0x07, 0xec, 0xe1, 0x1a, 0x45, 0x46, 0xda, 0x0c, 0x12, 0xa3, 0x49, 0xb3, 0x26, 0xe5, 0x09, 0xa6,
-	0x87, 0x07, 0x64, 0xb8, 0x4a, 0xb9, 0x20, 0xa9, 0x55, 0xc1, 0x44, 0xc7, 0xd0, 0xfa, 0x80, 0x0a,
-	0x53, 0x99, 0xb2, 0xff, 0xa1, 0x29, 0x53, 0x93, 0xa9, 0xd0, 0xeb, 0x79, 0xfd, 0xdd, 0xb8, 0x28,
-	0xa2, 0x6f, 0x3e, 0xc0, 0xfb, 0x35, 0x8a, 0xc9, 0xfa, 0xa3, 0x4c, 0x89, 0x3d, 0x87, 0x76, 0x4a,
-	0x9c, 0xf0, 0x92, 0xa7, 0x0b, 0x27, 0xec, 0xc6, 0x15, 0xc0, 0x8e, 0x00, 0x12, 0x6e, 0x50, 0x91,
-	0xa3, 0x5b, 0x8e, 0xae, 0x21, 0xec, 0x10, 0x76, 0x57, 0x5c, 0x2a, 0xc7, 0xee, 0x3a, 0x76, 0x5b,
-	0xdb, 0x7f, 0xdd, 0x37, 0xca, 0xf9, 0x82, 0xc2, 0x76, 0xcf, 0xeb, 0x07, 0x71, 0x0d, 0xb1, 0x2b,
-	0xdf, 0x2d, 0xb5, 0xb8, 0x9f, 0xc8, 0x15, 0x86, 0x81, 0xa3, 0x2b, 0x80, 0x3d, 0x83, 0x9d, 0x45,
-	0xf1, 0x67, 0xc3, 0x51, 0x65, 0x65, 0x5d, 0xa7, 0x72, 0x36, 0x93, 0x22, 0x5b, 0x52, 0x1e, 0x36,
-	0x7b, 0x5e, 0xbf, 0x11, 0xd7, 0x10, 0xeb, 0x2a, 0xd3, 0x6b, 0x5c, 0x25, 0x5a, 0x2f, 0xc3, 0x1d,
-	0x17, 0xbc, 0x02, 0xd8, 0x29, 0x04, 0xb4, 0x4e, 0x43, 0xbf, 0x17, 0xf4, 0x3b, 0x43, 0x36, 0x70,
-	0x53, 0x1c, 0x4c, 0xaa, 0x21, 0xc6, 0x96, 0x8e, 0xbe, 0x40, 0xf3, 0x73, 0x86, 0x26, 0xb7, 0x4d,
-	0xd8, 0xc1, 0xa3, 0x29, 0x27, 0x53, 0x56, 0x36, 0xf6, 0x2c, 0x53, 0xe2, 0x13, 0x5f, 0x61, 0xe8,
-	0xf7, 0xbc, 0x7e, 0x3b, 0xde, 0xd6, 0x2c, 0x84, 0x56, 0xc2, 0xf3, 0xa5, 0xe6, 0x53, 0x17, 0xaa,
-	0x1b, 0x6f, 0xca, 0xe8, 0x2b, 0xc0, 0xd8, 0x20, 0x27, 0x9c, 0xac, 0xaf, 0xd4, 0x6f, 0xbd, 0x8f,
-	0x00, 0x8a, 0x5e, 0x6a, 0xee, 0x35, 0xe4, 0x0f, 0xfe, 0x27, 0xd0, 0x79, 0x67, 0x0c, 0xcf, 0xc7,
-	0x5a, 0xcd, 0xe4, 0xdc, 0x6e, 0xff, 0x03, 0x5f, 0x66, 0x76, 0xb6, 0x41, 0xbf, 0x1d, 0x17, 0x45,
-	0x74, 0x0a, 0xdd, 0x1b, 0x32, 0x52, 0xcd, 0x1f, 0xab, 0xbc, 0x4a, 0x75, 0x02, 0x9d, 0x2b, 0x45,
-	0xa3, 0xe1, 0x53, 0xa2, 0xe6, 0x46, 0xf4, 0xc3, 0x03, 0x28, 0x04, 0x57, 0x84, 0x2b, 0xb6, 0x0f,
-	0xc1, 0x3d, 0xe6, 0x2e, 0x4d, 0x3b, 0xb6, 0x9f, 0x8c, 0x41, 0x83, 0x4f, 0xa7, 0xa6, 0x0c, 0xe1,
-	0xbe, 0xd9, 0x19, 0x04, 0xdc, 0x18, 0x67, 0x54, 0xed, 0x40, 0xad, 0xed, 0xcb, 0x7f, 0x62, 0x2b,
-	0x60, 0xaf, 0x20, 0x48, 0xc9, 0xb8, 0xcd, 0xef, 0x0c, 0xff, 0x2b, 0x75, 0xf5, 0xce, 0xad, 0x30,
-	0x25, 0x67, 0x28, 0x15, 0xb9, 0x93, 0x50, 0x19, 0xd6, 0x9a, 0xb7, 0x3a, 0xa9, 0x88, 0xed, 0x81,
-	0x3f, 0xc9, 0xc3, 0x8e, 0x0b, 0xe0, 0x4f, 0xf2, 0x8b, 0x56, 0x99, 0x29, 0xba, 0x85, 0xee, 0xb5,
-	0x9e, 0xca, 0xd9, 0x66, 0x6e, 0x8f, 0x73, 0x6c, 0xe3, 0xfb, 0xb5, 0x19, 0x59, 0x43, 0x9d, 0x94,
-	0x63, 0xf3, 0x75, 0xb2, 0x4d, 0xdb, 0xa8, 0xd2, 0x46, 0x02, 0xfe, 0x8d, 0x51, 0xa0, 0x4c, 0xa8,
-	0x34, 0x7f, 0x09, 0x8d, 0xc4, 0xe0, 0x83, 0x73, 0xef, 0x0c, 0x0f, 0xca, 0x76, 0xab, 0x29, 0xc6,
-	0x8e, 0x66, 0xaf, 0xa1, 0x25, 0x32, 0x63, 0xaf, 0x99, 0x5b, 0xf3, 0x49, 0xe5, 0x46, 0x11, 0xbd,
-	0x85, 0x4e, 0x8c, 0xc9, 0xf2, 0x2f, 0xfb, 0x8f, 0xbe, 0x7b, 0xb0, 0x7f, 0x29, 0x53, 0xd2, 0x26,
-	0x1f, 0xa3, 0xa1, 0x1b, 0xd2, 0x06, 0xed, 0xf5, 0x31, 0x5a, 0x93, 0x40, 0x43, 0x69, 0xe8, 0xf5,
-	0x02, 0xfb, 0x1c, 0x6c, 0x01, 0xf6, 0x06, 0x0e, 0xa4, 0x22, 0x34, 0x2b, 0x9c, 0x4a, 0x4e, 0x38,
-	0x76, 0x2a, 0xdf, 0xa9, 0x1e, 0x13, 0xec, 0x0c, 0xf6, 0x0c, 0x3e, 0x68, 0xc1, 0xed, 0xd9, 0xb5,
-	0x8f, 0x8d, 0x3b, 0x89, 0xdd, 0xf8, 0x17, 0xd4, 0xae, 0x29, 0x32, 0x63, 0x5f, 0x05, 0x5a, 0x94,
-	0xb7, 0xbd, 0x02, 0x2c, 0xab, 0xd6, 0x54, 0xbe, 0x22, 0xcd, 0x82, 0xdd, 0x02, 0x17, 0xc7, 0xb7,
-	0x2f, 0xe6, 0x92, 0x16, 0xd9, 0xdd, 0x40, 0xe8, 0xd5, 0xf9, 0x68, 0x24, 0xd4, 0xb9, 0x58, 0x70,
-	0xa9, 0x46, 0xa3, 0x73, 0x37, 0xb0, 0xbb, 0x1d, 0xf7, 0x2c, 0x8e, 0x7e, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x22, 0x46, 0xb1, 0x62, 0x42, 0x05, 0x00, 0x00,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x61, 0x8b, 0xdb, 0x46,
+	0x10, 0xad, 0x24, 0xfb, 0x7c, 0x1e, 0xbb, 0x47, 0x6e, 0x5b, 0x8a, 0x08, 0x6d, 0x62, 0x94, 0x34,
+	0x35, 0xb4, 0xdc, 0xc1, 0x99, 0xfe, 0x80, 0x9e, 0x29, 0xb9, 0x83, 0xa6, 0xd0, 0x8d, 0x43, 0x21,
+	0x1f, 0x0a, 0x7b, 0xab, 0xb1, 0xbd, 0x44, 0xde, 0x15, 0xbb, 0xa3, 0xab, 0xf5, 0x6f, 0xfa, 0x5b,
+	0x4a, 0x7f, 0x58, 0xd9, 0x95, 0x6c, 0xa9, 0xbd, 0x5e, 0x21, 0xdf, 0x76, 0xde, 0x7b, 0x1e, 0xcf,
+	0x7b, 0xab, 0x1d, 0x38, 0xc3, 0x3d, 0xca, 0x8a, 0x8c, 0xbd, 0x28, 0xad, 0x21, 0xc3, 0x86, 0x54,
+	0x97, 0xe8, 0x9e, 0x9e, 0x93, 0x15, 0xda, 0x09, 0x49, 0xca, 0xe8, 0x86, 0xc9, 0x9e, 0xc3, 0xe8,
+	0x35, 0x6a, 0x74, 0xca, 0xb1, 0xcf, 0x61, 0xa8, 0x9c, 0xad, 0x74, 0x1a, 0xcd, 0xa2, 0xf9, 0x29,
+	0x6f, 0x8a, 0xec, 0x8f, 0x18, 0xe0, 0xc7, 0x3d, 0xca, 0xd5, 0xfe, 0x27, 0xe5, 0x88, 0x7d, 0x09,
+	0x63, 0x47, 0x82, 0xf0, 0x46, 0xb8, 0x6d, 0x10, 0x4e, 0x79, 0x07, 0xb0, 0x67, 0x00, 0xa5, 0xb0,
+	0xa8, 0x29, 0xd0, 0xa3, 0x40, 0xf7, 0x10, 0xf6, 0x14, 0x4e, 0x77, 0x42, 0xe9, 0xc0, 0x9e, 0x06,
+	0xf6, 0x58, 0xfb, 0xdf, 0x86, 0x33, 0xaa, 0xcd, 0x96, 0xd2, 0xf1, 0x2c, 0x9a, 0x27, 0xbc, 0x87,
+	0xf8, 0x7f, 0xbe, 0x2b, 0x8c, 0xfc, 0xb0, 0x52, 0x3b, 0x4c, 0x93, 0x40, 0x77, 0x00, 0xfb, 0x02,
+	0x4e, 0xb6, 0xcd, 0x2f, 0x07, 0x81, 0x6a, 0x2b, 0xdf, 0x35, 0x57, 0xeb, 0xb5, 0x92, 0x55, 0x41,
+	0x75, 0x3a, 0x9c, 0x45, 0xf3, 0x01, 0xef, 0x21, 0xbe, 0xab, 0x72, 0x6f, 0x70, 0x57, 0x1a, 0x53,
+	0xa4, 0x27, 0xc1, 0x78, 0x07, 0xb0, 0x97, 0x90, 0xd0, 0xde, 0xa5, 0xf1, 0x2c, 0x99, 0x4f, 0xae,
+	0xd8, 0x45, 0x48, 0xf1, 0x62, 0xd5, 0x85, 0xc8, 0x3d, 0x9d, 0xbd, 0x83, 0xe1, 0x2f, 0x15, 0xda,
+	0xda, 0x0f, 0xe1, 0x83, 0x47, 0xdb, 0x26, 0xd3, 0x56, 0xde, 0xf6, 0xba, 0xd2, 0xf2, 0x67, 0xb1,
+	0xc3, 0x34, 0x9e, 0x45, 0xf3, 0x31, 0x3f, 0xd6, 0x2c, 0x85, 0x51, 0x29, 0xea, 0xc2, 0x88, 0x3c,
+	0x98, 0x9a, 0xf2, 0x43, 0x99, 0xfd, 0x06, 0xb0, 0xb4, 0x28, 0x08, 0x57, 0xfb, 0x5b, 0xfd, 0x68,
+	0xef, 0x67, 0x00, 0xcd, 0x2c, 0xbd, 0xee, 0x3d, 0xe4, 0x7f, 0xfa, 0xbf, 0x80, 0xc9, 0x0f, 0xd6,
+	0x8a, 0x7a, 0x69, 0xf4, 0x5a, 0x6d, 0xfc, 0xf5, 0xdf, 0x8b, 0xa2, 0xf2, 0xd9, 0x26, 0xf3, 0x31,
+	0x6f, 0x8a, 0xec, 0x25, 0x4c, 0xdf, 0x92, 0x55, 0x7a, 0xf3, 0x50, 0x15, 0x75, 0xaa, 0x17, 0x30,
+	0xb9, 0xd5, 0xb4, 0xb8, 0xfa, 0x2f, 0xd1, 0xf0, 0x20, 0xfa, 0x2b, 0x02, 0x68, 0x04, 0xb7, 0x84,
+	0x3b, 0xf6, 0x04, 0x92, 0x0f, 0x58, 0x07, 0x37, 0x63, 0xee, 0x8f, 0x8c, 0xc1, 0x40, 0xe4, 0xb9,
+	0x6d, 0x4d, 0x84, 0x33, 0x7b, 0x05, 0x89, 0xb0, 0x36, 0x34, 0xea, 0x6e, 0xa0, 0x37, 0xf6, 0xcd,
+	0x27, 0xdc, 0x0b, 0xd8, 0x37, 0x90, 0x38, 0xb2, 0xe1, 0xf2, 0x27, 0x57, 0x9f, 0xb5, 0xba, 0xfe,
+	0xe4, 0x5e, 0xe8, 0x28, 0x34, 0x54, 0x9a, 0xd2, 0xe1, 0x3f, 0x1a, 0xf6, 0x86, 0xf7, 0x3a, 0xa5,
+	0x89, 0x9d, 0x41, 0xbc, 0xaa, 0xd3, 0x49, 0x30, 0x10, 0xaf, 0xea, 0xeb, 0x51, 0xeb, 0x29, 0x7b,
+	0x0f, 0xd3, 0x37, 0x26, 0x57, 0xeb, 0x43, 0x6e, 0x0f, 0x7d, 0x1c, 0xed, 0xc7, 0xbd, 0x8c, 0x7c,
+	0x43, 0x53, 0xb6, 0xb1, 0xc5, 0xa6, 0x3c, 0xba, 0x1d, 0x74, 0x6e, 0x33, 0x09, 0x9f, 0x72, 0x94,
+	0xa8, 0x4a, 0x6a, 0x9b, 0x7f, 0x0d, 0x83, 0xd2, 0xe2, 0x7d, 0xe8, 0x3e, 0xb9, 0x3a, 0x6f, 0xc7,
+	0xed, 0x52, 0xe4, 0x81, 0x66, 0xdf, 0xc2, 0x48, 0x56, 0xd6, 0x3f, 0xb3, 0x34, 0x7e, 0x4c, 0x79,
+	0x50, 0x64, 0xdf, 0xc3, 0x84, 0x63, 0x59, 0x7c, 0xe4, 0xfc, 0xd9, 0x9f, 0x11, 0x3c, 0xb9, 0x51,
+	0x8e, 0x8c, 0xad, 0x97, 0x68, 0xe9, 0x2d, 0x19, 0x8b, 0xfe, 0xf9, 0x58, 0x63, 0x48, 0xa2, 0x25,
+	0x97, 0x46, 0xb3, 0xc4, 0xaf, 0x83, 0x23, 0xc0, 0xbe, 0x83, 0x73, 0xa5, 0x09, 0xed, 0x0e, 0x73,
+	0x25, 0x08, 0x97, 0x41, 0x15, 0x07, 0xd5, 0x43, 0x82, 0xbd, 0x82, 0x33, 0x8b, 0xf7, 0x46, 0x0a,
+	0xff, 0xed, 0xfa, 0x65, 0x13, 0xbe, 0xc4, 0x29, 0xff, 0x17, 0xea, 0xff, 0x53, 0x56, 0xd6, 0x6f,
+	0x05, 0xda, 0xb6, 0xaf, 0xbd, 0x03, 0x3c, 0xab, 0xf7, 0xd4, 0x6e, 0x91, 0x61, 0xc3, 0x1e, 0x01,
+	0x6f, 0xe2, 0xac, 0x4d, 0xd8, 0x2f, 0xb5, 0xd7, 0xc2, 0xf9, 0x07, 0xb2, 0x11, 0xee, 0x9d, 0xc3,
+	0x3c, 0x64, 0x90, 0xf0, 0x43, 0x79, 0xdc, 0x75, 0x1c, 0x45, 0x1e, 0xb2, 0x48, 0x78, 0x07, 0xf8,
+	0x87, 0x17, 0x8a, 0x5f, 0xad, 0xa2, 0xc3, 0x42, 0xea, 0x21, 0x9e, 0x2f, 0x8c, 0x14, 0x45, 0xc3,
+	0x37, 0x73, 0xf6, 0x10, 0xcf, 0xff, 0xee, 0x0f, 0xd7, 0x35, 0xa1, 0x6b, 0x27, 0xed, 0x21, 0x7e,
+	0x2e, 0xda, 0x37, 0xe4, 0x49, 0x33, 0x57, 0x5b, 0x5e, 0x3f, 0x7f, 0xff, 0xd5, 0x46, 0xd1, 0xb6,
+	0xba, 0xbb, 0x90, 0x66, 0x77, 0xb9, 0x58, 0x48, 0x7d, 0x29, 0xb7, 0x42, 0xe9, 0xc5, 0xe2, 0x32,
+	0xdc, 0xfa, 0xdd, 0x49, 0xd8, 0xed, 0x8b, 0xbf, 0x07, 0x00, 0xfe, 0x64, 0xdd, 0xd1, 0x07, 0x06,
+	0x00, 0x00,
 }
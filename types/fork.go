@@ -139,6 +139,10 @@ func (f *Forks) SetTestNetFork() {
 	f.SetFork("ForkCacheDriver", 2580000)
 	f.SetFork("ForkTicketFundAddrV1", 3350000)
 	f.SetFork("ForkRootHash", 4500000)
+	f.SetFork("ForkParallelExec", MaxHeight)
+	f.SetFork("ForkExecGas", MaxHeight)
+	f.SetFork("ForkTxMultiSign", MaxHeight)
+	f.SetFork("ForkTxBLSSign", MaxHeight)
 
 }
 
@@ -287,6 +287,36 @@ func (_m *Chain33Client) ExecWallet(ctx context.Context, in *types.ChainExecutor
 	return r0, r1
 }
 
+// ExportKeystore provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) ExportKeystore(ctx context.Context, in *types.ReqKeystoreFile, opts ...grpc.CallOption) (*types.ReplyStrings, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplyStrings
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqKeystoreFile, ...grpc.CallOption) *types.ReplyStrings); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyStrings)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqKeystoreFile, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenSeed provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) GenSeed(ctx context.Context, in *types.GenSeedLang, opts ...grpc.CallOption) (*types.ReplySeed, error) {
 	_va := make([]interface{}, len(opts))
@@ -677,6 +707,36 @@ func (_m *Chain33Client) GetFork(ctx context.Context, in *types.ReqKey, opts ...
 	return r0, r1
 }
 
+// GetHDAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) GetHDAccounts(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*types.HDAccounts, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.HDAccounts
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqNil, ...grpc.CallOption) *types.HDAccounts); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.HDAccounts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqNil, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeaders provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) GetHeaders(ctx context.Context, in *types.ReqBlocks, opts ...grpc.CallOption) (*types.Headers, error) {
 	_va := make([]interface{}, len(opts))
@@ -1037,6 +1097,36 @@ func (_m *Chain33Client) GetSequenceByHash(ctx context.Context, in *types.ReqHas
 	return r0, r1
 }
 
+// GetStateProof provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) GetStateProof(ctx context.Context, in *types.StoreGet, opts ...grpc.CallOption) (*types.ReplyStateProof, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplyStateProof
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StoreGet, ...grpc.CallOption) *types.ReplyStateProof); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyStateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.StoreGet, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionByAddr provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) GetTransactionByAddr(ctx context.Context, in *types.ReqAddr, opts ...grpc.CallOption) (*types.ReplyTxInfos, error) {
 	_va := make([]interface{}, len(opts))
@@ -1127,6 +1217,36 @@ func (_m *Chain33Client) GetWalletStatus(ctx context.Context, in *types.ReqNil,
 	return r0, r1
 }
 
+// ImportKeystore provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) ImportKeystore(ctx context.Context, in *types.ReqKeystoreFile, opts ...grpc.CallOption) (*types.ReplyImportKeystore, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplyImportKeystore
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqKeystoreFile, ...grpc.CallOption) *types.ReplyImportKeystore); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyImportKeystore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqKeystoreFile, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ImportPrivkey provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) ImportPrivkey(ctx context.Context, in *types.ReqWalletImportPrivkey, opts ...grpc.CallOption) (*types.WalletAccount, error) {
 	_va := make([]interface{}, len(opts))
@@ -1187,6 +1307,36 @@ func (_m *Chain33Client) ImportPrivkeysFile(ctx context.Context, in *types.ReqPr
 	return r0, r1
 }
 
+// ImportWatchOnly provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) ImportWatchOnly(ctx context.Context, in *types.ReqWalletImportWatchOnly, opts ...grpc.CallOption) (*types.WalletAccount, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.WalletAccount
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqWalletImportWatchOnly, ...grpc.CallOption) *types.WalletAccount); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.WalletAccount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqWalletImportWatchOnly, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsNtpClockSync provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) IsNtpClockSync(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*types.Reply, error) {
 	_va := make([]interface{}, len(opts))
@@ -1517,6 +1667,36 @@ func (_m *Chain33Client) QueryTransaction(ctx context.Context, in *types.ReqHash
 	return r0, r1
 }
 
+// RecoverAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) RecoverAccounts(ctx context.Context, in *types.ReqRecoverAccounts, opts ...grpc.CallOption) (*types.HDAccounts, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.HDAccounts
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqRecoverAccounts, ...grpc.CallOption) *types.HDAccounts); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.HDAccounts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqRecoverAccounts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveSeed provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) SaveSeed(ctx context.Context, in *types.SaveSeedByPw, opts ...grpc.CallOption) (*types.Reply, error) {
 	_va := make([]interface{}, len(opts))
@@ -1727,6 +1907,96 @@ func (_m *Chain33Client) SignRawTx(ctx context.Context, in *types.ReqSignRawTx,
 	return r0, r1
 }
 
+// SimulateTransaction provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) SimulateTransaction(ctx context.Context, in *types.ReqSimulateTx, opts ...grpc.CallOption) (*types.ReplySimulateTx, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplySimulateTx
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqSimulateTx, ...grpc.CallOption) *types.ReplySimulateTx); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySimulateTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqSimulateTx, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeBlockSeqs provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) SubscribeBlockSeqs(ctx context.Context, in *types.ReqSubscribeBlockSeqs, opts ...grpc.CallOption) (types.Chain33_SubscribeBlockSeqsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 types.Chain33_SubscribeBlockSeqsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqSubscribeBlockSeqs, ...grpc.CallOption) types.Chain33_SubscribeBlockSeqsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Chain33_SubscribeBlockSeqsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqSubscribeBlockSeqs, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeTxReceipts provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) SubscribeTxReceipts(ctx context.Context, in *types.ReqSubscribeTxReceipts, opts ...grpc.CallOption) (types.Chain33_SubscribeTxReceiptsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 types.Chain33_SubscribeTxReceiptsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqSubscribeTxReceipts, ...grpc.CallOption) types.Chain33_SubscribeTxReceiptsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Chain33_SubscribeTxReceiptsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqSubscribeTxReceipts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnLock provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) UnLock(ctx context.Context, in *types.WalletUnLock, opts ...grpc.CallOption) (*types.Reply, error) {
 	_va := make([]interface{}, len(opts))
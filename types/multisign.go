@@ -0,0 +1,184 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+)
+
+//MaxMultiSignKeys 多重签名最多的公钥数量
+const MaxMultiSignKeys = 20
+
+//NewMultiSignPubKey 创建m-of-n多重签名的公钥集合, 公钥按照字节序排序, 保证相同的集合得到相同的地址
+func NewMultiSignPubKey(threshold int32, keys []*MultiSignKey) (*MultiSignPubKey, error) {
+	sorted := make([]*MultiSignKey, len(keys))
+	copy(sorted, keys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Pubkey, sorted[j].Pubkey) < 0
+	})
+	pub := &MultiSignPubKey{Threshold: threshold, Keys: sorted}
+	if err := pub.check(); err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+func (m *MultiSignPubKey) check() error {
+	n := len(m.GetKeys())
+	if n == 0 || n > MaxMultiSignKeys || m.GetThreshold() <= 0 || int(m.GetThreshold()) > n {
+		return ErrMultiSignPubKey
+	}
+	for i, key := range m.Keys {
+		//不允许嵌套多重签名
+		if key.Ty == MultiSign || len(key.Pubkey) == 0 {
+			return ErrMultiSignPubKey
+		}
+		if i > 0 && bytes.Compare(m.Keys[i-1].Pubkey, key.Pubkey) >= 0 {
+			return ErrMultiSignPubKey
+		}
+	}
+	return nil
+}
+
+//CheckToAddress 检查交易的接收地址, ForkTxMultiSign之后可以向多重签名地址转账
+func CheckToAddress(cfg *Chain33Config, height int64, to string) error {
+	err := address.CheckAddress(to)
+	if err != nil && cfg.IsFork(height, "ForkTxMultiSign") && address.CheckMultiSignAddress(to) == nil {
+		return nil
+	}
+	return err
+}
+
+//CountBLSSign 签名中需要验证的BLS签名数量, 包括多重签名中的BLS签名
+func CountBLSSign(sign *Signature) int {
+	switch sign.GetTy() {
+	case BLS:
+		return 1
+	case MultiSign:
+		var pub MultiSignPubKey
+		var sigs MultiSignSignature
+		if Decode(sign.Pubkey, &pub) != nil || Decode(sign.Signature, &sigs) != nil {
+			return 0
+		}
+		n := 0
+		for _, item := range sigs.Sigs {
+			if item.Index >= 0 && int(item.Index) < len(pub.Keys) && pub.Keys[item.Index].Ty == BLS {
+				n++
+			}
+		}
+		return n
+	}
+	return 0
+}
+
+//Address 多重签名地址, 由排序后的公钥集合和门限计算
+func (m *MultiSignPubKey) Address() string {
+	return address.MultiSignAddress(Encode(m))
+}
+
+func (m *MultiSignPubKey) indexOf(ty int32, pubkey []byte) int {
+	for i, key := range m.GetKeys() {
+		if key.Ty == ty && bytes.Equal(key.Pubkey, pubkey) {
+			return i
+		}
+	}
+	return -1
+}
+
+// addSign 按照index排序插入签名, 相同的index覆盖原来的签名
+func (s *MultiSignSignature) addSign(item *MultiSignItem) {
+	for i, sig := range s.Sigs {
+		if sig.Index == item.Index {
+			s.Sigs[i] = item
+			return
+		}
+	}
+	s.Sigs = append(s.Sigs, item)
+	sort.Slice(s.Sigs, func(i, j int) bool { return s.Sigs[i].Index < s.Sigs[j].Index })
+}
+
+// multiSignOf 交易中已有的多重签名, 公钥集合不同或者不是多重签名时返回空的签名
+func (tx *Transaction) multiSignOf(pubkey []byte) *MultiSignSignature {
+	sign := tx.GetSignature()
+	if sign.GetTy() != MultiSign || !bytes.Equal(sign.GetPubkey(), pubkey) {
+		return &MultiSignSignature{}
+	}
+	var sigs MultiSignSignature
+	if err := Decode(sign.Signature, &sigs); err != nil {
+		return &MultiSignSignature{}
+	}
+	return &sigs
+}
+
+//SignMulti 使用其中一个私钥对交易做多重签名, 交易中已经有相同公钥集合的部分签名时追加签名
+func (tx *Transaction) SignMulti(pub *MultiSignPubKey, ty int32, priv crypto.PrivKey) error {
+	if err := pub.check(); err != nil {
+		return err
+	}
+	index := pub.indexOf(ty, priv.PubKey().Bytes())
+	if index < 0 {
+		return ErrMultiSignKeyNotFound
+	}
+	pubkey := Encode(pub)
+	sigs := tx.multiSignOf(pubkey)
+	tx.Signature = nil
+	tx.UnsetCacheHash()
+	data := Encode(tx)
+	sigs.addSign(&MultiSignItem{Index: int32(index), Signature: priv.Sign(data).Bytes()})
+	tx.Signature = &Signature{Ty: MultiSign, Pubkey: pubkey, Signature: Encode(sigs)}
+	return nil
+}
+
+//CombineMultiSign 合并同一笔交易的多份部分签名, 交易内容和公钥集合必须相同
+func CombineMultiSign(txs ...*Transaction) (*Transaction, error) {
+	if len(txs) == 0 || txs[0].GetSignature().GetTy() != MultiSign {
+		return nil, ErrMultiSignNotMatch
+	}
+	pubkey := txs[0].Signature.Pubkey
+	hash := txs[0].Hash()
+	sigs := &MultiSignSignature{}
+	for _, tx := range txs {
+		if tx.GetSignature().GetTy() != MultiSign || !bytes.Equal(tx.Signature.Pubkey, pubkey) || !bytes.Equal(tx.Hash(), hash) {
+			return nil, ErrMultiSignNotMatch
+		}
+		for _, item := range tx.multiSignOf(pubkey).GetSigs() {
+			sigs.addSign(item)
+		}
+	}
+	combined := txs[0].Clone()
+	combined.Signature = &Signature{Ty: MultiSign, Pubkey: pubkey, Signature: Encode(sigs)}
+	return combined, nil
+}
+
+// checkMultiSign 签名的index严格递增, 所有签名都必须正确, 并且数量达到门限
+func checkMultiSign(data []byte, execer string, sign *Signature) bool {
+	var pub MultiSignPubKey
+	if err := Decode(sign.Pubkey, &pub); err != nil || pub.check() != nil {
+		return false
+	}
+	var sigs MultiSignSignature
+	if err := Decode(sign.Signature, &sigs); err != nil {
+		return false
+	}
+	if len(sigs.Sigs) < int(pub.Threshold) {
+		return false
+	}
+	last := int32(-1)
+	for _, item := range sigs.Sigs {
+		if item.Index <= last || int(item.Index) >= len(pub.Keys) {
+			return false
+		}
+		last = item.Index
+		key := pub.Keys[item.Index]
+		if !CheckSign(data, execer, &Signature{Ty: key.Ty, Pubkey: key.Pubkey, Signature: item.Signature}) {
+			return false
+		}
+	}
+	return true
+}
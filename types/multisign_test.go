@@ -0,0 +1,152 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	_ "github.com/33cn/chain33/system/crypto/init"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genMultiSignKeys(t *testing.T, n int) ([]crypto.PrivKey, []*MultiSignKey) {
+	cr, err := crypto.New(GetSignName("", SECP256K1))
+	require.Nil(t, err)
+	var privs []crypto.PrivKey
+	var keys []*MultiSignKey
+	for i := 0; i < n; i++ {
+		priv, err := cr.GenKey()
+		require.Nil(t, err)
+		privs = append(privs, priv)
+		keys = append(keys, &MultiSignKey{Ty: SECP256K1, Pubkey: priv.PubKey().Bytes()})
+	}
+	return privs, keys
+}
+
+func TestMultiSignPubKey(t *testing.T) {
+	_, keys := genMultiSignKeys(t, 3)
+	pub, err := NewMultiSignPubKey(2, keys)
+	assert.Nil(t, err)
+	//公钥顺序不影响地址
+	reversed := []*MultiSignKey{keys[2], keys[1], keys[0]}
+	pub2, err := NewMultiSignPubKey(2, reversed)
+	assert.Nil(t, err)
+	assert.Equal(t, pub.Address(), pub2.Address())
+	assert.Nil(t, address.CheckMultiSignAddress(pub.Address()))
+	pub3, _ := NewMultiSignPubKey(3, keys)
+	assert.NotEqual(t, pub.Address(), pub3.Address())
+
+	_, err = NewMultiSignPubKey(0, keys)
+	assert.Equal(t, ErrMultiSignPubKey, err)
+	_, err = NewMultiSignPubKey(4, keys)
+	assert.Equal(t, ErrMultiSignPubKey, err)
+	_, err = NewMultiSignPubKey(1, []*MultiSignKey{keys[0], keys[0]})
+	assert.Equal(t, ErrMultiSignPubKey, err)
+	_, err = NewMultiSignPubKey(1, []*MultiSignKey{{Ty: MultiSign, Pubkey: Encode(pub)}})
+	assert.Equal(t, ErrMultiSignPubKey, err)
+}
+
+func TestMultiSignTx(t *testing.T) {
+	privs, keys := genMultiSignKeys(t, 3)
+	pub, err := NewMultiSignPubKey(2, keys)
+	require.Nil(t, err)
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("none"), Fee: 100000, Nonce: 1, To: "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"}
+
+	//第一个签名者只有部分签名, 验证失败
+	assert.Nil(t, tx.SignMulti(pub, SECP256K1, privs[0]))
+	assert.False(t, tx.CheckSign())
+	assert.Equal(t, pub.Address(), tx.From())
+	partial := tx.Clone()
+
+	//在部分签名的基础上追加签名
+	assert.Nil(t, tx.SignMulti(pub, SECP256K1, privs[2]))
+	assert.True(t, tx.CheckSign())
+	var sigs MultiSignSignature
+	require.Nil(t, Decode(tx.Signature.Signature, &sigs))
+	assert.Equal(t, 2, len(sigs.Sigs))
+
+	//不在公钥集合中的私钥不能签名
+	other, _ := genMultiSignKeys(t, 1)
+	assert.Equal(t, ErrMultiSignKeyNotFound, partial.Clone().SignMulti(pub, SECP256K1, other[0]))
+
+	//分别签名后合并
+	tx2 := partial.Clone()
+	tx2.Signature = nil
+	assert.Nil(t, tx2.SignMulti(pub, SECP256K1, privs[1]))
+	combined, err := CombineMultiSign(partial, tx2)
+	assert.Nil(t, err)
+	assert.True(t, combined.CheckSign())
+	assert.Equal(t, tx.Hash(), combined.Hash())
+
+	//交易内容不同不能合并
+	tx3 := partial.Clone()
+	tx3.Fee++
+	_, err = CombineMultiSign(partial, tx3)
+	assert.Equal(t, ErrMultiSignNotMatch, err)
+
+	//篡改其中一个签名后验证失败
+	sigs.Sigs[1].Signature = sigs.Sigs[0].Signature
+	tx.Signature.Signature = Encode(&sigs)
+	assert.False(t, tx.CheckSign())
+}
+
+func TestMultiSignFork(t *testing.T) {
+	privs, keys := genMultiSignKeys(t, 2)
+	pub, err := NewMultiSignPubKey(1, keys)
+	require.Nil(t, err)
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("none"), Fee: 100000, To: "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"}
+	require.Nil(t, tx.SignMulti(pub, SECP256K1, privs[1]))
+
+	cfg := NewChain33Config(GetDefaultCfgstring())
+	assert.Nil(t, tx.check(cfg, 1, 100000, 1e9))
+	cfg = NewChain33Config(ReadFile("testdata/bityuan.toml"))
+	assert.Equal(t, ErrMultiSignNotActive, tx.check(cfg, 1, 100000, 1e9))
+}
+
+func TestCheckToAddress(t *testing.T) {
+	_, keys := genMultiSignKeys(t, 2)
+	pub, err := NewMultiSignPubKey(1, keys)
+	require.Nil(t, err)
+	cfg := NewChain33Config(GetDefaultCfgstring())
+	assert.Nil(t, CheckToAddress(cfg, 1, "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"))
+	assert.Nil(t, CheckToAddress(cfg, 1, pub.Address()))
+	assert.NotNil(t, CheckToAddress(cfg, 1, "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE5"))
+	//fork之前多重签名地址不能作为接收地址
+	cfg = NewChain33Config(ReadFile("testdata/bityuan.toml"))
+	assert.Equal(t, address.ErrCheckVersion, CheckToAddress(cfg, 1, pub.Address()))
+}
+
+func TestBLSSignFork(t *testing.T) {
+	cr, err := crypto.New(GetSignName("", BLS))
+	require.Nil(t, err)
+	priv, err := cr.GenKey()
+	require.Nil(t, err)
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("none"), Fee: 100000, To: "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"}
+	tx.Sign(BLS, priv)
+	assert.Equal(t, 1, CountBLSSign(tx.Signature))
+	assert.True(t, tx.CheckSign())
+
+	//多重签名中的BLS签名
+	privs, keys := genMultiSignKeys(t, 1)
+	keys = append(keys, &MultiSignKey{Ty: BLS, Pubkey: priv.PubKey().Bytes()})
+	pub, err := NewMultiSignPubKey(2, keys)
+	require.Nil(t, err)
+	multi := tx.Clone()
+	require.Nil(t, multi.SignMulti(pub, SECP256K1, privs[0]))
+	require.Nil(t, multi.SignMulti(pub, BLS, priv))
+	assert.Equal(t, 1, CountBLSSign(multi.Signature))
+	assert.True(t, multi.CheckSign())
+
+	cfg := NewChain33Config(GetDefaultCfgstring())
+	assert.Nil(t, tx.check(cfg, 1, 100000, 1e9))
+	assert.Nil(t, multi.check(cfg, 1, 100000, 1e9))
+	cfg = NewChain33Config(ReadFile("testdata/local.mvertest.toml"))
+	assert.Nil(t, multi.check(cfg, 1, 100000, 1e9))
+	cfg = NewChain33Config(ReadFile("testdata/bityuan.toml"))
+	assert.Equal(t, ErrBLSSignNotActive, tx.check(cfg, 1, 100000, 1e9))
+}
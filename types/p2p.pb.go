@@ -966,7 +966,54 @@ func (m *P2PTx) GetRoute() *P2PRoute {
 	return nil
 }
 
-//*
+// mempool替换交易通知, p2p不再接收被替换的交易, 并向其他节点转发替换的交易
+type P2PTxReplaced struct {
+	OldHash              []byte       `protobuf:"bytes,1,opt,name=oldHash,proto3" json:"oldHash,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *P2PTxReplaced) Reset()         { *m = P2PTxReplaced{} }
+func (m *P2PTxReplaced) String() string { return proto.CompactTextString(m) }
+func (*P2PTxReplaced) ProtoMessage()    {}
+func (*P2PTxReplaced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{17}
+}
+
+func (m *P2PTxReplaced) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PTxReplaced.Unmarshal(m, b)
+}
+func (m *P2PTxReplaced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PTxReplaced.Marshal(b, m, deterministic)
+}
+func (m *P2PTxReplaced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PTxReplaced.Merge(m, src)
+}
+func (m *P2PTxReplaced) XXX_Size() int {
+	return xxx_messageInfo_P2PTxReplaced.Size(m)
+}
+func (m *P2PTxReplaced) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PTxReplaced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PTxReplaced proto.InternalMessageInfo
+
+func (m *P2PTxReplaced) GetOldHash() []byte {
+	if m != nil {
+		return m.OldHash
+	}
+	return nil
+}
+
+func (m *P2PTxReplaced) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
 // p2p 发送区块协议
 type P2PBlock struct {
 	Block                *Block   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
@@ -979,7 +1026,7 @@ func (m *P2PBlock) Reset()         { *m = P2PBlock{} }
 func (m *P2PBlock) String() string { return proto.CompactTextString(m) }
 func (*P2PBlock) ProtoMessage()    {}
 func (*P2PBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{17}
+	return fileDescriptor_e7fdddb109e6467a, []int{18}
 }
 
 func (m *P2PBlock) XXX_Unmarshal(b []byte) error {
@@ -1023,7 +1070,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{18}
+	return fileDescriptor_e7fdddb109e6467a, []int{19}
 }
 
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
@@ -1085,7 +1132,7 @@ func (m *LightTx) Reset()         { *m = LightTx{} }
 func (m *LightTx) String() string { return proto.CompactTextString(m) }
 func (*LightTx) ProtoMessage()    {}
 func (*LightTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{19}
+	return fileDescriptor_e7fdddb109e6467a, []int{20}
 }
 
 func (m *LightTx) XXX_Unmarshal(b []byte) error {
@@ -1132,7 +1179,7 @@ func (m *P2PTxReq) Reset()         { *m = P2PTxReq{} }
 func (m *P2PTxReq) String() string { return proto.CompactTextString(m) }
 func (*P2PTxReq) ProtoMessage()    {}
 func (*P2PTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{20}
+	return fileDescriptor_e7fdddb109e6467a, []int{21}
 }
 
 func (m *P2PTxReq) XXX_Unmarshal(b []byte) error {
@@ -1173,7 +1220,7 @@ func (m *P2PBlockTxReq) Reset()         { *m = P2PBlockTxReq{} }
 func (m *P2PBlockTxReq) String() string { return proto.CompactTextString(m) }
 func (*P2PBlockTxReq) ProtoMessage()    {}
 func (*P2PBlockTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{21}
+	return fileDescriptor_e7fdddb109e6467a, []int{22}
 }
 
 func (m *P2PBlockTxReq) XXX_Unmarshal(b []byte) error {
@@ -1222,7 +1269,7 @@ func (m *P2PBlockTxReply) Reset()         { *m = P2PBlockTxReply{} }
 func (m *P2PBlockTxReply) String() string { return proto.CompactTextString(m) }
 func (*P2PBlockTxReply) ProtoMessage()    {}
 func (*P2PBlockTxReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{22}
+	return fileDescriptor_e7fdddb109e6467a, []int{23}
 }
 
 func (m *P2PBlockTxReply) XXX_Unmarshal(b []byte) error {
@@ -1281,7 +1328,7 @@ func (m *P2PQueryData) Reset()         { *m = P2PQueryData{} }
 func (m *P2PQueryData) String() string { return proto.CompactTextString(m) }
 func (*P2PQueryData) ProtoMessage()    {}
 func (*P2PQueryData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{23}
+	return fileDescriptor_e7fdddb109e6467a, []int{24}
 }
 
 func (m *P2PQueryData) XXX_Unmarshal(b []byte) error {
@@ -1362,7 +1409,7 @@ func (m *Versions) Reset()         { *m = Versions{} }
 func (m *Versions) String() string { return proto.CompactTextString(m) }
 func (*Versions) ProtoMessage()    {}
 func (*Versions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{24}
+	return fileDescriptor_e7fdddb109e6467a, []int{25}
 }
 
 func (m *Versions) XXX_Unmarshal(b []byte) error {
@@ -1426,7 +1473,7 @@ func (m *BroadCastData) Reset()         { *m = BroadCastData{} }
 func (m *BroadCastData) String() string { return proto.CompactTextString(m) }
 func (*BroadCastData) ProtoMessage()    {}
 func (*BroadCastData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{25}
+	return fileDescriptor_e7fdddb109e6467a, []int{26}
 }
 
 func (m *BroadCastData) XXX_Unmarshal(b []byte) error {
@@ -1591,7 +1638,7 @@ func (m *P2PGetHeaders) Reset()         { *m = P2PGetHeaders{} }
 func (m *P2PGetHeaders) String() string { return proto.CompactTextString(m) }
 func (*P2PGetHeaders) ProtoMessage()    {}
 func (*P2PGetHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{26}
+	return fileDescriptor_e7fdddb109e6467a, []int{27}
 }
 
 func (m *P2PGetHeaders) XXX_Unmarshal(b []byte) error {
@@ -1646,7 +1693,7 @@ func (m *P2PHeaders) Reset()         { *m = P2PHeaders{} }
 func (m *P2PHeaders) String() string { return proto.CompactTextString(m) }
 func (*P2PHeaders) ProtoMessage()    {}
 func (*P2PHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{27}
+	return fileDescriptor_e7fdddb109e6467a, []int{28}
 }
 
 func (m *P2PHeaders) XXX_Unmarshal(b []byte) error {
@@ -1691,7 +1738,7 @@ func (m *InvData) Reset()         { *m = InvData{} }
 func (m *InvData) String() string { return proto.CompactTextString(m) }
 func (*InvData) ProtoMessage()    {}
 func (*InvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{28}
+	return fileDescriptor_e7fdddb109e6467a, []int{29}
 }
 
 func (m *InvData) XXX_Unmarshal(b []byte) error {
@@ -1777,7 +1824,7 @@ func (m *InvDatas) Reset()         { *m = InvDatas{} }
 func (m *InvDatas) String() string { return proto.CompactTextString(m) }
 func (*InvDatas) ProtoMessage()    {}
 func (*InvDatas) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{29}
+	return fileDescriptor_e7fdddb109e6467a, []int{30}
 }
 
 func (m *InvDatas) XXX_Unmarshal(b []byte) error {
@@ -1826,7 +1873,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{30}
+	return fileDescriptor_e7fdddb109e6467a, []int{31}
 }
 
 func (m *Peer) XXX_Unmarshal(b []byte) error {
@@ -1923,7 +1970,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{31}
+	return fileDescriptor_e7fdddb109e6467a, []int{32}
 }
 
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
@@ -1964,7 +2011,7 @@ func (m *P2PGetPeerReq) Reset()         { *m = P2PGetPeerReq{} }
 func (m *P2PGetPeerReq) String() string { return proto.CompactTextString(m) }
 func (*P2PGetPeerReq) ProtoMessage()    {}
 func (*P2PGetPeerReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{32}
+	return fileDescriptor_e7fdddb109e6467a, []int{33}
 }
 
 func (m *P2PGetPeerReq) XXX_Unmarshal(b []byte) error {
@@ -2005,7 +2052,7 @@ func (m *P2PGetNetInfoReq) Reset()         { *m = P2PGetNetInfoReq{} }
 func (m *P2PGetNetInfoReq) String() string { return proto.CompactTextString(m) }
 func (*P2PGetNetInfoReq) ProtoMessage()    {}
 func (*P2PGetNetInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{33}
+	return fileDescriptor_e7fdddb109e6467a, []int{34}
 }
 
 func (m *P2PGetNetInfoReq) XXX_Unmarshal(b []byte) error {
@@ -2055,7 +2102,7 @@ func (m *NodeNetInfo) Reset()         { *m = NodeNetInfo{} }
 func (m *NodeNetInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeNetInfo) ProtoMessage()    {}
 func (*NodeNetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{34}
+	return fileDescriptor_e7fdddb109e6467a, []int{35}
 }
 
 func (m *NodeNetInfo) XXX_Unmarshal(b []byte) error {
@@ -2157,7 +2204,7 @@ func (m *PeersReply) Reset()         { *m = PeersReply{} }
 func (m *PeersReply) String() string { return proto.CompactTextString(m) }
 func (*PeersReply) ProtoMessage()    {}
 func (*PeersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{35}
+	return fileDescriptor_e7fdddb109e6467a, []int{36}
 }
 
 func (m *PeersReply) XXX_Unmarshal(b []byte) error {
@@ -2200,7 +2247,7 @@ func (m *PeersInfo) Reset()         { *m = PeersInfo{} }
 func (m *PeersInfo) String() string { return proto.CompactTextString(m) }
 func (*PeersInfo) ProtoMessage()    {}
 func (*PeersInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{36}
+	return fileDescriptor_e7fdddb109e6467a, []int{37}
 }
 
 func (m *PeersInfo) XXX_Unmarshal(b []byte) error {
@@ -2274,6 +2321,7 @@ func init() {
 	proto.RegisterType((*P2PGetData)(nil), "types.P2PGetData")
 	proto.RegisterType((*P2PRoute)(nil), "types.P2PRoute")
 	proto.RegisterType((*P2PTx)(nil), "types.P2PTx")
+	proto.RegisterType((*P2PTxReplaced)(nil), "types.P2PTxReplaced")
 	proto.RegisterType((*P2PBlock)(nil), "types.P2PBlock")
 	proto.RegisterType((*LightBlock)(nil), "types.LightBlock")
 	proto.RegisterType((*LightTx)(nil), "types.LightTx")
@@ -2301,114 +2349,115 @@ func init() {
 }
 
 var fileDescriptor_e7fdddb109e6467a = []byte{
-	// 1701 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x8f, 0x1b, 0xb7,
-	0x15, 0x1f, 0xfd, 0x99, 0x95, 0xf4, 0xb4, 0xd6, 0xae, 0x19, 0x37, 0x10, 0x04, 0x37, 0x71, 0x09,
-	0x27, 0x76, 0xeb, 0x58, 0x76, 0x66, 0x53, 0x17, 0x68, 0x7a, 0xd9, 0x75, 0x5a, 0x6b, 0xd1, 0xad,
-	0x31, 0xe5, 0xaa, 0x3d, 0xf4, 0x36, 0x2b, 0x71, 0xa5, 0x81, 0x47, 0x9c, 0xd9, 0x19, 0x4a, 0x90,
-	0x72, 0xef, 0xa1, 0x40, 0x6f, 0xfd, 0x2c, 0xfd, 0x56, 0xf9, 0x08, 0x3d, 0x14, 0x7c, 0x24, 0xe7,
-	0x8f, 0xa4, 0x55, 0x8d, 0x06, 0xb9, 0x91, 0xbf, 0xf7, 0xf8, 0xc8, 0xf7, 0xff, 0xcd, 0x40, 0x27,
-	0xf1, 0x92, 0x61, 0x92, 0xc6, 0x32, 0x26, 0xae, 0xdc, 0x24, 0x3c, 0x1b, 0x3c, 0x94, 0x69, 0x20,
-	0xb2, 0x60, 0x22, 0xc3, 0x58, 0x68, 0xca, 0xe0, 0x78, 0x12, 0x2f, 0x16, 0xf9, 0xee, 0xf4, 0x26,
-	0x8a, 0x27, 0x1f, 0x26, 0xf3, 0x20, 0x34, 0x08, 0xfd, 0x15, 0xf4, 0x7c, 0xcf, 0x7f, 0xc7, 0xa5,
-	0xcf, 0x79, 0x7a, 0x29, 0x6e, 0x63, 0xd2, 0x87, 0xd6, 0x8a, 0xa7, 0x59, 0x18, 0x8b, 0x7e, 0xed,
-	0x49, 0xed, 0xb9, 0xcb, 0xec, 0x96, 0xfe, 0xa7, 0x06, 0x5d, 0xdf, 0xf3, 0x73, 0x4e, 0x02, 0xcd,
-	0x60, 0x3a, 0x4d, 0x91, 0xad, 0xc3, 0x70, 0xad, 0xb0, 0x24, 0x4e, 0x65, 0xbf, 0x8e, 0x47, 0x71,
-	0xad, 0x30, 0x11, 0x2c, 0x78, 0xbf, 0xa1, 0xf9, 0xd4, 0x9a, 0x3c, 0x81, 0xee, 0x82, 0x2f, 0x92,
-	0x38, 0x8e, 0xae, 0xc3, 0xef, 0x79, 0xbf, 0x89, 0xec, 0x65, 0x88, 0x7c, 0x01, 0x47, 0x73, 0x1e,
-	0x4c, 0x79, 0xda, 0x77, 0x9f, 0xd4, 0x9e, 0x77, 0xbd, 0x07, 0x43, 0x54, 0x72, 0x38, 0x42, 0x90,
-	0x19, 0x62, 0xf9, 0xb9, 0x47, 0x28, 0xdf, 0x6e, 0xc9, 0x97, 0xd0, 0x8b, 0xe2, 0x49, 0x10, 0x7d,
-	0x77, 0xf1, 0x57, 0xc3, 0xd0, 0x42, 0x86, 0x2d, 0x54, 0xf1, 0x65, 0x32, 0x4e, 0x79, 0xc1, 0xd7,
-	0xd6, 0x7c, 0x55, 0x94, 0xfe, 0x50, 0x03, 0xf0, 0x3d, 0xdf, 0x1e, 0xbb, 0xd7, 0x4e, 0x8a, 0x92,
-	0xf1, 0x74, 0x15, 0x4e, 0x38, 0x9a, 0xa1, 0xc1, 0xec, 0x96, 0x3c, 0x86, 0x8e, 0x0c, 0x17, 0x3c,
-	0x93, 0xc1, 0x22, 0x41, 0x73, 0x34, 0x58, 0x01, 0x90, 0x01, 0xb4, 0x95, 0x0d, 0x19, 0x9f, 0xac,
-	0xd0, 0x20, 0x1d, 0x96, 0xef, 0x2d, 0xed, 0x0f, 0x69, 0xbc, 0x40, 0x7b, 0x18, 0x9a, 0xda, 0x93,
-	0x47, 0xe0, 0x8a, 0x58, 0x4c, 0x38, 0x1a, 0xa0, 0xc1, 0xf4, 0x46, 0xdd, 0xb5, 0xcc, 0x78, 0x7a,
-	0x3e, 0xe3, 0x42, 0x1a, 0xcd, 0x0b, 0x40, 0xd9, 0x3f, 0x93, 0x41, 0x2a, 0x47, 0x3c, 0x9c, 0xcd,
-	0x25, 0x6a, 0xdc, 0x60, 0x65, 0x88, 0xfe, 0x05, 0x3a, 0x5a, 0xdb, 0xf3, 0xc9, 0x87, 0xff, 0x4b,
-	0xd9, 0xfc, 0x59, 0x8d, 0xd2, 0xb3, 0xe8, 0x02, 0x5a, 0x2a, 0x86, 0x42, 0x31, 0x2b, 0x18, 0x6a,
-	0xe5, 0x77, 0xdb, 0xa8, 0xaa, 0xef, 0x89, 0xaa, 0x46, 0x29, 0xaa, 0x9e, 0x42, 0x33, 0x0b, 0x67,
-	0x02, 0x2d, 0xd5, 0xf5, 0x4e, 0x4d, 0x74, 0x5c, 0x87, 0x33, 0x11, 0xc8, 0x65, 0xca, 0x19, 0x52,
-	0xe9, 0xe7, 0xfa, 0xba, 0xf8, 0xbe, 0xeb, 0x28, 0x45, 0xa7, 0xbe, 0xe3, 0xf2, 0x5c, 0x5d, 0xb4,
-	0x9f, 0xe7, 0x5b, 0x14, 0x72, 0x3f, 0x83, 0xf5, 0x4e, 0x14, 0x66, 0x2a, 0xf2, 0x1b, 0xd6, 0x3b,
-	0x6a, 0x4f, 0xaf, 0x31, 0x69, 0xd4, 0xe1, 0xab, 0x30, 0x93, 0xf7, 0x08, 0x18, 0x42, 0x3b, 0xe1,
-	0x3c, 0x0d, 0xc5, 0x6d, 0x8c, 0x02, 0xba, 0x1e, 0x31, 0x0a, 0x95, 0x12, 0x8e, 0xe5, 0x3c, 0xf4,
-	0x2d, 0x9c, 0xf8, 0x9e, 0xff, 0xfb, 0xb5, 0xe4, 0xa9, 0x08, 0xa2, 0x7b, 0xb3, 0xf1, 0x31, 0x74,
-	0xc2, 0x2c, 0x5e, 0xca, 0x2c, 0x9c, 0x6a, 0xf7, 0xb4, 0x59, 0x01, 0xd0, 0x39, 0x1c, 0x6b, 0xd5,
-	0x2f, 0x54, 0x55, 0xc8, 0x0e, 0x38, 0x79, 0x2b, 0x5a, 0xea, 0x3b, 0xd1, 0xa2, 0x6e, 0xe2, 0x62,
-	0x6a, 0xe8, 0x26, 0xb2, 0x73, 0x80, 0xfe, 0x12, 0x1e, 0xe8, 0x9b, 0xfe, 0xa4, 0x13, 0xfc, 0x40,
-	0x91, 0x19, 0xc2, 0x91, 0xef, 0xf9, 0x97, 0x62, 0xa5, 0x1c, 0x1c, 0x8a, 0x55, 0xd6, 0xaf, 0xa1,
-	0x3d, 0xac, 0x83, 0x2f, 0xc5, 0x8a, 0x0b, 0x19, 0xa7, 0x1b, 0x86, 0x54, 0xfa, 0x0e, 0x3a, 0x39,
-	0x44, 0x7a, 0x50, 0x97, 0x1b, 0x23, 0xb1, 0x2e, 0x37, 0xca, 0x26, 0xf3, 0x20, 0x9b, 0xe3, 0x83,
-	0x8f, 0x19, 0xae, 0xc9, 0xa7, 0xaa, 0xae, 0x94, 0x9e, 0x69, 0x76, 0xf4, 0xca, 0x06, 0xc2, 0x77,
-	0x81, 0x0c, 0x0e, 0xd8, 0xc2, 0x3e, 0xab, 0x7e, 0xf0, 0x59, 0x8f, 0xa1, 0xed, 0x7b, 0x3e, 0x8b,
-	0x97, 0x92, 0x93, 0x53, 0x68, 0x8c, 0xc7, 0x57, 0x46, 0x8e, 0x5a, 0x52, 0x06, 0xae, 0xef, 0xf9,
-	0xe3, 0x35, 0xa1, 0x50, 0x97, 0x6b, 0xa4, 0x14, 0x1e, 0x1f, 0x17, 0x45, 0x9c, 0xd5, 0xe5, 0x9a,
-	0x7c, 0x01, 0x6e, 0xaa, 0xe4, 0xa0, 0x16, 0x5d, 0xef, 0xa4, 0x08, 0x0c, 0x14, 0xcf, 0x34, 0x95,
-	0x0e, 0xf1, 0x46, 0x74, 0x25, 0xa1, 0xe0, 0x62, 0xa5, 0x37, 0x92, 0x8f, 0xcd, 0x11, 0x24, 0x32,
-	0x4d, 0xa2, 0xff, 0xaa, 0x01, 0x5c, 0x29, 0xcd, 0xf5, 0x11, 0xa2, 0xd2, 0xe9, 0x7b, 0x1b, 0x96,
-	0xb8, 0x2e, 0x95, 0xe0, 0xfa, 0xa1, 0x12, 0xfc, 0x15, 0xb4, 0x16, 0xa1, 0xe0, 0xe9, 0x78, 0x8d,
-	0x26, 0xdd, 0xaf, 0x89, 0x65, 0x51, 0x91, 0x92, 0x8d, 0xd7, 0xa3, 0x20, 0x9b, 0xf3, 0xac, 0xdf,
-	0xc4, 0x64, 0x29, 0x00, 0x3a, 0x82, 0x16, 0x3e, 0x6a, 0xbc, 0x56, 0x8e, 0x92, 0x08, 0xe3, 0x9b,
-	0x8e, 0x99, 0xd9, 0x7d, 0xac, 0x3d, 0x28, 0xda, 0x63, 0xbc, 0x66, 0xfc, 0xee, 0x3e, 0x51, 0xf4,
-	0x8f, 0x18, 0x97, 0x68, 0x00, 0xcd, 0xf8, 0x18, 0x3a, 0x68, 0x9d, 0x9c, 0xb7, 0xc3, 0x0a, 0x00,
-	0xcb, 0xf7, 0xfa, 0x52, 0x4c, 0xc3, 0x09, 0xd7, 0xfe, 0x77, 0x59, 0x01, 0xd0, 0x0c, 0x73, 0x32,
-	0x17, 0x96, 0x44, 0x9b, 0x1f, 0x23, 0x8e, 0x3c, 0x85, 0x86, 0x5c, 0x67, 0xfd, 0x46, 0xa5, 0x1a,
-	0x94, 0x2d, 0xaa, 0xc8, 0x74, 0x8d, 0x39, 0xfc, 0xe7, 0x25, 0x4f, 0x37, 0x18, 0xb7, 0xcf, 0xc0,
-	0x95, 0x4a, 0x13, 0xe3, 0xf9, 0x92, 0x71, 0x50, 0xc1, 0x91, 0xc3, 0x34, 0x9d, 0xbc, 0x01, 0xb8,
-	0xc9, 0xf5, 0x36, 0xa6, 0x7c, 0x54, 0x70, 0x17, 0x36, 0x19, 0x39, 0xac, 0xc4, 0x79, 0xd1, 0x02,
-	0x77, 0x15, 0x44, 0x4b, 0x55, 0x3d, 0xda, 0xa6, 0x15, 0x66, 0xe4, 0x33, 0x80, 0xc4, 0x4b, 0xaa,
-	0x09, 0x53, 0x42, 0xb0, 0x7e, 0xc4, 0xb7, 0xd2, 0x32, 0xe8, 0xd2, 0x5e, 0x86, 0x54, 0x05, 0x55,
-	0xc5, 0xad, 0x34, 0x27, 0xe4, 0x7b, 0xfa, 0x43, 0x1d, 0x1e, 0x5c, 0xa4, 0x71, 0x30, 0x7d, 0x1b,
-	0x64, 0x3a, 0x3b, 0x3f, 0x2b, 0xa5, 0xcd, 0x71, 0x59, 0xc5, 0x91, 0x83, 0x29, 0xf3, 0xcc, 0xc6,
-	0xff, 0x4e, 0x88, 0xa0, 0x5e, 0xca, 0x0a, 0x48, 0x57, 0xc9, 0x9c, 0x84, 0x62, 0x66, 0xe2, 0xb6,
-	0x57, 0xaa, 0xb9, 0xa1, 0x98, 0x8d, 0x1c, 0x86, 0x54, 0xf2, 0xa2, 0x28, 0x06, 0xcd, 0x8a, 0x40,
-	0x6b, 0x80, 0x91, 0x53, 0xa9, 0x0f, 0x91, 0x1c, 0xaf, 0xcd, 0xd4, 0x62, 0x45, 0x9a, 0xa0, 0x56,
-	0x22, 0x15, 0x95, 0xbc, 0x84, 0x56, 0xa4, 0x33, 0x0f, 0xbb, 0x76, 0xd7, 0x7b, 0x58, 0x66, 0xb4,
-	0xaf, 0xb4, 0x3c, 0xe4, 0x05, 0xb8, 0x77, 0xca, 0xc7, 0xd8, 0xc8, 0xbb, 0xde, 0x27, 0xc5, 0x43,
-	0x73, 0xd7, 0x2b, 0xa5, 0x90, 0x87, 0x7c, 0x03, 0x6d, 0xd4, 0x8e, 0xf1, 0x04, 0x1b, 0x7b, 0xd7,
-	0xfb, 0x74, 0x8f, 0x63, 0x93, 0x68, 0x33, 0x72, 0x58, 0xce, 0x59, 0x38, 0x36, 0xb4, 0xc5, 0x5a,
-	0xa7, 0xf9, 0x4f, 0xd9, 0x17, 0x7e, 0x8d, 0x35, 0xd7, 0xde, 0xf3, 0x0c, 0x5a, 0xba, 0xa2, 0xd8,
-	0x9a, 0xbf, 0x55, 0x6f, 0x2c, 0x95, 0x0a, 0x68, 0x5d, 0x8a, 0x15, 0x46, 0xc2, 0xd3, 0xc3, 0x05,
-	0xd4, 0xc4, 0xc3, 0xd3, 0x6a, 0x3c, 0x54, 0xea, 0x61, 0x11, 0x0c, 0xba, 0x7b, 0x34, 0x6c, 0xf7,
-	0x28, 0x2c, 0xf2, 0x1a, 0xda, 0xe6, 0x3e, 0x95, 0x96, 0x6e, 0x28, 0xf9, 0xc2, 0x3e, 0xb1, 0x57,
-	0xd4, 0x7f, 0x45, 0x67, 0x9a, 0x48, 0xff, 0x51, 0x87, 0xa6, 0x6a, 0xdb, 0x3f, 0x6a, 0x46, 0x56,
-	0x25, 0x99, 0x47, 0xb7, 0x18, 0x73, 0x6d, 0x86, 0xeb, 0xed, 0xb9, 0xd9, 0x3d, 0x34, 0x37, 0x1f,
-	0x7d, 0xe4, 0xdc, 0xdc, 0xfa, 0x5f, 0x73, 0x73, 0xfb, 0x23, 0xe7, 0xe6, 0xce, 0xde, 0xb9, 0xf9,
-	0x25, 0xb4, 0x95, 0x29, 0x70, 0xfa, 0xf9, 0x05, 0xb8, 0x2a, 0xad, 0xad, 0xf5, 0xba, 0x36, 0x2e,
-	0x39, 0x4f, 0x99, 0xa6, 0x14, 0xb3, 0x02, 0x82, 0xfc, 0x4e, 0xbd, 0x34, 0xf1, 0x92, 0xf1, 0x26,
-	0xe1, 0xc6, 0x8a, 0x76, 0x4b, 0xbf, 0x82, 0x53, 0xcd, 0xfa, 0x9e, 0x4b, 0x1c, 0x90, 0x0e, 0x72,
-	0xff, 0xbb, 0x0e, 0xdd, 0xf7, 0xf1, 0x94, 0x1b, 0x66, 0x42, 0xe1, 0x98, 0x9b, 0x01, 0xaa, 0xe4,
-	0xa2, 0x0a, 0xa6, 0xc2, 0x17, 0xb5, 0x2e, 0x4d, 0xa4, 0x05, 0x50, 0x9e, 0x7d, 0x1b, 0xe8, 0xa3,
-	0xf2, 0xa0, 0x1f, 0x2f, 0xe5, 0x4d, 0xbc, 0x14, 0xd3, 0xcc, 0x7c, 0xdc, 0x14, 0x80, 0x2a, 0x76,
-	0xa1, 0x30, 0x44, 0xed, 0xc1, 0x7c, 0xaf, 0x5e, 0xa5, 0xfa, 0x57, 0x28, 0x66, 0x32, 0xb8, 0x89,
-	0xf4, 0x4c, 0xef, 0xb2, 0x0a, 0xa6, 0xa4, 0xa3, 0xad, 0x94, 0x9d, 0xd1, 0x7b, 0x2e, 0x2b, 0x00,
-	0xd5, 0xec, 0xd2, 0x40, 0xf2, 0xd0, 0xfa, 0xcd, 0xec, 0xd4, 0x6b, 0xd5, 0x2a, 0x5e, 0x4a, 0xe3,
-	0x28, 0xbb, 0x55, 0xf2, 0xd4, 0x52, 0xc6, 0x32, 0x88, 0xfa, 0xa0, 0xb5, 0xcc, 0x01, 0xfa, 0x0d,
-	0x80, 0x72, 0x45, 0xa6, 0x5b, 0xda, 0x97, 0x55, 0x0f, 0x9e, 0x96, 0x3c, 0x98, 0xa1, 0x0f, 0x8c,
-	0x1b, 0xff, 0x5e, 0x83, 0x4e, 0x0e, 0xe6, 0xe1, 0x5d, 0x2b, 0x85, 0x77, 0x0f, 0xea, 0x61, 0x62,
-	0x8c, 0x5a, 0x0f, 0x93, 0xbd, 0x43, 0xfe, 0x56, 0xe3, 0x68, 0xee, 0x36, 0x8e, 0x6a, 0xeb, 0x71,
-	0xb7, 0x5b, 0x8f, 0xf7, 0xcf, 0x16, 0x74, 0x13, 0x2f, 0x99, 0x59, 0xcf, 0xbc, 0x80, 0x6e, 0xde,
-	0x4b, 0xc6, 0x6b, 0x52, 0xe9, 0x1e, 0x03, 0xbb, 0x43, 0x55, 0xa9, 0x43, 0xbe, 0x86, 0x5e, 0xce,
-	0xac, 0x0b, 0xf1, 0x76, 0x2b, 0xd9, 0x39, 0xf2, 0x1c, 0x9a, 0xf8, 0x71, 0xb3, 0xd5, 0x4b, 0x06,
-	0xe5, 0x7d, 0x2c, 0x66, 0xd4, 0x21, 0x43, 0x68, 0xd9, 0xcf, 0x8e, 0x87, 0x05, 0xd1, 0x40, 0x65,
-	0x7e, 0xb5, 0xa7, 0x0e, 0x79, 0x03, 0x5d, 0x43, 0xc4, 0x54, 0xda, 0x73, 0x86, 0x54, 0xcf, 0x28,
-	0x36, 0xea, 0x90, 0xd7, 0xd0, 0xb2, 0x29, 0x5b, 0x3a, 0x63, 0xa0, 0xc1, 0x69, 0x05, 0x3a, 0x9f,
-	0x7c, 0xa0, 0x0e, 0xf1, 0xf2, 0xd6, 0xee, 0xed, 0x3b, 0xb2, 0x0b, 0x51, 0x87, 0xbc, 0x84, 0xee,
-	0x75, 0x7c, 0x2b, 0xed, 0x4d, 0xdb, 0xea, 0xef, 0x5a, 0xb6, 0x53, 0x7c, 0x78, 0x7c, 0x52, 0x51,
-	0x45, 0x83, 0x83, 0x07, 0x05, 0x78, 0x29, 0x56, 0xd4, 0x21, 0x67, 0x00, 0xfa, 0x0b, 0xc2, 0x57,
-	0x5f, 0x10, 0x8f, 0x2a, 0x67, 0xcc, 0x77, 0xc5, 0xee, 0xa1, 0xaf, 0xd1, 0xc8, 0xd8, 0x2a, 0xaa,
-	0x06, 0x53, 0xd0, 0xe0, 0xa4, 0x5a, 0xbd, 0x33, 0xea, 0xbc, 0xae, 0x91, 0xdf, 0xe0, 0x3d, 0xb6,
-	0x29, 0x55, 0xef, 0x31, 0x68, 0xd9, 0x04, 0x06, 0xa2, 0x0e, 0xf9, 0x2d, 0x3a, 0x28, 0xff, 0x3d,
-	0xf2, 0xb3, 0xca, 0x49, 0x0b, 0x0f, 0xf6, 0x7c, 0xd8, 0x51, 0x87, 0x7c, 0x0b, 0xa7, 0xd7, 0x3c,
-	0x5d, 0xf1, 0xf4, 0x5a, 0xa6, 0x3c, 0x58, 0x30, 0x1e, 0x4c, 0xf3, 0xab, 0x2b, 0xb3, 0x4f, 0xae,
-	0x22, 0xe3, 0x77, 0xef, 0xc3, 0x88, 0x3a, 0xcf, 0x6b, 0xe4, 0x77, 0xd5, 0xc3, 0xd7, 0x5c, 0x4c,
-	0x77, 0x1c, 0xb0, 0x57, 0x18, 0xea, 0x7b, 0x06, 0xbd, 0xb7, 0x71, 0x14, 0xf1, 0x89, 0xbc, 0x14,
-	0x98, 0xb1, 0x3b, 0x67, 0x4f, 0x4a, 0x49, 0x6e, 0x82, 0xea, 0x0d, 0x9c, 0x54, 0x0f, 0x79, 0x3b,
-	0xa7, 0x1e, 0x96, 0x4b, 0x83, 0xf1, 0xfb, 0xc5, 0xe7, 0x7f, 0xfb, 0xf9, 0x2c, 0x94, 0xf3, 0xe5,
-	0xcd, 0x70, 0x12, 0x2f, 0x5e, 0x9d, 0x9d, 0x4d, 0xc4, 0x2b, 0xfc, 0x1d, 0x75, 0x76, 0xf6, 0x0a,
-	0xb9, 0x6f, 0x8e, 0xf0, 0xbf, 0xd4, 0xd9, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x19, 0x3b,
-	0x8b, 0xde, 0x12, 0x00, 0x00,
+	// 1719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x8f, 0xdb, 0xc6,
+	0x15, 0xa7, 0x44, 0x71, 0x25, 0x3d, 0xae, 0xb5, 0xeb, 0x89, 0x1b, 0x08, 0x82, 0x9b, 0x6c, 0x07,
+	0x4e, 0xec, 0xd6, 0xb1, 0xec, 0x70, 0x53, 0x17, 0x68, 0x7a, 0xf1, 0x3a, 0xad, 0xb5, 0xe8, 0xc6,
+	0x60, 0x67, 0xd5, 0x1e, 0x7a, 0xe3, 0x4a, 0xb3, 0x5a, 0xc2, 0x14, 0xc9, 0x25, 0x47, 0x82, 0x94,
+	0x7b, 0x0f, 0x05, 0x7a, 0xeb, 0xdf, 0xd2, 0xff, 0x2a, 0x7f, 0x42, 0x0f, 0xc5, 0xbc, 0x99, 0xe1,
+	0x87, 0xbe, 0x6a, 0x34, 0xe8, 0x8d, 0xef, 0xf7, 0xde, 0x7c, 0xbc, 0xef, 0x37, 0x84, 0x6e, 0xea,
+	0xa5, 0xc3, 0x34, 0x4b, 0x44, 0x42, 0x1c, 0xb1, 0x4e, 0x79, 0x3e, 0x78, 0x28, 0xb2, 0x20, 0xce,
+	0x83, 0x89, 0x08, 0x93, 0x58, 0x71, 0x06, 0xc7, 0x93, 0x64, 0x3e, 0x2f, 0xa8, 0xd3, 0x9b, 0x28,
+	0x99, 0x7c, 0x98, 0xdc, 0x05, 0xa1, 0x46, 0xe8, 0xaf, 0xa0, 0xe7, 0x7b, 0xfe, 0x3b, 0x2e, 0x7c,
+	0xce, 0xb3, 0xcb, 0xf8, 0x36, 0x21, 0x7d, 0x68, 0x2f, 0x79, 0x96, 0x87, 0x49, 0xdc, 0x6f, 0x9c,
+	0x35, 0x9e, 0x39, 0xcc, 0x90, 0xf4, 0xdf, 0x0d, 0x70, 0x7d, 0xcf, 0x2f, 0x24, 0x09, 0xb4, 0x82,
+	0xe9, 0x34, 0x43, 0xb1, 0x2e, 0xc3, 0x6f, 0x89, 0xa5, 0x49, 0x26, 0xfa, 0x4d, 0x5c, 0x8a, 0xdf,
+	0x12, 0x8b, 0x83, 0x39, 0xef, 0xdb, 0x4a, 0x4e, 0x7e, 0x93, 0x33, 0x70, 0xe7, 0x7c, 0x9e, 0x26,
+	0x49, 0x74, 0x1d, 0xfe, 0xc0, 0xfb, 0x2d, 0x14, 0xaf, 0x42, 0xe4, 0x0b, 0x38, 0xba, 0xe3, 0xc1,
+	0x94, 0x67, 0x7d, 0xe7, 0xac, 0xf1, 0xcc, 0xf5, 0x1e, 0x0c, 0x51, 0xc9, 0xe1, 0x08, 0x41, 0xa6,
+	0x99, 0xd5, 0xeb, 0x1e, 0xe1, 0xfe, 0x86, 0x24, 0x5f, 0x42, 0x2f, 0x4a, 0x26, 0x41, 0xf4, 0xdd,
+	0xc5, 0x5f, 0xb4, 0x40, 0x1b, 0x05, 0x36, 0x50, 0x29, 0x97, 0x8b, 0x24, 0xe3, 0xa5, 0x5c, 0x47,
+	0xc9, 0xd5, 0x51, 0xfa, 0x63, 0x03, 0xc0, 0xf7, 0x7c, 0xb3, 0x6c, 0xaf, 0x9d, 0x24, 0x27, 0xe7,
+	0xd9, 0x32, 0x9c, 0x70, 0x34, 0x83, 0xcd, 0x0c, 0x49, 0x1e, 0x43, 0x57, 0x84, 0x73, 0x9e, 0x8b,
+	0x60, 0x9e, 0xa2, 0x39, 0x6c, 0x56, 0x02, 0x64, 0x00, 0x1d, 0x69, 0x43, 0xc6, 0x27, 0x4b, 0x34,
+	0x48, 0x97, 0x15, 0xb4, 0xe1, 0xfd, 0x21, 0x4b, 0xe6, 0x7d, 0xa7, 0xe4, 0x49, 0x9a, 0x3c, 0x02,
+	0x27, 0x4e, 0xe2, 0x09, 0x47, 0x03, 0xd8, 0x4c, 0x11, 0xf2, 0xac, 0x45, 0xce, 0xb3, 0x37, 0x33,
+	0x1e, 0x0b, 0xad, 0x79, 0x09, 0x48, 0xfb, 0xe7, 0x22, 0xc8, 0xc4, 0x88, 0x87, 0xb3, 0x3b, 0x81,
+	0x1a, 0xdb, 0xac, 0x0a, 0xd1, 0x3f, 0x43, 0x57, 0x69, 0xfb, 0x66, 0xf2, 0xe1, 0x7f, 0x52, 0xb6,
+	0xb8, 0x96, 0x5d, 0xb9, 0x16, 0x9d, 0x43, 0x5b, 0xc6, 0x50, 0x18, 0xcf, 0x4a, 0x81, 0x46, 0xf5,
+	0xde, 0x26, 0xaa, 0x9a, 0x3b, 0xa2, 0xca, 0xae, 0x44, 0xd5, 0x13, 0x68, 0xe5, 0xe1, 0x2c, 0x46,
+	0x4b, 0xb9, 0xde, 0xa9, 0x8e, 0x8e, 0xeb, 0x70, 0x16, 0x07, 0x62, 0x91, 0x71, 0x86, 0x5c, 0xfa,
+	0xb9, 0x3a, 0x2e, 0xd9, 0x77, 0x1c, 0xa5, 0xe8, 0xd4, 0x77, 0x5c, 0xbc, 0x91, 0x07, 0xed, 0x96,
+	0xf9, 0x16, 0x37, 0xd9, 0x2f, 0x60, 0xbc, 0x13, 0x85, 0xb9, 0x8c, 0x7c, 0xdb, 0x78, 0x47, 0xd2,
+	0xf4, 0x1a, 0x5c, 0xbd, 0xf8, 0x2a, 0xcc, 0xc5, 0x9e, 0x0d, 0x86, 0xd0, 0x49, 0x39, 0xcf, 0xc2,
+	0xf8, 0x36, 0xc1, 0x0d, 0x5c, 0x8f, 0x68, 0x85, 0x2a, 0x09, 0xc7, 0x0a, 0x19, 0xfa, 0x16, 0x4e,
+	0x7c, 0xcf, 0xff, 0xfd, 0x4a, 0xf0, 0x2c, 0x0e, 0xa2, 0xbd, 0xd9, 0xf8, 0x18, 0xba, 0x61, 0x9e,
+	0x2c, 0x44, 0x1e, 0x4e, 0x95, 0x7b, 0x3a, 0xac, 0x04, 0xe8, 0x1d, 0x1c, 0x2b, 0xd5, 0x2f, 0x64,
+	0x55, 0xc8, 0x0f, 0x38, 0x79, 0x23, 0x5a, 0x9a, 0x5b, 0xd1, 0x22, 0x4f, 0xe2, 0xf1, 0x54, 0xf3,
+	0x75, 0x64, 0x17, 0x00, 0xfd, 0x25, 0x3c, 0x50, 0x27, 0x7d, 0xaf, 0x12, 0xfc, 0x40, 0x91, 0x19,
+	0xc2, 0x91, 0xef, 0xf9, 0x97, 0xf1, 0x52, 0x3a, 0x38, 0x8c, 0x97, 0x79, 0xbf, 0x71, 0x66, 0x57,
+	0x1c, 0x7c, 0x19, 0x2f, 0x79, 0x2c, 0x92, 0x6c, 0xcd, 0x90, 0x4b, 0xdf, 0x41, 0xb7, 0x80, 0x48,
+	0x0f, 0x9a, 0x62, 0xad, 0x77, 0x6c, 0x8a, 0xb5, 0xb4, 0xc9, 0x5d, 0x90, 0xdf, 0xe1, 0x85, 0x8f,
+	0x19, 0x7e, 0x93, 0x4f, 0x65, 0x5d, 0xa9, 0x5c, 0x53, 0x53, 0xf4, 0xca, 0x04, 0xc2, 0x77, 0x81,
+	0x08, 0x0e, 0xd8, 0xc2, 0x5c, 0xab, 0x79, 0xf0, 0x5a, 0x8f, 0xa1, 0xe3, 0x7b, 0x3e, 0x4b, 0x16,
+	0x82, 0x93, 0x53, 0xb0, 0xc7, 0xe3, 0x2b, 0xbd, 0x8f, 0xfc, 0xa4, 0x0c, 0x1c, 0xdf, 0xf3, 0xc7,
+	0x2b, 0x42, 0xa1, 0x29, 0x56, 0xc8, 0x29, 0x3d, 0x3e, 0x2e, 0x8b, 0x38, 0x6b, 0x8a, 0x15, 0xf9,
+	0x02, 0x9c, 0x4c, 0xee, 0x83, 0x5a, 0xb8, 0xde, 0x49, 0x19, 0x18, 0xb8, 0x3d, 0x53, 0x5c, 0xfa,
+	0x3d, 0xda, 0x78, 0xbc, 0x62, 0x3c, 0x8d, 0x82, 0x09, 0x9f, 0x4a, 0x15, 0x92, 0x68, 0x3a, 0x92,
+	0xfa, 0x37, 0x50, 0x7f, 0x43, 0xea, 0x53, 0x9b, 0x87, 0x4e, 0xa5, 0x43, 0x54, 0x00, 0x23, 0x83,
+	0x50, 0x70, 0xb0, 0x71, 0xe8, 0x8b, 0x1e, 0xeb, 0x25, 0xc8, 0x64, 0x8a, 0x45, 0xff, 0xd9, 0x00,
+	0xb8, 0x92, 0x86, 0x54, 0x4b, 0x88, 0xcc, 0xce, 0x1f, 0x4c, 0x94, 0xb7, 0xf2, 0x7a, 0x45, 0x6f,
+	0x1e, 0xaa, 0xe8, 0x5f, 0x41, 0x7b, 0x1e, 0xc6, 0x3c, 0x1b, 0xaf, 0xfa, 0xf6, 0xde, 0x2b, 0x1a,
+	0x11, 0x19, 0x78, 0xf9, 0x78, 0x25, 0xd5, 0xe2, 0x79, 0xbf, 0x85, 0xb9, 0x57, 0x02, 0x74, 0x04,
+	0x6d, 0xbc, 0xd4, 0x78, 0x25, 0xfd, 0x2e, 0x56, 0x15, 0x6b, 0x68, 0xea, 0x63, 0xcd, 0x4b, 0xa1,
+	0xa3, 0xcd, 0x7b, 0xbf, 0x6f, 0x2b, 0xfa, 0x47, 0x74, 0x01, 0x1a, 0x40, 0x09, 0x3e, 0x86, 0x2e,
+	0x5a, 0xa7, 0x90, 0xed, 0xb2, 0x12, 0x90, 0x5c, 0xb1, 0xba, 0x8c, 0xa7, 0xe1, 0x84, 0xab, 0x70,
+	0x72, 0x58, 0x09, 0xd0, 0x1c, 0x4e, 0xaa, 0x9b, 0xa5, 0xd1, 0xfa, 0xa7, 0x6c, 0x47, 0x9e, 0x80,
+	0x2d, 0x56, 0x79, 0xdf, 0x3e, 0xb3, 0xf7, 0x58, 0x54, 0xb2, 0xe9, 0x0a, 0x4b, 0xc2, 0x9f, 0x16,
+	0x3c, 0x5b, 0x63, 0x1a, 0x3c, 0x05, 0x47, 0x48, 0x4d, 0xfa, 0x8d, 0x4d, 0xe3, 0xa0, 0x82, 0x23,
+	0x8b, 0x29, 0x3e, 0x79, 0x0d, 0x70, 0x53, 0xe8, 0xad, 0x4d, 0xf9, 0xa8, 0x94, 0x2e, 0x6d, 0x32,
+	0xb2, 0x58, 0x45, 0xf2, 0xa2, 0x0d, 0xce, 0x32, 0x88, 0x16, 0xb2, 0x18, 0x75, 0x74, 0x67, 0xcd,
+	0xc9, 0x67, 0x00, 0xa9, 0x97, 0xd6, 0xf3, 0xaf, 0x82, 0x60, 0x39, 0x4a, 0x6e, 0x85, 0x11, 0x50,
+	0x9d, 0xa2, 0x0a, 0xc9, 0x82, 0x2c, 0x6b, 0x65, 0x65, 0xec, 0x28, 0x68, 0xfa, 0x63, 0x13, 0x1e,
+	0x5c, 0x64, 0x49, 0x30, 0x7d, 0x1b, 0xe4, 0x2a, 0xd9, 0x3f, 0xab, 0x64, 0xe1, 0x71, 0x55, 0xc5,
+	0x91, 0x85, 0x19, 0xf8, 0xd4, 0xc4, 0xff, 0x56, 0x88, 0xa0, 0x5e, 0xd2, 0x0a, 0xc8, 0x97, 0xb5,
+	0x21, 0x0d, 0xe3, 0x99, 0x8e, 0xdb, 0x5e, 0x29, 0x27, 0xfb, 0xdd, 0xc8, 0x62, 0xc8, 0x25, 0xcf,
+	0xcb, 0xda, 0xd2, 0xaa, 0x6d, 0x68, 0x0c, 0x30, 0xb2, 0x6a, 0xe5, 0x26, 0x12, 0xe3, 0x55, 0xdf,
+	0xa9, 0x6d, 0xa9, 0x83, 0x5a, 0x6e, 0x29, 0xb9, 0xe4, 0x05, 0xb4, 0x23, 0x95, 0x79, 0x38, 0x04,
+	0xb8, 0xde, 0xc3, 0xaa, 0xa0, 0xb9, 0xa5, 0x91, 0x21, 0xcf, 0xc1, 0xb9, 0x97, 0x3e, 0xc6, 0xb9,
+	0xc0, 0xf5, 0x3e, 0x29, 0x2f, 0x5a, 0xb8, 0x5e, 0x2a, 0x85, 0x32, 0xe4, 0x1b, 0xe8, 0xa0, 0x76,
+	0x8c, 0xa7, 0x38, 0x27, 0xb8, 0xde, 0xa7, 0x3b, 0x1c, 0x9b, 0x46, 0xeb, 0x91, 0xc5, 0x0a, 0xc9,
+	0xd2, 0xb1, 0xa1, 0xa9, 0xfd, 0x2a, 0xcd, 0xff, 0x9f, 0x6d, 0xe6, 0xd7, 0x58, 0xc2, 0xcd, 0x39,
+	0x4f, 0xa1, 0xad, 0x2a, 0x8a, 0x69, 0x21, 0x1b, 0xf5, 0xc6, 0x70, 0x69, 0x0c, 0xed, 0xcb, 0x78,
+	0x89, 0x91, 0xf0, 0xe4, 0x70, 0x3d, 0xd6, 0xf1, 0xf0, 0xa4, 0x1e, 0x0f, 0xb5, 0x7a, 0x58, 0x06,
+	0x83, 0x6a, 0x46, 0xb6, 0x69, 0x46, 0xa5, 0x45, 0x5e, 0x41, 0x47, 0x9f, 0x27, 0xd3, 0xd2, 0x09,
+	0x05, 0x9f, 0x9b, 0x2b, 0xf6, 0xca, 0x76, 0x22, 0xf9, 0x4c, 0x31, 0xe9, 0xdf, 0x9b, 0xd0, 0x92,
+	0x53, 0xc0, 0x4f, 0x1a, 0xb9, 0x65, 0x49, 0xe6, 0xd1, 0x2d, 0xc6, 0x5c, 0x87, 0xe1, 0xf7, 0xe6,
+	0x18, 0xee, 0x1c, 0x1a, 0xc3, 0x8f, 0x3e, 0x72, 0x0c, 0x6f, 0xff, 0xb7, 0x31, 0xbc, 0xf3, 0x91,
+	0x63, 0x78, 0x77, 0xe7, 0x18, 0xfe, 0x02, 0x3a, 0xd2, 0x14, 0x38, 0x4c, 0xfd, 0x02, 0x1c, 0x99,
+	0xd6, 0xc6, 0x7a, 0xae, 0x89, 0x4b, 0xce, 0x33, 0xa6, 0x38, 0xe5, 0xe8, 0x81, 0x20, 0xbf, 0x97,
+	0x37, 0x4d, 0xbd, 0x74, 0xbc, 0x4e, 0xb9, 0xb6, 0xa2, 0x21, 0xe9, 0x57, 0x70, 0xaa, 0x44, 0xdf,
+	0x73, 0x81, 0xf3, 0xd6, 0x41, 0xe9, 0x7f, 0x35, 0xc1, 0x7d, 0x9f, 0x4c, 0xb9, 0x16, 0x26, 0x14,
+	0x8e, 0xb9, 0x9e, 0xc7, 0x2a, 0x2e, 0xaa, 0x61, 0x32, 0x7c, 0x51, 0xeb, 0xca, 0x80, 0x5b, 0x02,
+	0xd5, 0x51, 0xda, 0x46, 0x1f, 0x55, 0xdf, 0x0d, 0xc9, 0x42, 0xdc, 0x24, 0x8b, 0x78, 0x9a, 0xeb,
+	0xb7, 0x52, 0x09, 0xc8, 0x62, 0x17, 0xc6, 0x9a, 0xa9, 0x3c, 0x58, 0xd0, 0xf2, 0x56, 0xb2, 0x7f,
+	0x85, 0xf1, 0x4c, 0x04, 0x37, 0x91, 0x7a, 0x22, 0x38, 0xac, 0x86, 0xc9, 0xdd, 0xd1, 0x56, 0xd2,
+	0xce, 0xe8, 0x3d, 0x87, 0x95, 0x80, 0x6c, 0x76, 0x59, 0x20, 0x78, 0x68, 0xfc, 0xa6, 0x29, 0x79,
+	0x5b, 0xf9, 0x95, 0x2c, 0x84, 0x76, 0x94, 0x21, 0xe5, 0x7e, 0xf2, 0x53, 0x24, 0x22, 0x88, 0xfa,
+	0xa0, 0xb4, 0x2c, 0x00, 0xfa, 0x0d, 0x80, 0x74, 0x45, 0xae, 0x5a, 0xda, 0x97, 0x75, 0x0f, 0x9e,
+	0x56, 0x3c, 0x98, 0xa3, 0x0f, 0xb4, 0x1b, 0xff, 0xd6, 0x80, 0x6e, 0x01, 0x16, 0xe1, 0xdd, 0xa8,
+	0x84, 0x77, 0x0f, 0x9a, 0x61, 0xaa, 0x8d, 0xda, 0x0c, 0xd3, 0x9d, 0x6f, 0x86, 0x8d, 0xc6, 0xd1,
+	0xda, 0x6e, 0x1c, 0xf5, 0xd6, 0xe3, 0x6c, 0xb6, 0x1e, 0xef, 0x1f, 0x6d, 0x70, 0x53, 0x2f, 0x9d,
+	0x19, 0xcf, 0x3c, 0x07, 0xb7, 0xe8, 0x25, 0xe3, 0x15, 0xa9, 0x75, 0x8f, 0x81, 0xa1, 0x50, 0x55,
+	0x6a, 0x91, 0xaf, 0xa1, 0x57, 0x08, 0xab, 0x42, 0xbc, 0xd9, 0x4a, 0xb6, 0x96, 0x3c, 0x83, 0x16,
+	0xbe, 0x95, 0x36, 0x7a, 0xc9, 0xa0, 0x4a, 0x27, 0xf1, 0x8c, 0x5a, 0x64, 0x08, 0x6d, 0xf3, 0x8a,
+	0x79, 0x58, 0x32, 0x35, 0x54, 0x95, 0x97, 0x34, 0xb5, 0xc8, 0x6b, 0x70, 0x35, 0x13, 0x53, 0x69,
+	0xc7, 0x1a, 0x52, 0x5f, 0x23, 0xc5, 0xa8, 0x45, 0x5e, 0x41, 0xdb, 0xa4, 0x6c, 0x65, 0x8d, 0x86,
+	0x06, 0xa7, 0x35, 0xe8, 0xcd, 0xe4, 0x03, 0xb5, 0x88, 0x57, 0xb4, 0x76, 0x6f, 0xd7, 0x92, 0x6d,
+	0x88, 0x5a, 0xe4, 0x05, 0xb8, 0xd7, 0xc9, 0xad, 0x30, 0x27, 0x6d, 0xaa, 0xbf, 0x6d, 0xd9, 0x6e,
+	0xf9, 0x8e, 0xf9, 0xa4, 0xa6, 0x8a, 0x02, 0x07, 0x0f, 0x4a, 0xf0, 0x32, 0x5e, 0x52, 0x8b, 0x9c,
+	0x03, 0xa8, 0x07, 0x89, 0x2f, 0x1f, 0x24, 0x8f, 0x6a, 0x6b, 0xf4, 0x33, 0x65, 0x7b, 0xd1, 0xd7,
+	0x68, 0x64, 0x6c, 0x15, 0x75, 0x83, 0x49, 0x68, 0x70, 0x52, 0xaf, 0xde, 0x39, 0xb5, 0x5e, 0x35,
+	0xc8, 0x6f, 0xf0, 0x1c, 0xd3, 0x94, 0xea, 0xe7, 0x68, 0xb4, 0x6a, 0x02, 0x0d, 0x51, 0x8b, 0xfc,
+	0x16, 0x1d, 0x54, 0xfc, 0x6d, 0xf9, 0x59, 0x6d, 0xa5, 0x81, 0x07, 0x3b, 0xde, 0x89, 0xd4, 0x22,
+	0xdf, 0xc2, 0xe9, 0x35, 0xcf, 0x96, 0x3c, 0xbb, 0x16, 0x19, 0x0f, 0xe6, 0x8c, 0x07, 0xd3, 0xe2,
+	0xe8, 0xda, 0xec, 0x53, 0xa8, 0xc8, 0xf8, 0xfd, 0xfb, 0x30, 0xa2, 0xd6, 0xb3, 0x06, 0xf9, 0x5d,
+	0x7d, 0xf1, 0x35, 0x8f, 0xa7, 0x5b, 0x0e, 0xd8, 0xb9, 0x19, 0xea, 0x7b, 0x0e, 0xbd, 0xb7, 0x49,
+	0x14, 0xf1, 0x89, 0xb8, 0x8c, 0x31, 0x63, 0xb7, 0xd6, 0x9e, 0x54, 0x92, 0x5c, 0x07, 0xd5, 0x6b,
+	0x38, 0xa9, 0x2f, 0xf2, 0xb6, 0x56, 0x3d, 0xac, 0xac, 0xca, 0xb5, 0xdf, 0x2f, 0x3e, 0xff, 0xeb,
+	0xcf, 0x67, 0xa1, 0xb8, 0x5b, 0xdc, 0x0c, 0x27, 0xc9, 0xfc, 0xe5, 0xf9, 0xf9, 0x24, 0x7e, 0x89,
+	0x7f, 0xb7, 0xce, 0xcf, 0x5f, 0xa2, 0xf4, 0xcd, 0x11, 0xfe, 0xe6, 0x3a, 0xff, 0xcf, 0x00, 0x5f,
+	0x3e, 0xde, 0x21, 0x2d, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
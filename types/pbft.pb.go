@@ -305,10 +305,13 @@ type Request struct {
 	//	*Request_Viewchange
 	//	*Request_Ack
 	//	*Request_Newview
-	Value                isRequest_Value `protobuf_oneof:"value"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Value isRequest_Value `protobuf_oneof:"value"`
+	// 发送消息的共识节点, 使用该节点的私钥对消息签名
+	Sender               uint32     `protobuf:"varint,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	Signature            *Signature `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -451,6 +454,20 @@ func (m *Request) GetNewview() *RequestNewView {
 	return nil
 }
 
+func (m *Request) GetSender() uint32 {
+	if m != nil {
+		return m.Sender
+	}
+	return 0
+}
+
+func (m *Request) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Request) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -646,10 +663,13 @@ func (m *RequestPrepare) GetReplica() uint32 {
 	return 0
 }
 
+// blockSign 对区块哈希的签名, 2f+1个commit的签名组成区块的提交证书
 type RequestCommit struct {
 	View                 uint32   `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
 	Sequence             uint32   `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Replica              uint32   `protobuf:"varint,3,opt,name=replica,proto3" json:"replica,omitempty"`
+	Digest               []byte   `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	BlockSign            []byte   `protobuf:"bytes,5,opt,name=blockSign,proto3" json:"blockSign,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -701,6 +721,20 @@ func (m *RequestCommit) GetReplica() uint32 {
 	return 0
 }
 
+func (m *RequestCommit) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *RequestCommit) GetBlockSign() []byte {
+	if m != nil {
+		return m.BlockSign
+	}
+	return nil
+}
+
 type RequestCheckpoint struct {
 	Sequence             uint32   `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Digest               []byte   `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
@@ -757,15 +791,17 @@ func (m *RequestCheckpoint) GetReplica() uint32 {
 }
 
 type RequestViewChange struct {
-	View                 uint32        `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
-	Sequence             uint32        `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Checkpoints          []*Checkpoint `protobuf:"bytes,3,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
-	Preps                []*Entry      `protobuf:"bytes,4,rep,name=preps,proto3" json:"preps,omitempty"`
-	Prepreps             []*Entry      `protobuf:"bytes,5,rep,name=prepreps,proto3" json:"prepreps,omitempty"`
-	Replica              uint32        `protobuf:"varint,6,opt,name=replica,proto3" json:"replica,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	View        uint32        `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	Sequence    uint32        `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Checkpoints []*Checkpoint `protobuf:"bytes,3,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	Preps       []*Entry      `protobuf:"bytes,4,rep,name=preps,proto3" json:"preps,omitempty"`
+	Prepreps    []*Entry      `protobuf:"bytes,5,rep,name=prepreps,proto3" json:"prepreps,omitempty"`
+	Replica     uint32        `protobuf:"varint,6,opt,name=replica,proto3" json:"replica,omitempty"`
+	// preps中每个区块的签名preprepare和2f个prepare
+	Proofs               []*Request `protobuf:"bytes,7,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *RequestViewChange) Reset()         { *m = RequestViewChange{} }
@@ -835,6 +871,13 @@ func (m *RequestViewChange) GetReplica() uint32 {
 	return 0
 }
 
+func (m *RequestViewChange) GetProofs() []*Request {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type RequestAck struct {
 	View                 uint32   `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
 	Replica              uint32   `protobuf:"varint,2,opt,name=replica,proto3" json:"replica,omitempty"`
@@ -899,13 +942,15 @@ func (m *RequestAck) GetDigest() []byte {
 }
 
 type RequestNewView struct {
-	View                 uint32        `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
-	Viewchanges          []*ViewChange `protobuf:"bytes,2,rep,name=viewchanges,proto3" json:"viewchanges,omitempty"`
-	Summaries            []*Summary    `protobuf:"bytes,4,rep,name=summaries,proto3" json:"summaries,omitempty"`
-	Replica              uint32        `protobuf:"varint,5,opt,name=replica,proto3" json:"replica,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	View        uint32        `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	Viewchanges []*ViewChange `protobuf:"bytes,2,rep,name=viewchanges,proto3" json:"viewchanges,omitempty"`
+	Summaries   []*Summary    `protobuf:"bytes,4,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	Replica     uint32        `protobuf:"varint,5,opt,name=replica,proto3" json:"replica,omitempty"`
+	// 2f+1个节点签名的viewchange消息, 用于验证summaries
+	Proofs               []*Request `protobuf:"bytes,6,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *RequestNewView) Reset()         { *m = RequestNewView{} }
@@ -961,6 +1006,13 @@ func (m *RequestNewView) GetReplica() uint32 {
 	return 0
 }
 
+func (m *RequestNewView) GetProofs() []*Request {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type ClientReply struct {
 	View                 uint32   `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
 	Timestamp            string   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -1056,48 +1108,53 @@ func init() {
 }
 
 var fileDescriptor_6cc19f28ccff0670 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5f, 0x6b, 0xdb, 0x30,
-	0x10, 0xaf, 0xe3, 0xc4, 0x69, 0x2e, 0x4d, 0x69, 0xc5, 0x36, 0x44, 0xd9, 0x58, 0x30, 0x14, 0xfa,
-	0x50, 0x12, 0x56, 0xbf, 0x0d, 0x06, 0x5b, 0xcb, 0x46, 0x9f, 0xd6, 0xa2, 0xc1, 0x60, 0x7b, 0x73,
-	0x34, 0x2d, 0x11, 0x89, 0xff, 0xcc, 0x92, 0x1b, 0xfa, 0x4d, 0xf6, 0xba, 0xcf, 0xb0, 0x2f, 0xb4,
-	0x8f, 0x32, 0x24, 0x2b, 0x96, 0xb5, 0xba, 0x65, 0xed, 0x60, 0x90, 0x07, 0x4b, 0x77, 0x3f, 0xdd,
-	0xe9, 0xee, 0x77, 0x3f, 0x05, 0x20, 0x9f, 0x7d, 0x95, 0x93, 0xbc, 0xc8, 0x64, 0x86, 0x7a, 0xf2,
-	0x3a, 0x67, 0xe2, 0x60, 0x6f, 0xb6, 0xca, 0xe8, 0x92, 0x2e, 0x62, 0x9e, 0x56, 0x86, 0x70, 0x0a,
-	0x83, 0x8b, 0x9c, 0x15, 0xb1, 0xe4, 0x59, 0x8a, 0x42, 0xe8, 0x5d, 0xc5, 0xab, 0x92, 0x61, 0x6f,
-	0xec, 0x1d, 0x0d, 0x4f, 0x76, 0x26, 0x1a, 0x35, 0x39, 0x55, 0x20, 0x52, 0x99, 0xc2, 0xd7, 0x00,
-	0x67, 0x0b, 0x46, 0x97, 0x79, 0xc6, 0x53, 0x89, 0x0e, 0x60, 0x5b, 0xb0, 0x6f, 0x25, 0x4b, 0x69,
-	0x05, 0x1a, 0x91, 0x7a, 0x8d, 0x9e, 0x40, 0xf0, 0x85, 0xcf, 0x99, 0x90, 0xb8, 0x33, 0xf6, 0x8e,
-	0x76, 0x88, 0x59, 0x85, 0x17, 0xd0, 0x7b, 0x9b, 0xca, 0xe2, 0xfa, 0x21, 0x60, 0x84, 0xa0, 0x7b,
-	0xc5, 0xd9, 0x1a, 0xfb, 0xda, 0x5f, 0x7f, 0x87, 0xef, 0x00, 0x3e, 0x72, 0xb6, 0x3e, 0x5b, 0xc4,
-	0xe9, 0x9c, 0xa1, 0x31, 0x0c, 0xd5, 0x2e, 0xd5, 0xab, 0xc2, 0x1c, 0xdc, 0xdc, 0xba, 0x35, 0xb1,
-	0x57, 0xd0, 0xff, 0x50, 0x26, 0x49, 0xfc, 0xb0, 0xd4, 0xc2, 0x63, 0x08, 0x08, 0x13, 0xe5, 0x4a,
-	0xfe, 0x55, 0x1d, 0x7f, 0xfa, 0xd0, 0x27, 0xea, 0x48, 0x21, 0xd1, 0x04, 0x02, 0xba, 0xe2, 0x2c,
-	0x95, 0x06, 0xf0, 0xc8, 0x00, 0x8c, 0xfd, 0x4c, 0xdb, 0xce, 0xb7, 0x88, 0xf1, 0x42, 0x2f, 0x01,
-	0xf2, 0x82, 0xa9, 0x5f, 0x5c, 0x30, 0x9d, 0xc5, 0xf0, 0x04, 0xbb, 0x98, 0xcb, 0x82, 0x5d, 0x56,
-	0xf6, 0xf3, 0x2d, 0xd2, 0xf0, 0x46, 0x2f, 0xa0, 0xbf, 0x01, 0xfa, 0x1a, 0xf8, 0xf8, 0x06, 0xd0,
-	0xa0, 0x36, 0x7e, 0x3a, 0xbd, 0x2c, 0x49, 0xb8, 0xc4, 0xdd, 0xd6, 0xf4, 0xb4, 0x4d, 0xa7, 0xa7,
-	0xbf, 0x54, 0x7a, 0xb4, 0xa6, 0x08, 0xee, 0xb5, 0xa5, 0x67, 0x29, 0xa4, 0xd2, 0xb3, 0xde, 0x0a,
-	0x6b, 0x5b, 0x85, 0x83, 0x36, 0xac, 0xed, 0xb5, 0xc2, 0x5a, 0x6f, 0x74, 0x08, 0x7e, 0x4c, 0x97,
-	0xb8, 0xaf, 0x41, 0xfb, 0x2e, 0xe8, 0x0d, 0x5d, 0x9e, 0x6f, 0x11, 0x65, 0x57, 0x15, 0x48, 0xd9,
-	0x5a, 0xb3, 0x68, 0xbb, 0xad, 0x02, 0xef, 0xd9, 0x5a, 0x85, 0x50, 0x15, 0x30, 0x7e, 0xa7, 0x7d,
-	0xd3, 0xd0, 0x70, 0x0e, 0x23, 0xa7, 0x29, 0x68, 0x0c, 0x9d, 0x2c, 0x37, 0x6d, 0xdb, 0x33, 0xe7,
-	0xd4, 0x03, 0x45, 0x3a, 0x59, 0x8e, 0x9e, 0xc2, 0x40, 0xf2, 0x84, 0x09, 0x19, 0x27, 0xb9, 0xee,
-	0xd5, 0x80, 0xd8, 0x0d, 0x45, 0x26, 0xd3, 0x7a, 0x5f, 0x9b, 0xcc, 0x2a, 0x2c, 0x61, 0xff, 0x46,
-	0x27, 0x6b, 0xf2, 0x7b, 0x96, 0xfc, 0x0e, 0x53, 0x3b, 0xb7, 0x32, 0xd5, 0x77, 0x86, 0x08, 0x43,
-	0xbf, 0x60, 0xf9, 0x8a, 0xd3, 0x58, 0x77, 0x74, 0x44, 0x36, 0xcb, 0xb0, 0x80, 0x5d, 0x97, 0x07,
-	0xff, 0x21, 0xe6, 0x27, 0x5b, 0xd3, 0x8a, 0x3f, 0xf7, 0x0d, 0xd9, 0x38, 0xda, 0x77, 0x8f, 0x8e,
-	0xeb, 0x2a, 0xfe, 0x9b, 0x66, 0xdd, 0x11, 0xe2, 0x97, 0x57, 0xc7, 0x68, 0x88, 0xd0, 0x7d, 0xaf,
-	0x10, 0xc1, 0xd0, 0x0e, 0x81, 0xc0, 0xfe, 0xd8, 0x6f, 0x50, 0xd8, 0xe6, 0x4e, 0x9a, 0x5e, 0x4a,
-	0x66, 0xd4, 0x88, 0x0a, 0xdc, 0xd5, 0xee, 0x1b, 0x99, 0xd1, 0xe2, 0x4a, 0x2a, 0x13, 0x3a, 0x82,
-	0x6d, 0x33, 0xfc, 0x02, 0xf7, 0x5a, 0xdc, 0x6a, 0x6b, 0xf3, 0x8a, 0x81, 0x7b, 0x45, 0x09, 0x60,
-	0xa7, 0xa8, 0xf5, 0x6a, 0x0d, 0x6c, 0xc7, 0xc1, 0xfe, 0xa9, 0xc6, 0xfe, 0x5d, 0x6a, 0xdc, 0x75,
-	0xe4, 0xf4, 0x87, 0x57, 0x73, 0xd1, 0x4c, 0x64, 0x6b, 0xe8, 0xa8, 0x19, 0x40, 0xe0, 0x8e, 0x53,
-	0x39, 0xdb, 0x91, 0x66, 0x4c, 0x81, 0x8e, 0x61, 0x20, 0xb4, 0xd2, 0x73, 0xb6, 0xa9, 0xde, 0xae,
-	0x81, 0x98, 0x17, 0x80, 0x58, 0x87, 0xe6, 0xed, 0x7a, 0x6e, 0x65, 0xbe, 0x7b, 0x30, 0xac, 0x84,
-	0x80, 0xb0, 0x7c, 0x75, 0xdd, 0x9a, 0xe0, 0x83, 0xe6, 0xff, 0xf6, 0x71, 0x41, 0x87, 0x10, 0x14,
-	0xfa, 0x99, 0x31, 0xca, 0x3a, 0xaa, 0xd5, 0x4b, 0x6d, 0x12, 0x63, 0x3c, 0x7d, 0xfe, 0xf9, 0xd9,
-	0x9c, 0xcb, 0x45, 0x39, 0x9b, 0xd0, 0x2c, 0x99, 0x46, 0x11, 0x4d, 0xa7, 0xfa, 0xdd, 0x8f, 0xa2,
-	0xa9, 0xf6, 0x9f, 0x05, 0xfa, 0x0f, 0x40, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x03, 0x07, 0x21,
-	0x7a, 0x27, 0x08, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6a, 0xdb, 0x4a,
+	0x14, 0x8e, 0x2c, 0x5b, 0x8e, 0x8f, 0xe3, 0x10, 0x0f, 0xf7, 0x5e, 0x86, 0x90, 0x4b, 0x8d, 0x20,
+	0x25, 0x8b, 0x60, 0xd3, 0x68, 0x57, 0x28, 0xb4, 0x09, 0x2d, 0x59, 0x35, 0x61, 0x02, 0x5d, 0x74,
+	0x27, 0x2b, 0x13, 0x5b, 0xd8, 0xfa, 0xe9, 0xcc, 0x28, 0x26, 0x6f, 0xd0, 0x5d, 0xb7, 0x7d, 0xa5,
+	0x3e, 0x53, 0x37, 0x65, 0x46, 0x63, 0x8d, 0xa6, 0x91, 0x43, 0xe3, 0x45, 0xc1, 0x0b, 0xcf, 0xf9,
+	0xfd, 0xe6, 0x9c, 0xef, 0x9c, 0x11, 0x40, 0x3e, 0xbd, 0x13, 0xe3, 0x9c, 0x65, 0x22, 0x43, 0x1d,
+	0xf1, 0x90, 0x53, 0x7e, 0x78, 0x30, 0x5d, 0x66, 0xd1, 0x22, 0x9a, 0x87, 0x71, 0x5a, 0x2a, 0x0e,
+	0x87, 0x82, 0x85, 0x29, 0x0f, 0x23, 0x11, 0x67, 0x5a, 0xe4, 0x4f, 0xa0, 0x77, 0x95, 0x53, 0x16,
+	0x4a, 0x11, 0xf2, 0xa1, 0x73, 0x1f, 0x2e, 0x0b, 0x8a, 0x9d, 0x91, 0x73, 0xd2, 0x3f, 0xdb, 0x1b,
+	0xab, 0x40, 0xe3, 0x73, 0x19, 0x87, 0x94, 0x2a, 0xff, 0x2d, 0xc0, 0xc5, 0x9c, 0x46, 0x8b, 0x3c,
+	0x8b, 0x53, 0x81, 0x0e, 0x61, 0x97, 0xd3, 0x2f, 0x05, 0x4d, 0xa3, 0xd2, 0x69, 0x40, 0xaa, 0x33,
+	0xfa, 0x0f, 0xbc, 0xdb, 0x78, 0x46, 0xb9, 0xc0, 0xad, 0x91, 0x73, 0xb2, 0x47, 0xf4, 0xc9, 0xbf,
+	0x82, 0xce, 0xfb, 0x54, 0xb0, 0x87, 0x6d, 0x9c, 0x11, 0x82, 0xf6, 0x7d, 0x4c, 0x57, 0xd8, 0x55,
+	0xf6, 0xea, 0xbf, 0xff, 0x01, 0xe0, 0x53, 0x4c, 0x57, 0x17, 0xf3, 0x30, 0x9d, 0x51, 0x34, 0x82,
+	0xbe, 0x94, 0x46, 0xea, 0xc4, 0x74, 0xe0, 0xba, 0x68, 0x23, 0xb0, 0x37, 0xd0, 0xbd, 0x29, 0x92,
+	0x24, 0xdc, 0x0e, 0x9a, 0x7f, 0x0a, 0x1e, 0xa1, 0xbc, 0x58, 0x8a, 0x3f, 0xaa, 0xe3, 0x4f, 0x17,
+	0xba, 0x44, 0x86, 0xe4, 0x02, 0x8d, 0xc1, 0x8b, 0x96, 0x31, 0x4d, 0x85, 0x76, 0xf8, 0x47, 0x3b,
+	0x68, 0xfd, 0x85, 0xd2, 0x5d, 0xee, 0x10, 0x6d, 0x85, 0x5e, 0x03, 0xe4, 0x8c, 0xca, 0x5f, 0xc8,
+	0xa8, 0x42, 0xd1, 0x3f, 0xc3, 0xb6, 0xcf, 0x35, 0xa3, 0xd7, 0xa5, 0xfe, 0x72, 0x87, 0xd4, 0xac,
+	0xd1, 0x2b, 0xe8, 0xae, 0x1d, 0x5d, 0xe5, 0xf8, 0xef, 0x23, 0x47, 0xed, 0xb5, 0xb6, 0x53, 0xf0,
+	0xb2, 0x24, 0x89, 0x05, 0x6e, 0x37, 0xc2, 0x53, 0x3a, 0x05, 0x4f, 0xfd, 0x93, 0xf0, 0xa2, 0x8a,
+	0x22, 0xb8, 0xd3, 0x04, 0xcf, 0x50, 0x48, 0xc2, 0x33, 0xd6, 0xd2, 0xd7, 0xb4, 0x0a, 0x7b, 0x4d,
+	0xbe, 0xa6, 0xd7, 0xd2, 0xd7, 0x58, 0xa3, 0x63, 0x70, 0xc3, 0x68, 0x81, 0xbb, 0xca, 0x69, 0x68,
+	0x3b, 0xbd, 0x8b, 0x16, 0x97, 0x3b, 0x44, 0xea, 0x65, 0x05, 0x52, 0xba, 0x52, 0x2c, 0xda, 0x6d,
+	0xaa, 0xc0, 0x47, 0xba, 0x92, 0x29, 0x64, 0x05, 0xb4, 0x9d, 0x6c, 0x39, 0xa7, 0xe9, 0x2d, 0x65,
+	0xb8, 0xa7, 0xc8, 0xa0, 0x4f, 0x68, 0x0c, 0x3d, 0x1e, 0xcf, 0xd2, 0x50, 0x14, 0x8c, 0x62, 0x50,
+	0xc1, 0x0e, 0x74, 0xb0, 0x9b, 0xb5, 0x9c, 0x18, 0x93, 0xf3, 0xae, 0x26, 0x86, 0x3f, 0x83, 0x81,
+	0xd5, 0x5c, 0x34, 0x82, 0x56, 0x96, 0x63, 0xc7, 0x0a, 0x51, 0x0d, 0x26, 0x69, 0x65, 0x39, 0x3a,
+	0x82, 0x9e, 0x88, 0x13, 0xca, 0x45, 0x98, 0xe4, 0xaa, 0xe7, 0x3d, 0x62, 0x04, 0x12, 0xa1, 0xa6,
+	0x90, 0xab, 0x54, 0xfa, 0xe4, 0x17, 0x30, 0x7c, 0xc4, 0x88, 0x6a, 0x88, 0x1c, 0x33, 0x44, 0x16,
+	0xe3, 0x5b, 0x1b, 0x19, 0xef, 0x5a, 0xc3, 0x88, 0xa1, 0xcb, 0x68, 0xbe, 0x8c, 0xa3, 0x50, 0x31,
+	0x63, 0x40, 0xd6, 0x47, 0x9f, 0xc1, 0xbe, 0xcd, 0xa7, 0xbf, 0x90, 0xf3, 0x9b, 0x63, 0x8a, 0x5a,
+	0x12, 0xf1, 0xb9, 0x39, 0x6b, 0xb1, 0x5d, 0x2b, 0x76, 0x0d, 0x4d, 0xdb, 0x42, 0x73, 0x04, 0x3d,
+	0xb5, 0x65, 0x65, 0xb7, 0x15, 0xd3, 0xf7, 0x88, 0x11, 0xf8, 0x21, 0x0c, 0x1f, 0xf1, 0x7d, 0xab,
+	0xad, 0xb7, 0x11, 0x98, 0xff, 0xb5, 0x55, 0xe5, 0xa8, 0xed, 0xc0, 0xe7, 0x5e, 0x3c, 0x80, 0xbe,
+	0x99, 0x41, 0x8e, 0xdd, 0x91, 0x5b, 0x9b, 0x20, 0x83, 0x9d, 0xd4, 0xad, 0xe4, 0x96, 0x93, 0x1b,
+	0x82, 0xe3, 0xf6, 0xc8, 0xad, 0x6d, 0x39, 0xb5, 0xdb, 0x49, 0xa9, 0x42, 0x27, 0xb0, 0xab, 0x77,
+	0x0f, 0xc7, 0x9d, 0x06, 0xb3, 0x4a, 0x5b, 0xbf, 0xa2, 0x67, 0xd7, 0xfe, 0x25, 0x78, 0x39, 0xcb,
+	0xb2, 0x3b, 0x8e, 0xbb, 0x2a, 0xc2, 0xbe, 0x3d, 0xae, 0x44, 0x6b, 0x7d, 0x01, 0x60, 0x86, 0xbd,
+	0xb1, 0x04, 0xb5, 0x1c, 0x2d, 0x3b, 0xc7, 0x6f, 0x8f, 0x86, 0xfb, 0xd4, 0xa3, 0x61, 0x31, 0xc0,
+	0xff, 0xe1, 0xc0, 0xbe, 0xbd, 0x38, 0x1a, 0x53, 0x07, 0xf5, 0x04, 0x1c, 0xb7, 0xac, 0x0a, 0x9b,
+	0xce, 0xd5, 0x73, 0x72, 0x74, 0x0a, 0x3d, 0xae, 0x1e, 0xa4, 0x98, 0xae, 0xab, 0xbc, 0xbe, 0xbc,
+	0x7e, 0xa8, 0x88, 0x31, 0xa8, 0xdf, 0xae, 0xb3, 0xa9, 0x82, 0xde, 0x93, 0x15, 0xfc, 0xee, 0x40,
+	0xbf, 0xdc, 0x47, 0x84, 0xe6, 0xcb, 0x87, 0xc6, 0x8b, 0x6c, 0xb5, 0x86, 0x36, 0x4f, 0x2d, 0x3a,
+	0x06, 0x8f, 0xa9, 0x57, 0x53, 0x3f, 0x14, 0x83, 0x0a, 0x9b, 0x14, 0x12, 0xad, 0x3c, 0x7f, 0xf1,
+	0xf9, 0xff, 0x59, 0x2c, 0xe6, 0xc5, 0x74, 0x1c, 0x65, 0xc9, 0x24, 0x08, 0xa2, 0x74, 0xa2, 0xbe,
+	0x6c, 0x82, 0x60, 0xa2, 0xec, 0xa7, 0x9e, 0xfa, 0x9e, 0x09, 0x7e, 0x0d, 0x00, 0xd5, 0x1f, 0x2f,
+	0x34, 0x09, 0x09, 0x00, 0x00,
 }
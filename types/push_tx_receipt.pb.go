@@ -156,9 +156,107 @@ func (m *TxReceipts4Subscribe) GetTxReceipts() []*TxReceipts4SubscribePerBlk {
 	return nil
 }
 
+// grpc 流式订阅区块sequence, start 小于0时从最新的sequence之后开始推送
+type ReqSubscribeBlockSeqs struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSubscribeBlockSeqs) Reset()         { *m = ReqSubscribeBlockSeqs{} }
+func (m *ReqSubscribeBlockSeqs) String() string { return proto.CompactTextString(m) }
+func (*ReqSubscribeBlockSeqs) ProtoMessage()    {}
+func (*ReqSubscribeBlockSeqs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5e438adec79672e, []int{2}
+}
+
+func (m *ReqSubscribeBlockSeqs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSubscribeBlockSeqs.Unmarshal(m, b)
+}
+func (m *ReqSubscribeBlockSeqs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSubscribeBlockSeqs.Marshal(b, m, deterministic)
+}
+func (m *ReqSubscribeBlockSeqs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSubscribeBlockSeqs.Merge(m, src)
+}
+func (m *ReqSubscribeBlockSeqs) XXX_Size() int {
+	return xxx_messageInfo_ReqSubscribeBlockSeqs.Size(m)
+}
+func (m *ReqSubscribeBlockSeqs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSubscribeBlockSeqs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSubscribeBlockSeqs proto.InternalMessageInfo
+
+func (m *ReqSubscribeBlockSeqs) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+// grpc 流式订阅交易回执, contract 和 address 至少指定一个
+type ReqSubscribeTxReceipts struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Contract             []string `protobuf:"bytes,2,rep,name=contract,proto3" json:"contract,omitempty"`
+	Address              []string `protobuf:"bytes,3,rep,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSubscribeTxReceipts) Reset()         { *m = ReqSubscribeTxReceipts{} }
+func (m *ReqSubscribeTxReceipts) String() string { return proto.CompactTextString(m) }
+func (*ReqSubscribeTxReceipts) ProtoMessage()    {}
+func (*ReqSubscribeTxReceipts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5e438adec79672e, []int{3}
+}
+
+func (m *ReqSubscribeTxReceipts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSubscribeTxReceipts.Unmarshal(m, b)
+}
+func (m *ReqSubscribeTxReceipts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSubscribeTxReceipts.Marshal(b, m, deterministic)
+}
+func (m *ReqSubscribeTxReceipts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSubscribeTxReceipts.Merge(m, src)
+}
+func (m *ReqSubscribeTxReceipts) XXX_Size() int {
+	return xxx_messageInfo_ReqSubscribeTxReceipts.Size(m)
+}
+func (m *ReqSubscribeTxReceipts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSubscribeTxReceipts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSubscribeTxReceipts proto.InternalMessageInfo
+
+func (m *ReqSubscribeTxReceipts) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ReqSubscribeTxReceipts) GetContract() []string {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *ReqSubscribeTxReceipts) GetAddress() []string {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TxReceipts4SubscribePerBlk)(nil), "types.TxReceipts4SubscribePerBlk")
 	proto.RegisterType((*TxReceipts4Subscribe)(nil), "types.TxReceipts4Subscribe")
+	proto.RegisterType((*ReqSubscribeBlockSeqs)(nil), "types.ReqSubscribeBlockSeqs")
+	proto.RegisterType((*ReqSubscribeTxReceipts)(nil), "types.ReqSubscribeTxReceipts")
 }
 
 func init() {
@@ -166,24 +264,28 @@ func init() {
 }
 
 var fileDescriptor_d5e438adec79672e = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x5f, 0x4f, 0xc2, 0x30,
-	0x14, 0xc5, 0xb3, 0x21, 0x28, 0x17, 0x5e, 0x6c, 0xd4, 0x2c, 0xc4, 0x3f, 0x73, 0x4f, 0x7b, 0x1a,
-	0x89, 0xe3, 0x0b, 0x48, 0x78, 0xf0, 0xc9, 0x98, 0xca, 0x8b, 0xbe, 0x90, 0xae, 0x34, 0xac, 0x01,
-	0xd6, 0xda, 0xde, 0x99, 0xf1, 0x8d, 0xfc, 0x98, 0x86, 0x0e, 0xdd, 0x48, 0xf4, 0xf1, 0x9e, 0xf3,
-	0xeb, 0xe9, 0xcd, 0xb9, 0x70, 0xa9, 0x4b, 0x9b, 0x2f, 0xb0, 0x5a, 0x18, 0xc1, 0x85, 0xd4, 0x98,
-	0x68, 0xa3, 0x50, 0x91, 0x2e, 0xee, 0xb4, 0xb0, 0xa3, 0x73, 0x34, 0xac, 0xb0, 0x8c, 0xa3, 0x54,
-	0x45, 0xed, 0x44, 0x5f, 0x3e, 0x8c, 0xe6, 0x15, 0xad, 0x69, 0x3b, 0x79, 0x2d, 0x33, 0xcb, 0x8d,
-	0xcc, 0xc4, 0x8b, 0x30, 0xd3, 0xcd, 0x9a, 0x44, 0xe0, 0x63, 0x15, 0x78, 0x61, 0x27, 0x1e, 0x3c,
-	0x90, 0xc4, 0xa5, 0x24, 0xf3, 0x26, 0x84, 0xfa, 0x58, 0x91, 0x09, 0x0c, 0x0e, 0xbf, 0xcd, 0x18,
-	0xb2, 0xc0, 0x3f, 0x82, 0x69, 0xe3, 0xd0, 0x36, 0x46, 0xae, 0xa0, 0x97, 0x0b, 0xb9, 0xca, 0x31,
-	0x38, 0x09, 0xbd, 0xb8, 0x43, 0x0f, 0x13, 0xb9, 0x86, 0x7e, 0xb6, 0x51, 0x7c, 0xfd, 0xc4, 0x6c,
-	0x1e, 0x74, 0x43, 0x2f, 0x1e, 0xd2, 0x46, 0x20, 0xb7, 0x00, 0x9a, 0x19, 0x51, 0xa0, 0xb3, 0x7b,
-	0xce, 0x6e, 0x29, 0x24, 0x82, 0xa1, 0x36, 0xe2, 0x53, 0xaa, 0xd2, 0x3a, 0xe2, 0xd4, 0x11, 0x47,
-	0xda, 0x3e, 0x83, 0x2d, 0x97, 0x33, 0xb1, 0x99, 0xef, 0xb4, 0x08, 0xce, 0x42, 0x2f, 0xee, 0xd2,
-	0x96, 0xb2, 0xdf, 0xcc, 0x8a, 0x8f, 0xe7, 0x72, 0x1b, 0xf4, 0xeb, 0xcd, 0xea, 0x29, 0x7a, 0x83,
-	0x8b, 0xbf, 0x9a, 0x22, 0x8f, 0x00, 0xf8, 0xab, 0x1f, 0xba, 0xba, 0xff, 0xe9, 0xea, 0xdf, 0x6a,
-	0x69, 0xeb, 0xd1, 0xf4, 0xee, 0xfd, 0x66, 0x25, 0x31, 0x2f, 0xb3, 0x84, 0xab, 0xed, 0x38, 0x4d,
-	0x79, 0x31, 0xe6, 0x39, 0x93, 0x45, 0x9a, 0x8e, 0x5d, 0x4e, 0xd6, 0x73, 0xd7, 0x4a, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0xd0, 0x6e, 0x3c, 0xe0, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0xaf, 0xa2, 0x30,
+	0x14, 0xc5, 0x03, 0x0e, 0xfe, 0xb9, 0xba, 0x99, 0x46, 0x4d, 0x63, 0xe6, 0x0f, 0xc3, 0x8a, 0xcd,
+	0x60, 0x32, 0xf8, 0x05, 0xc6, 0xb8, 0x98, 0xd5, 0x64, 0x52, 0xdd, 0xcc, 0xdb, 0x98, 0x52, 0x1a,
+	0x21, 0x2a, 0x60, 0x7b, 0x79, 0xc1, 0x6f, 0xf4, 0x3e, 0xe6, 0x8b, 0x45, 0x05, 0x13, 0xdf, 0xf2,
+	0x9c, 0xf3, 0xe3, 0xf6, 0xf6, 0x50, 0x98, 0x14, 0xa5, 0x4e, 0xb6, 0x58, 0x6d, 0x95, 0x14, 0x32,
+	0x2d, 0x30, 0x28, 0x54, 0x8e, 0x39, 0x71, 0xf0, 0x5c, 0x48, 0x3d, 0xfb, 0x8c, 0x8a, 0x67, 0x9a,
+	0x0b, 0x4c, 0xf3, 0xac, 0x4e, 0xbc, 0x37, 0x1b, 0x66, 0x9b, 0x8a, 0xd5, 0xb4, 0x5e, 0xac, 0xcb,
+	0x48, 0x0b, 0x95, 0x46, 0xf2, 0x9f, 0x54, 0xcb, 0xc3, 0x9e, 0x78, 0x60, 0x63, 0x45, 0x2d, 0xb7,
+	0xe3, 0x0f, 0x7f, 0x91, 0xc0, 0x4c, 0x09, 0x36, 0xcd, 0x10, 0x66, 0x63, 0x45, 0x16, 0x30, 0xbc,
+	0x9e, 0xb6, 0xe2, 0xc8, 0xa9, 0xfd, 0x00, 0xb3, 0x26, 0x61, 0x6d, 0x8c, 0x4c, 0xa1, 0x9b, 0xc8,
+	0x74, 0x97, 0x20, 0xfd, 0xe4, 0x5a, 0x7e, 0x87, 0x5d, 0x15, 0xf9, 0x02, 0x83, 0xe8, 0x90, 0x8b,
+	0xfd, 0x1f, 0xae, 0x13, 0xea, 0xb8, 0x96, 0x3f, 0x62, 0x8d, 0x41, 0xbe, 0x01, 0x14, 0x5c, 0xc9,
+	0x0c, 0x4d, 0xdc, 0x35, 0x71, 0xcb, 0x21, 0x1e, 0x8c, 0x0a, 0x25, 0x5f, 0xd3, 0xbc, 0xd4, 0x86,
+	0xe8, 0x19, 0xe2, 0xc1, 0xbb, 0xcc, 0xe0, 0x71, 0xbc, 0x92, 0x87, 0xcd, 0xb9, 0x90, 0xb4, 0xef,
+	0x5a, 0xbe, 0xc3, 0x5a, 0xce, 0x65, 0x33, 0x2d, 0x4f, 0x7f, 0xcb, 0x23, 0x1d, 0xd4, 0x9b, 0xd5,
+	0xca, 0xfb, 0x0f, 0xe3, 0x67, 0x4d, 0x91, 0xdf, 0x00, 0x78, 0xf7, 0xaf, 0x5d, 0xfd, 0xb8, 0x75,
+	0xf5, 0x61, 0xb5, 0xac, 0xf5, 0x91, 0xf7, 0x13, 0x26, 0x4c, 0x9e, 0xee, 0xc4, 0xf2, 0x72, 0xdf,
+	0xb5, 0x3c, 0x69, 0x32, 0x06, 0x47, 0x23, 0x57, 0x48, 0x2d, 0xb3, 0x4a, 0x2d, 0xbc, 0x18, 0xa6,
+	0x6d, 0xbc, 0x39, 0xe4, 0x39, 0x4f, 0x66, 0xd0, 0x17, 0x79, 0x86, 0x8a, 0x0b, 0x34, 0xbf, 0x67,
+	0xc0, 0xee, 0x9a, 0x50, 0xe8, 0xf1, 0x38, 0x56, 0x52, 0x6b, 0xda, 0x31, 0xd1, 0x4d, 0x2e, 0xbf,
+	0xbf, 0x7c, 0xdd, 0xa5, 0x98, 0x94, 0x51, 0x20, 0xf2, 0xe3, 0x3c, 0x0c, 0x45, 0x36, 0x17, 0x09,
+	0x4f, 0xb3, 0x30, 0x9c, 0x9b, 0xcb, 0x45, 0x5d, 0xf3, 0x84, 0xc2, 0xf7, 0x01, 0x00, 0x9e, 0xe8,
+	0x45, 0x71, 0x75, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: raft.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// raft共识节点持久化的投票状态
+type RaftState struct {
+	Term                 int64    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	VotedFor             string   `protobuf:"bytes,2,opt,name=votedFor,proto3" json:"votedFor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftState) Reset()         { *m = RaftState{} }
+func (m *RaftState) String() string { return proto.CompactTextString(m) }
+func (*RaftState) ProtoMessage()    {}
+func (*RaftState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b042552c306ae59b, []int{0}
+}
+
+func (m *RaftState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RaftState.Unmarshal(m, b)
+}
+func (m *RaftState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RaftState.Marshal(b, m, deterministic)
+}
+func (m *RaftState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftState.Merge(m, src)
+}
+func (m *RaftState) XXX_Size() int {
+	return xxx_messageInfo_RaftState.Size(m)
+}
+func (m *RaftState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftState proto.InternalMessageInfo
+
+func (m *RaftState) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftState) GetVotedFor() string {
+	if m != nil {
+		return m.VotedFor
+	}
+	return ""
+}
+
+// 候选人拉票, 链高度和待提交区块用于比较日志新旧
+type RaftVote struct {
+	Term                 int64    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Candidate            string   `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	PendingTerm          int64    `protobuf:"varint,5,opt,name=pendingTerm,proto3" json:"pendingTerm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftVote) Reset()         { *m = RaftVote{} }
+func (m *RaftVote) String() string { return proto.CompactTextString(m) }
+func (*RaftVote) ProtoMessage()    {}
+func (*RaftVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b042552c306ae59b, []int{1}
+}
+
+func (m *RaftVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RaftVote.Unmarshal(m, b)
+}
+func (m *RaftVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RaftVote.Marshal(b, m, deterministic)
+}
+func (m *RaftVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftVote.Merge(m, src)
+}
+func (m *RaftVote) XXX_Size() int {
+	return xxx_messageInfo_RaftVote.Size(m)
+}
+func (m *RaftVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftVote proto.InternalMessageInfo
+
+func (m *RaftVote) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftVote) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *RaftVote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RaftVote) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *RaftVote) GetPendingTerm() int64 {
+	if m != nil {
+		return m.PendingTerm
+	}
+	return 0
+}
+
+type RaftVoteReply struct {
+	Term                 int64    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Granted              bool     `protobuf:"varint,3,opt,name=granted,proto3" json:"granted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftVoteReply) Reset()         { *m = RaftVoteReply{} }
+func (m *RaftVoteReply) String() string { return proto.CompactTextString(m) }
+func (*RaftVoteReply) ProtoMessage()    {}
+func (*RaftVoteReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b042552c306ae59b, []int{2}
+}
+
+func (m *RaftVoteReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RaftVoteReply.Unmarshal(m, b)
+}
+func (m *RaftVoteReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RaftVoteReply.Marshal(b, m, deterministic)
+}
+func (m *RaftVoteReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftVoteReply.Merge(m, src)
+}
+func (m *RaftVoteReply) XXX_Size() int {
+	return xxx_messageInfo_RaftVoteReply.Size(m)
+}
+func (m *RaftVoteReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftVoteReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftVoteReply proto.InternalMessageInfo
+
+func (m *RaftVoteReply) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftVoteReply) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *RaftVoteReply) GetGranted() bool {
+	if m != nil {
+		return m.Granted
+	}
+	return false
+}
+
+// leader复制区块, block为空时只是心跳
+type RaftAppend struct {
+	Term                 int64    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Leader               string   `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	Block                *Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Committed            bool     `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	CommitHeight         int64    `protobuf:"varint,5,opt,name=commitHeight,proto3" json:"commitHeight,omitempty"`
+	CommitHash           []byte   `protobuf:"bytes,6,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftAppend) Reset()         { *m = RaftAppend{} }
+func (m *RaftAppend) String() string { return proto.CompactTextString(m) }
+func (*RaftAppend) ProtoMessage()    {}
+func (*RaftAppend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b042552c306ae59b, []int{3}
+}
+
+func (m *RaftAppend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RaftAppend.Unmarshal(m, b)
+}
+func (m *RaftAppend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RaftAppend.Marshal(b, m, deterministic)
+}
+func (m *RaftAppend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftAppend.Merge(m, src)
+}
+func (m *RaftAppend) XXX_Size() int {
+	return xxx_messageInfo_RaftAppend.Size(m)
+}
+func (m *RaftAppend) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftAppend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftAppend proto.InternalMessageInfo
+
+func (m *RaftAppend) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftAppend) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *RaftAppend) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *RaftAppend) GetCommitted() bool {
+	if m != nil {
+		return m.Committed
+	}
+	return false
+}
+
+func (m *RaftAppend) GetCommitHeight() int64 {
+	if m != nil {
+		return m.CommitHeight
+	}
+	return 0
+}
+
+func (m *RaftAppend) GetCommitHash() []byte {
+	if m != nil {
+		return m.CommitHash
+	}
+	return nil
+}
+
+type RaftAppendReply struct {
+	Term                 int64    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Success              bool     `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	PendingHeight        int64    `protobuf:"varint,5,opt,name=pendingHeight,proto3" json:"pendingHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftAppendReply) Reset()         { *m = RaftAppendReply{} }
+func (m *RaftAppendReply) String() string { return proto.CompactTextString(m) }
+func (*RaftAppendReply) ProtoMessage()    {}
+func (*RaftAppendReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b042552c306ae59b, []int{4}
+}
+
+func (m *RaftAppendReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RaftAppendReply.Unmarshal(m, b)
+}
+func (m *RaftAppendReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RaftAppendReply.Marshal(b, m, deterministic)
+}
+func (m *RaftAppendReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftAppendReply.Merge(m, src)
+}
+func (m *RaftAppendReply) XXX_Size() int {
+	return xxx_messageInfo_RaftAppendReply.Size(m)
+}
+func (m *RaftAppendReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftAppendReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftAppendReply proto.InternalMessageInfo
+
+func (m *RaftAppendReply) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftAppendReply) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *RaftAppendReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *RaftAppendReply) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RaftAppendReply) GetPendingHeight() int64 {
+	if m != nil {
+		return m.PendingHeight
+	}
+	return 0
+}
+
+type RaftMessage struct {
+	// Types that are valid to be assigned to Value:
+	//	*RaftMessage_Vote
+	//	*RaftMessage_VoteReply
+	//	*RaftMessage_Append
+	//	*RaftMessage_AppendReply
+	Value                isRaftMessage_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RaftMessage) Reset()         { *m = RaftMessage{} }
+func (m *RaftMessage) String() string { return proto.CompactTextString(m) }
+func (*RaftMessage) ProtoMessage()    {}
+func (*RaftMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b042552c306ae59b, []int{5}
+}
+
+func (m *RaftMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RaftMessage.Unmarshal(m, b)
+}
+func (m *RaftMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RaftMessage.Marshal(b, m, deterministic)
+}
+func (m *RaftMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftMessage.Merge(m, src)
+}
+func (m *RaftMessage) XXX_Size() int {
+	return xxx_messageInfo_RaftMessage.Size(m)
+}
+func (m *RaftMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftMessage proto.InternalMessageInfo
+
+type isRaftMessage_Value interface {
+	isRaftMessage_Value()
+}
+
+type RaftMessage_Vote struct {
+	Vote *RaftVote `protobuf:"bytes,1,opt,name=vote,proto3,oneof"`
+}
+
+type RaftMessage_VoteReply struct {
+	VoteReply *RaftVoteReply `protobuf:"bytes,2,opt,name=voteReply,proto3,oneof"`
+}
+
+type RaftMessage_Append struct {
+	Append *RaftAppend `protobuf:"bytes,3,opt,name=append,proto3,oneof"`
+}
+
+type RaftMessage_AppendReply struct {
+	AppendReply *RaftAppendReply `protobuf:"bytes,4,opt,name=appendReply,proto3,oneof"`
+}
+
+func (*RaftMessage_Vote) isRaftMessage_Value() {}
+
+func (*RaftMessage_VoteReply) isRaftMessage_Value() {}
+
+func (*RaftMessage_Append) isRaftMessage_Value() {}
+
+func (*RaftMessage_AppendReply) isRaftMessage_Value() {}
+
+func (m *RaftMessage) GetValue() isRaftMessage_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *RaftMessage) GetVote() *RaftVote {
+	if x, ok := m.GetValue().(*RaftMessage_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (m *RaftMessage) GetVoteReply() *RaftVoteReply {
+	if x, ok := m.GetValue().(*RaftMessage_VoteReply); ok {
+		return x.VoteReply
+	}
+	return nil
+}
+
+func (m *RaftMessage) GetAppend() *RaftAppend {
+	if x, ok := m.GetValue().(*RaftMessage_Append); ok {
+		return x.Append
+	}
+	return nil
+}
+
+func (m *RaftMessage) GetAppendReply() *RaftAppendReply {
+	if x, ok := m.GetValue().(*RaftMessage_AppendReply); ok {
+		return x.AppendReply
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RaftMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RaftMessage_Vote)(nil),
+		(*RaftMessage_VoteReply)(nil),
+		(*RaftMessage_Append)(nil),
+		(*RaftMessage_AppendReply)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*RaftState)(nil), "types.RaftState")
+	proto.RegisterType((*RaftVote)(nil), "types.RaftVote")
+	proto.RegisterType((*RaftVoteReply)(nil), "types.RaftVoteReply")
+	proto.RegisterType((*RaftAppend)(nil), "types.RaftAppend")
+	proto.RegisterType((*RaftAppendReply)(nil), "types.RaftAppendReply")
+	proto.RegisterType((*RaftMessage)(nil), "types.RaftMessage")
+}
+
+func init() {
+	proto.RegisterFile("raft.proto", fileDescriptor_b042552c306ae59b)
+}
+
+var fileDescriptor_b042552c306ae59b = []byte{
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x8e, 0xd3, 0x30,
+	0x14, 0xad, 0x99, 0x34, 0xd3, 0xde, 0x74, 0x34, 0x60, 0xa1, 0x2a, 0x1a, 0xf1, 0x88, 0x2c, 0x90,
+	0x2a, 0x21, 0xb5, 0x52, 0xc3, 0x0a, 0x56, 0x74, 0x81, 0xba, 0x61, 0x81, 0x41, 0x2c, 0xd8, 0xb9,
+	0x89, 0x9b, 0x44, 0x34, 0x71, 0x94, 0xb8, 0x95, 0xe6, 0x2f, 0xe0, 0x87, 0xf8, 0x12, 0x3e, 0x06,
+	0xf9, 0xc6, 0x26, 0x29, 0x0c, 0x8b, 0xd9, 0xf9, 0xdc, 0xe7, 0xb9, 0xe7, 0x24, 0x00, 0x8d, 0xd8,
+	0xeb, 0x65, 0xdd, 0x28, 0xad, 0xe8, 0x58, 0xdf, 0xd6, 0xb2, 0xbd, 0x79, 0xb8, 0x3b, 0xa8, 0xe4,
+	0x5b, 0x92, 0x8b, 0xa2, 0xea, 0x12, 0xec, 0x2d, 0x4c, 0xb9, 0xd8, 0xeb, 0x4f, 0x5a, 0x68, 0x49,
+	0x29, 0x78, 0x5a, 0x36, 0x65, 0x48, 0x22, 0xb2, 0xb8, 0xe0, 0xf8, 0xa6, 0x37, 0x30, 0x39, 0x29,
+	0x2d, 0xd3, 0xf7, 0xaa, 0x09, 0x1f, 0x44, 0x64, 0x31, 0xe5, 0x7f, 0x30, 0xfb, 0x4e, 0x60, 0x62,
+	0xba, 0xbf, 0xa8, 0xff, 0x34, 0x3f, 0x81, 0x69, 0x22, 0xaa, 0xb4, 0x48, 0x85, 0x96, 0xb6, 0xbb,
+	0x0f, 0xd0, 0x39, 0xf8, 0xb9, 0x2c, 0xb2, 0x5c, 0x87, 0x17, 0xd8, 0x63, 0x11, 0x0d, 0xe1, 0xb2,
+	0x96, 0x55, 0x5a, 0x54, 0x59, 0xe8, 0x45, 0x64, 0x31, 0xe1, 0x0e, 0xd2, 0x08, 0x02, 0xfb, 0xfc,
+	0x6c, 0x56, 0x8d, 0xb1, 0x6d, 0x18, 0x62, 0x1f, 0xe1, 0xca, 0x31, 0xe2, 0xb2, 0x3e, 0xdc, 0xde,
+	0x49, 0x8b, 0x82, 0xb7, 0x6f, 0x54, 0x69, 0x19, 0xe1, 0xdb, 0x2c, 0xcd, 0x1a, 0x51, 0x69, 0x99,
+	0x22, 0x9b, 0x09, 0x77, 0x90, 0xfd, 0x24, 0x00, 0x66, 0xe6, 0xbb, 0xda, 0x2c, 0xba, 0x73, 0xe0,
+	0x1c, 0xfc, 0x83, 0x14, 0xa9, 0x74, 0x12, 0x59, 0x44, 0x19, 0x8c, 0x51, 0x71, 0x1c, 0x19, 0xac,
+	0x67, 0x4b, 0xb4, 0x61, 0xb9, 0x31, 0x31, 0xde, 0xa5, 0x50, 0x23, 0x55, 0x96, 0x85, 0x36, 0xab,
+	0xbb, 0x7b, 0xfb, 0x00, 0x65, 0x30, 0xeb, 0xc0, 0xb6, 0x53, 0xaa, 0x3b, 0xf9, 0x2c, 0x46, 0x9f,
+	0x01, 0x58, 0x2c, 0xda, 0x3c, 0xf4, 0x23, 0xb2, 0x98, 0xf1, 0x41, 0x84, 0xfd, 0x20, 0x70, 0xdd,
+	0x1f, 0x70, 0x6f, 0x59, 0xda, 0x63, 0x92, 0xc8, 0xb6, 0x75, 0xb2, 0x58, 0x38, 0x70, 0xcf, 0x3b,
+	0x73, 0xef, 0x05, 0x5c, 0x59, 0x43, 0xce, 0x28, 0x9f, 0x07, 0xd9, 0x2f, 0x02, 0x81, 0xe1, 0xf4,
+	0x41, 0xb6, 0xad, 0xc8, 0x24, 0x7d, 0x09, 0x9e, 0xf9, 0xac, 0x90, 0x4f, 0xb0, 0xbe, 0xb6, 0x42,
+	0x39, 0x2b, 0xb7, 0x23, 0x8e, 0x69, 0xfa, 0x1a, 0xa6, 0x27, 0x67, 0x2d, 0xf2, 0x0c, 0xd6, 0x8f,
+	0xff, 0xaa, 0xc5, 0xdc, 0x76, 0xc4, 0xfb, 0x42, 0xfa, 0x0a, 0x7c, 0x81, 0xb7, 0x5b, 0x1f, 0x1e,
+	0x0d, 0x5a, 0x3a, 0x51, 0xb6, 0x23, 0x6e, 0x4b, 0xe8, 0x1b, 0x08, 0x44, 0x2f, 0x14, 0x1e, 0x17,
+	0xac, 0xe7, 0xff, 0x74, 0xb8, 0x35, 0xc3, 0xe2, 0xcd, 0x25, 0x8c, 0x4f, 0xe2, 0x70, 0x94, 0x9b,
+	0xe7, 0x5f, 0x9f, 0x66, 0x85, 0xce, 0x8f, 0xbb, 0x65, 0xa2, 0xca, 0x55, 0x1c, 0x27, 0xd5, 0x0a,
+	0xff, 0xba, 0x38, 0x5e, 0xe1, 0xa0, 0x9d, 0x8f, 0xbf, 0x5f, 0xfc, 0x7b, 0x00, 0x13, 0x4a, 0x23,
+	0x86, 0xa5, 0x03, 0x00, 0x00,
+}
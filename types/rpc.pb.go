@@ -30,82 +30,94 @@ func init() {
 }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 1196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x6d, 0x6f, 0xdb, 0x36,
-	0x10, 0xd6, 0x87, 0xad, 0x69, 0x58, 0xc7, 0x71, 0x98, 0x34, 0x6b, 0xb4, 0x15, 0x05, 0x04, 0x0c,
-	0x1b, 0x30, 0x34, 0x49, 0xed, 0x25, 0xeb, 0xd6, 0x6e, 0x40, 0x9c, 0xd4, 0x8e, 0x31, 0xd7, 0x73,
-	0x23, 0x77, 0x03, 0xf6, 0x8d, 0x91, 0x6f, 0x8e, 0x10, 0x59, 0x94, 0x45, 0x2a, 0xb6, 0x7f, 0xc2,
-	0xfe, 0xf5, 0x40, 0x52, 0x2f, 0xa4, 0x25, 0x27, 0xd9, 0x37, 0xf3, 0xb9, 0x7b, 0x8e, 0x77, 0xbc,
-	0x37, 0x0b, 0x6d, 0xc6, 0x91, 0x77, 0x18, 0xc5, 0x94, 0x53, 0xfc, 0x25, 0x5f, 0x46, 0xc0, 0xec,
-	0x9a, 0x47, 0xa7, 0x53, 0x1a, 0x2a, 0xd0, 0xde, 0xe1, 0x31, 0x09, 0x19, 0xf1, 0xb8, 0x9f, 0x43,
-	0x8d, 0xeb, 0x80, 0x7a, 0xb7, 0xde, 0x0d, 0xf1, 0x33, 0xa4, 0x36, 0x27, 0x41, 0x00, 0x3c, 0x3d,
-	0x6d, 0x46, 0xcd, 0x28, 0xfd, 0xb9, 0x45, 0x3c, 0x8f, 0x26, 0x61, 0x26, 0xa9, 0xc3, 0x02, 0xbc,
-	0x84, 0xd3, 0x58, 0x9d, 0x9b, 0xff, 0x7e, 0x8d, 0x36, 0xa4, 0x9d, 0x56, 0x0b, 0xbf, 0x46, 0x9b,
-	0x5d, 0xe0, 0x6d, 0x61, 0x9a, 0xe1, 0xc6, 0xa1, 0xf4, 0xe5, 0xf0, 0x0a, 0x66, 0x0a, 0xb1, 0x6b,
-	0x39, 0x12, 0x05, 0x4b, 0xc7, 0xc2, 0x47, 0x68, 0xab, 0x0b, 0xbc, 0x4f, 0x18, 0xbf, 0x04, 0x32,
-	0x86, 0x18, 0x6f, 0x15, 0x94, 0x81, 0x1f, 0xd8, 0xd9, 0x51, 0x49, 0x1d, 0x0b, 0xff, 0x82, 0xf6,
-	0xce, 0x63, 0x20, 0x1c, 0xae, 0xc8, 0x7c, 0x54, 0xc4, 0x84, 0xb7, 0x53, 0x45, 0x25, 0x1c, 0x2d,
-	0xec, 0x0c, 0xf8, 0x1c, 0x32, 0x7f, 0x12, 0x8e, 0x16, 0x8e, 0x85, 0x2f, 0x50, 0xa3, 0xe0, 0x2e,
-	0xba, 0x31, 0x4d, 0x22, 0xfc, 0xd2, 0xe4, 0x15, 0x16, 0xa5, 0xb8, 0xca, 0xca, 0x6f, 0xa8, 0xf1,
-	0x29, 0x81, 0x78, 0xa9, 0xdf, 0x5e, 0x2f, 0xbc, 0xbe, 0x24, 0xec, 0xc6, 0x7e, 0x91, 0x9e, 0x35,
-	0x9d, 0x0b, 0xe0, 0xc4, 0x0f, 0x1c, 0x0b, 0x9f, 0xa0, 0x6d, 0x17, 0xc2, 0xb1, 0x4e, 0xc7, 0x65,
-	0xf5, 0xd2, 0x4b, 0xfd, 0x8a, 0xf6, 0xba, 0xc0, 0x35, 0x8d, 0xf6, 0xf2, 0x6c, 0x3c, 0x8e, 0xf5,
-	0xab, 0xc5, 0xd9, 0xde, 0xd5, 0x79, 0xa3, 0x45, 0x2f, 0xfc, 0x87, 0x32, 0xc7, 0xc2, 0x5d, 0xb4,
-	0xbf, 0x4a, 0x17, 0x9e, 0x82, 0x91, 0x24, 0x85, 0xd8, 0x07, 0xeb, 0xbc, 0x17, 0x86, 0xde, 0x22,
-	0xd4, 0x05, 0xfe, 0x11, 0xa6, 0x43, 0x4a, 0x03, 0xbc, 0x57, 0x90, 0x15, 0x1a, 0x51, 0x1a, 0xd8,
-	0xd8, 0xf4, 0xa1, 0xef, 0x33, 0x2e, 0x03, 0x7f, 0xd6, 0x05, 0x7e, 0xa6, 0x4a, 0x89, 0xad, 0x66,
-	0xfa, 0x79, 0x7a, 0xfc, 0x4b, 0xd6, 0x60, 0xa6, 0x25, 0x33, 0x8e, 0x0a, 0xda, 0xca, 0x85, 0x29,
-	0x6a, 0xef, 0x55, 0x91, 0x15, 0x77, 0x00, 0xf3, 0x0a, 0x6e, 0x81, 0xae, 0xe5, 0x5e, 0xa1, 0xe7,
-	0x0a, 0xd2, 0x9e, 0x41, 0x44, 0x82, 0x5f, 0x15, 0x66, 0x2a, 0x15, 0xec, 0x7d, 0xc3, 0xe2, 0x68,
-	0x51, 0x3c, 0x5e, 0x07, 0x6d, 0xf5, 0xa6, 0x11, 0x8d, 0xf9, 0x30, 0xf6, 0xef, 0x6e, 0x61, 0x99,
-	0x97, 0x5f, 0x6e, 0xcb, 0x10, 0xaf, 0xf5, 0xad, 0x8d, 0xb6, 0x64, 0x0d, 0x51, 0x91, 0x72, 0x60,
-	0xac, 0x6c, 0xc7, 0x10, 0xdb, 0x0d, 0x3d, 0x21, 0x22, 0xcb, 0x8e, 0x85, 0x9b, 0xe8, 0xa9, 0x2b,
-	0xbc, 0xeb, 0x00, 0xe0, 0xfd, 0x32, 0x9d, 0x77, 0x00, 0x4a, 0x45, 0xf8, 0x0e, 0x6d, 0xb8, 0xa2,
-	0x5d, 0xaf, 0x03, 0xfc, 0xa2, 0x82, 0xd2, 0x27, 0xd7, 0x10, 0xdc, 0xe3, 0x74, 0xed, 0x23, 0xc4,
-	0x13, 0x68, 0x93, 0x80, 0x84, 0x1e, 0xe0, 0x6f, 0x56, 0x2d, 0xe8, 0x52, 0xb3, 0x86, 0x54, 0x61,
-	0x3a, 0x16, 0x3e, 0x45, 0x9b, 0x2e, 0xf0, 0x21, 0x61, 0x6c, 0x3e, 0xc6, 0x07, 0x15, 0x2e, 0x28,
-	0x51, 0xc9, 0xf1, 0x6f, 0xd1, 0x17, 0x7d, 0xea, 0xdd, 0xae, 0x16, 0xdd, 0xaa, 0xda, 0x6b, 0xf4,
-	0xe4, 0x73, 0x28, 0x15, 0x77, 0x8d, 0x20, 0x14, 0x58, 0x52, 0x3f, 0x41, 0xf5, 0x74, 0x7a, 0x65,
-	0xfd, 0xb0, 0x62, 0xbf, 0xba, 0x11, 0xde, 0xa3, 0x5a, 0x17, 0xf8, 0x30, 0xa6, 0x11, 0xc4, 0xe2,
-	0xf5, 0x8b, 0x96, 0x9d, 0xe5, 0x60, 0xde, 0x0f, 0x92, 0x9a, 0xc3, 0x8e, 0x85, 0x7f, 0x42, 0xdb,
-	0x5d, 0xe0, 0x69, 0xc0, 0x9c, 0xf0, 0xa4, 0xd4, 0x4a, 0xa6, 0xef, 0x4a, 0x47, 0x36, 0x43, 0x23,
-	0x1b, 0xcd, 0x7f, 0xdc, 0x41, 0x7c, 0xe7, 0xc3, 0xbc, 0x34, 0xb8, 0xb2, 0xdc, 0x19, 0x5a, 0xb2,
-	0xeb, 0xc5, 0xa5, 0xa2, 0x9c, 0xaa, 0xa8, 0xc6, 0xe0, 0xd1, 0x95, 0x1c, 0x0b, 0xbf, 0x91, 0xc1,
-	0x4a, 0x7b, 0xe2, 0x06, 0xdd, 0xd7, 0x5e, 0xc8, 0x2b, 0x2b, 0xf3, 0x0d, 0xda, 0xe8, 0x42, 0xe8,
-	0x02, 0x8c, 0xf3, 0xc9, 0x98, 0x9e, 0xfb, 0x24, 0x9c, 0x98, 0x14, 0x81, 0x66, 0x14, 0xbe, 0x42,
-	0x91, 0xe7, 0xf6, 0x72, 0x38, 0xaf, 0xa4, 0x1c, 0xa1, 0xa7, 0x2e, 0xb9, 0x03, 0xc9, 0xc9, 0x7c,
-	0xcf, 0x00, 0x49, 0x5a, 0xcd, 0x76, 0x53, 0x0e, 0xa2, 0xac, 0x7a, 0x77, 0xb4, 0xdd, 0x96, 0x96,
-	0x6c, 0xb6, 0x2c, 0xb4, 0xe1, 0xd5, 0x44, 0x48, 0x2e, 0x8b, 0x73, 0xb1, 0x1e, 0xf3, 0x01, 0x24,
-	0x4f, 0x1f, 0xd2, 0x25, 0x5a, 0x75, 0x8f, 0x90, 0xa9, 0xec, 0x3d, 0x92, 0x73, 0x8a, 0xea, 0xea,
-	0x1e, 0x1a, 0x32, 0x08, 0x59, 0xc2, 0x1e, 0xc9, 0xfb, 0x19, 0xed, 0x94, 0x36, 0x5f, 0x1e, 0x5a,
-	0xb6, 0x4b, 0x7b, 0x61, 0xd5, 0x1e, 0x3c, 0x96, 0xc5, 0x7f, 0x09, 0x8b, 0xd1, 0x42, 0xed, 0x92,
-	0x52, 0x31, 0xd5, 0xf2, 0xe5, 0xbd, 0x90, 0x8c, 0x13, 0xf4, 0xec, 0x22, 0x99, 0x46, 0xd9, 0xec,
-	0xd3, 0x16, 0x8f, 0xcb, 0x63, 0x3f, 0x9c, 0x98, 0xed, 0xa2, 0x30, 0x55, 0xb7, 0x1a, 0x8d, 0x75,
-	0xfc, 0xc0, 0x18, 0x58, 0x3a, 0x5e, 0x8a, 0xef, 0x3d, 0xc2, 0xc6, 0x44, 0xfd, 0x7f, 0xec, 0x43,
-	0xb4, 0xf1, 0x27, 0xc4, 0x4c, 0xbc, 0xc9, 0x9a, 0xc6, 0x4e, 0xc5, 0x62, 0xcb, 0x3a, 0x16, 0xfe,
-	0x0e, 0x3d, 0xe9, 0x31, 0x77, 0x19, 0x7a, 0x0f, 0xcd, 0x99, 0x53, 0xb9, 0x0a, 0x87, 0x00, 0xb1,
-	0x60, 0xe6, 0xb9, 0x1a, 0x36, 0x87, 0x29, 0x7c, 0x05, 0xb3, 0xfc, 0xcd, 0xc5, 0x39, 0x9d, 0x1c,
-	0x6f, 0xd1, 0xc6, 0x00, 0xb8, 0xe4, 0x7c, 0x65, 0x70, 0x52, 0x54, 0xd0, 0x32, 0xd7, 0x06, 0x74,
-	0x0c, 0x29, 0x2c, 0xab, 0xbd, 0xde, 0x63, 0x03, 0x1e, 0x9d, 0x8b, 0x46, 0x7c, 0x8c, 0x8b, 0xc7,
-	0xb2, 0xe3, 0x3b, 0x84, 0x93, 0xa0, 0x43, 0xfc, 0x20, 0x89, 0x61, 0x1d, 0xa3, 0x17, 0xf2, 0x56,
-	0x53, 0xa6, 0x77, 0x2f, 0x9d, 0x86, 0xb2, 0xdb, 0x5d, 0x98, 0x25, 0x20, 0x3a, 0x65, 0x3d, 0xed,
-	0xf4, 0x47, 0xc7, 0xc2, 0x2d, 0xb4, 0x23, 0x5b, 0x55, 0x69, 0x3f, 0x50, 0x4a, 0x19, 0xe9, 0x5d,
-	0x31, 0xcb, 0xee, 0xf9, 0x23, 0xb3, 0xab, 0x4f, 0xb3, 0x62, 0x0b, 0x1f, 0xcb, 0x3f, 0x9d, 0x29,
-	0xd9, 0x85, 0x19, 0x36, 0xac, 0xe7, 0xef, 0x9e, 0x45, 0xe1, 0x58, 0xf8, 0x07, 0x84, 0xce, 0x03,
-	0xca, 0xe0, 0x53, 0x02, 0x09, 0x3c, 0xf4, 0x72, 0x1d, 0x19, 0xd0, 0x59, 0x10, 0x88, 0xae, 0xcb,
-	0xc6, 0x85, 0xb6, 0x2e, 0x4d, 0x49, 0x3e, 0xe8, 0x4d, 0x58, 0xf6, 0xe6, 0xa6, 0xeb, 0x4f, 0x42,
-	0xf9, 0x67, 0x55, 0xdf, 0x11, 0x39, 0x68, 0xee, 0x88, 0x1c, 0x76, 0x2c, 0xdc, 0x43, 0xb6, 0x6a,
-	0xde, 0x01, 0x4d, 0xed, 0x55, 0xfd, 0xdd, 0x2c, 0x84, 0xf7, 0x98, 0x3a, 0x45, 0x35, 0x39, 0x59,
-	0xae, 0x48, 0x38, 0x1e, 0x24, 0x53, 0x5c, 0xf4, 0xe8, 0x4c, 0x40, 0x32, 0x3b, 0x55, 0x43, 0xfc,
-	0x7b, 0x39, 0x91, 0x3b, 0x34, 0x36, 0x96, 0xee, 0xef, 0xb0, 0x2c, 0xe5, 0xb2, 0x8d, 0xf0, 0xaa,
-	0xb3, 0x0b, 0x96, 0x07, 0xac, 0x83, 0xeb, 0xbd, 0x3c, 0x97, 0xf5, 0x30, 0x24, 0x31, 0x11, 0xd3,
-	0x68, 0xe4, 0xf3, 0x00, 0xf2, 0x0e, 0x11, 0x5d, 0xae, 0x0b, 0xf2, 0x25, 0xa7, 0xd0, 0xa2, 0x2e,
-	0x7a, 0x68, 0xa7, 0x4f, 0xc9, 0x78, 0xad, 0x95, 0x4b, 0xf0, 0x27, 0x37, 0x3c, 0xb3, 0x72, 0x60,
-	0x04, 0xad, 0x8b, 0x1c, 0x0b, 0x7f, 0x90, 0x35, 0x90, 0x59, 0x52, 0x52, 0xbd, 0x06, 0x4c, 0xc9,
-	0x5a, 0x8f, 0x8e, 0xe5, 0xca, 0x51, 0x1f, 0x3f, 0x55, 0x9f, 0x53, 0x75, 0xe3, 0xf3, 0x88, 0x39,
-	0x56, 0xfb, 0xd5, 0xdf, 0x2f, 0x27, 0x3e, 0xbf, 0x49, 0xae, 0x0f, 0x3d, 0x3a, 0x3d, 0x6a, 0xb5,
-	0xbc, 0xf0, 0x28, 0xfd, 0x34, 0x3b, 0x92, 0xaa, 0xd7, 0x4f, 0xe4, 0x37, 0x5b, 0xeb, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xbf, 0x4e, 0xac, 0xf9, 0x32, 0x0e, 0x00, 0x00,
+	// 1391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xef, 0x6f, 0xdb, 0x36,
+	0x13, 0xd6, 0x0b, 0xbc, 0x6f, 0xd3, 0xb0, 0x8e, 0x93, 0x30, 0x3f, 0xda, 0x0a, 0x6f, 0x51, 0x4c,
+	0xc0, 0xb0, 0x01, 0x43, 0x93, 0xd4, 0x6e, 0xb3, 0x6e, 0xed, 0x36, 0xc4, 0x49, 0xec, 0x78, 0x4d,
+	0x5d, 0xd7, 0x72, 0x57, 0x60, 0xfb, 0x50, 0xc8, 0xf2, 0xd5, 0x11, 0x22, 0x8b, 0x0a, 0x45, 0x25,
+	0xf2, 0x5f, 0xbf, 0x81, 0xa4, 0x7e, 0x90, 0x92, 0x9c, 0x74, 0xdf, 0xcc, 0xe7, 0xee, 0x39, 0x1e,
+	0xc5, 0xe3, 0x73, 0xa4, 0xd1, 0x2a, 0x0d, 0xdd, 0xbd, 0x90, 0x12, 0x46, 0xf0, 0xff, 0xd8, 0x22,
+	0x84, 0xc8, 0x6c, 0xb8, 0x64, 0x3e, 0x27, 0x81, 0x04, 0xcd, 0x4d, 0x46, 0x9d, 0x20, 0x72, 0x5c,
+	0xe6, 0xe5, 0xd0, 0xc6, 0xc4, 0x27, 0xee, 0xa5, 0x7b, 0xe1, 0x78, 0x19, 0xd2, 0xb8, 0x71, 0x7c,
+	0x1f, 0x58, 0x3a, 0x5a, 0x0d, 0x5b, 0x61, 0xfa, 0x73, 0xcd, 0x71, 0x5d, 0x12, 0x07, 0x99, 0xa5,
+	0x09, 0x09, 0xb8, 0x31, 0x23, 0x34, 0x1d, 0xef, 0x84, 0x71, 0x74, 0xf1, 0x99, 0x25, 0x9f, 0x29,
+	0xb8, 0xe0, 0x85, 0x99, 0xdb, 0xfd, 0xe9, 0x44, 0xfe, 0x6a, 0xfd, 0xfd, 0x14, 0xad, 0x88, 0x89,
+	0xda, 0x6d, 0xfc, 0x0c, 0xad, 0xf6, 0x80, 0x75, 0xf8, 0xdc, 0x11, 0xde, 0xd8, 0x13, 0xc9, 0xee,
+	0x8d, 0xe0, 0x4a, 0x22, 0x66, 0x23, 0x47, 0x42, 0x7f, 0x61, 0x19, 0x78, 0x1f, 0xad, 0xf5, 0x80,
+	0x9d, 0x3b, 0x11, 0x3b, 0x03, 0x67, 0x0a, 0x14, 0xaf, 0x15, 0x94, 0x81, 0xe7, 0x9b, 0xd9, 0x50,
+	0x5a, 0x2d, 0x03, 0xff, 0x8c, 0xb6, 0x8f, 0x29, 0x38, 0x0c, 0x46, 0xce, 0xcd, 0xb8, 0x58, 0x34,
+	0x5e, 0x4f, 0x1d, 0xa5, 0x71, 0x9c, 0x98, 0x19, 0xf0, 0x31, 0x88, 0xbc, 0x59, 0x30, 0x4e, 0x2c,
+	0x03, 0x9f, 0xa0, 0x8d, 0x82, 0x9b, 0xf4, 0x28, 0x89, 0x43, 0xfc, 0x44, 0xe7, 0x15, 0x11, 0x85,
+	0xb9, 0x2e, 0xca, 0xaf, 0x68, 0xe3, 0x43, 0x0c, 0x74, 0xa1, 0xce, 0xde, 0x2c, 0xb2, 0x3e, 0x73,
+	0xa2, 0x0b, 0xf3, 0x51, 0x3a, 0x56, 0x7c, 0x4e, 0x80, 0x39, 0x9e, 0x6f, 0x19, 0xf8, 0x25, 0x5a,
+	0xb7, 0x21, 0x98, 0xaa, 0x74, 0x5c, 0x75, 0xaf, 0x7c, 0xa9, 0x5f, 0xd0, 0x76, 0x0f, 0x98, 0xe2,
+	0xd1, 0x59, 0x1c, 0x4d, 0xa7, 0x54, 0x9d, 0x9a, 0x8f, 0xcd, 0x2d, 0x95, 0x37, 0x4e, 0xfa, 0xc1,
+	0x17, 0x12, 0x59, 0x06, 0xee, 0xa1, 0xdd, 0x32, 0x9d, 0x67, 0x0a, 0xda, 0x26, 0x49, 0xc4, 0x7c,
+	0xbc, 0x2c, 0x7b, 0x1e, 0xe8, 0x15, 0x42, 0x3d, 0x60, 0xef, 0x60, 0x3e, 0x24, 0xc4, 0xc7, 0xdb,
+	0x05, 0x59, 0xa2, 0x21, 0x21, 0xbe, 0x89, 0xf5, 0x1c, 0xce, 0xbd, 0x88, 0x89, 0x85, 0x3f, 0xe8,
+	0x01, 0x3b, 0x92, 0xb5, 0x16, 0x95, 0x77, 0x7a, 0x27, 0x1d, 0x7e, 0x12, 0x45, 0x9a, 0x79, 0x89,
+	0x1d, 0x47, 0x05, 0xad, 0x34, 0x61, 0x8a, 0x9a, 0xdb, 0x75, 0x64, 0xc9, 0x1d, 0xc0, 0x4d, 0x0d,
+	0xb7, 0x40, 0x97, 0x72, 0x47, 0x68, 0x47, 0x42, 0xca, 0x67, 0xe0, 0x2b, 0xc1, 0x4f, 0x8b, 0x30,
+	0xb5, 0x0e, 0xe6, 0xae, 0x16, 0x71, 0x9c, 0x14, 0x1f, 0xaf, 0x8b, 0xd6, 0xfa, 0xf3, 0x90, 0x50,
+	0x36, 0xa4, 0xde, 0xf5, 0x25, 0x2c, 0xf0, 0x93, 0x72, 0x2c, 0xcd, 0xbc, 0x34, 0xb7, 0xdf, 0xd1,
+	0xba, 0x74, 0xfc, 0xe4, 0x30, 0xf7, 0xe2, 0x7d, 0xe0, 0x2f, 0xaa, 0x59, 0x95, 0x1c, 0x96, 0xc6,
+	0xea, 0xa0, 0x35, 0x51, 0x8f, 0x84, 0x97, 0x0f, 0x44, 0x51, 0x35, 0x27, 0xcd, 0x6c, 0x6e, 0xa8,
+	0x9b, 0xcb, 0x2b, 0xc6, 0x32, 0x70, 0x0b, 0xdd, 0xb7, 0xf9, 0x4a, 0xbb, 0x00, 0x78, 0xb7, 0x4a,
+	0x67, 0x5d, 0x80, 0x4a, 0x41, 0xbf, 0x46, 0x2b, 0x36, 0x3f, 0xfa, 0x13, 0x1f, 0x3f, 0xaa, 0xa1,
+	0x9c, 0x3b, 0x13, 0xf0, 0x6f, 0x49, 0xba, 0xf1, 0x0e, 0xe8, 0x0c, 0x3a, 0x8e, 0xef, 0x04, 0x2e,
+	0xe0, 0xff, 0x97, 0x23, 0xa8, 0x56, 0x13, 0x97, 0x53, 0x06, 0xbe, 0x19, 0x87, 0x68, 0xd5, 0x06,
+	0x36, 0x74, 0xa2, 0xe8, 0x66, 0x8a, 0x1f, 0xd7, 0xa4, 0x20, 0x4d, 0x95, 0xc4, 0xbf, 0x45, 0xff,
+	0x3d, 0x27, 0xee, 0x65, 0xb9, 0x80, 0xcb, 0x6e, 0xcf, 0xd0, 0xbd, 0x8f, 0x81, 0x70, 0xdc, 0xd2,
+	0x16, 0x21, 0xc1, 0x8a, 0xfb, 0x4b, 0xd4, 0x4c, 0x95, 0x30, 0x3b, 0x5b, 0xa5, 0xf8, 0xf5, 0x87,
+	0xea, 0x0d, 0x6a, 0xf4, 0x80, 0x0d, 0x29, 0x09, 0x81, 0xf2, 0xaf, 0x5f, 0x1c, 0xff, 0xab, 0x1c,
+	0x34, 0x77, 0x54, 0x6a, 0x0e, 0x5b, 0x06, 0xfe, 0x11, 0xad, 0xf7, 0x80, 0xa5, 0x0b, 0x66, 0x0e,
+	0x8b, 0x2b, 0xc7, 0x52, 0xcf, 0x5d, 0xfa, 0x88, 0x83, 0xb5, 0x91, 0xc9, 0xfc, 0xfb, 0x6b, 0xa0,
+	0xd7, 0x1e, 0xdc, 0x54, 0x44, 0x30, 0xdb, 0x3b, 0xcd, 0x4b, 0x28, 0x08, 0x9f, 0x94, 0x97, 0x53,
+	0x1d, 0x55, 0x13, 0x31, 0xd5, 0xc9, 0x32, 0xf0, 0x73, 0xb1, 0x58, 0x11, 0x8f, 0xcf, 0xa0, 0xe6,
+	0xda, 0x0f, 0x58, 0x6d, 0x65, 0x3e, 0x47, 0x2b, 0x3d, 0x08, 0x6c, 0x80, 0x69, 0xae, 0xb2, 0xe9,
+	0xf8, 0xdc, 0x09, 0x66, 0x3a, 0x85, 0xa3, 0x19, 0x85, 0x95, 0x28, 0x62, 0xdc, 0x59, 0x0c, 0x6f,
+	0x6a, 0x29, 0xfb, 0xe8, 0xbe, 0xed, 0x5c, 0x83, 0xe0, 0x64, 0xb9, 0x67, 0x80, 0x20, 0x95, 0x77,
+	0xbb, 0x25, 0x44, 0x2d, 0xab, 0xde, 0x4d, 0xa5, 0x4f, 0xa6, 0x25, 0x9b, 0x35, 0x1e, 0x45, 0x08,
+	0x5b, 0x08, 0x89, 0xc6, 0x73, 0xcc, 0x5b, 0x6d, 0x2e, 0x66, 0x62, 0x74, 0x9a, 0x76, 0xec, 0xba,
+	0x79, 0xb8, 0x4d, 0xee, 0xde, 0x57, 0x72, 0x0e, 0x51, 0x53, 0xce, 0x43, 0x82, 0x08, 0x82, 0x28,
+	0x8e, 0xbe, 0x92, 0xf7, 0x13, 0xda, 0xac, 0x74, 0xd1, 0x7c, 0x69, 0x59, 0x5f, 0xee, 0x07, 0x75,
+	0x3d, 0xf5, 0x40, 0x14, 0xff, 0x19, 0x24, 0xe3, 0x44, 0xf6, 0xa5, 0x4a, 0x31, 0x35, 0xf2, 0x8b,
+	0x40, 0x22, 0x18, 0x2f, 0xd1, 0x83, 0x93, 0x78, 0x1e, 0x66, 0x3a, 0xaa, 0x34, 0x31, 0x9b, 0x51,
+	0x2f, 0x98, 0xe9, 0xc7, 0x45, 0x62, 0xb2, 0x6e, 0x15, 0x5a, 0xd4, 0xf5, 0x7c, 0x4d, 0xb0, 0x54,
+	0xbc, 0xb2, 0xbe, 0x37, 0x08, 0x6b, 0xea, 0xfc, 0xef, 0xd8, 0xbf, 0xa1, 0xe6, 0x69, 0xc2, 0xd9,
+	0x6f, 0x61, 0x11, 0x31, 0x42, 0x35, 0x66, 0x86, 0x09, 0xe6, 0x56, 0x35, 0x73, 0xd9, 0x3b, 0x9a,
+	0x72, 0xfa, 0x3b, 0x03, 0x98, 0x6a, 0x00, 0x9d, 0x63, 0x19, 0xf8, 0x08, 0xad, 0x8f, 0xc0, 0x25,
+	0xd7, 0x40, 0xf3, 0x56, 0xac, 0x88, 0x5f, 0xc9, 0x64, 0x66, 0xfb, 0x77, 0x76, 0xa2, 0x54, 0x62,
+	0x5b, 0xdc, 0xda, 0x0a, 0xa8, 0x2c, 0x1a, 0xb5, 0xa4, 0x3d, 0xb4, 0xf2, 0x07, 0xd0, 0x88, 0x17,
+	0xc5, 0x12, 0x65, 0x4b, 0xcd, 0xfc, 0xca, 0x62, 0x19, 0xf8, 0x3b, 0x74, 0xaf, 0x1f, 0xd9, 0x8b,
+	0xc0, 0xbd, 0x4b, 0x68, 0x0f, 0xc5, 0xbd, 0x62, 0x08, 0x40, 0x39, 0x33, 0x2f, 0xd6, 0x61, 0x6b,
+	0x98, 0xc2, 0x23, 0xb8, 0xca, 0x8b, 0x8e, 0x8f, 0x53, 0xe9, 0x7c, 0x85, 0x56, 0x06, 0xc0, 0x04,
+	0xe7, 0xa1, 0xc6, 0x49, 0x51, 0x4e, 0xcb, 0x52, 0x1b, 0x90, 0x29, 0xa4, 0xb0, 0x38, 0xee, 0xcd,
+	0x7e, 0x34, 0x60, 0xe1, 0x31, 0x57, 0xa2, 0xaf, 0x49, 0xf1, 0x40, 0x48, 0x5e, 0xd7, 0x61, 0x8e,
+	0xdf, 0x75, 0x3c, 0x3f, 0xa6, 0xb0, 0x8c, 0xd1, 0x0f, 0x58, 0xbb, 0x25, 0xea, 0x7b, 0x3b, 0x6d,
+	0x07, 0x42, 0xee, 0x6c, 0xb8, 0x8a, 0x21, 0x70, 0x6f, 0xa3, 0x1d, 0xbe, 0x10, 0x3b, 0xb3, 0x29,
+	0xb4, 0x4a, 0x7a, 0xdf, 0x71, 0x96, 0x32, 0xd2, 0xeb, 0x42, 0xcc, 0x6f, 0xb9, 0x15, 0x6e, 0xa9,
+	0x72, 0x5e, 0x5c, 0x69, 0x0e, 0x44, 0x2d, 0xa4, 0x64, 0x1b, 0xae, 0xb0, 0x16, 0x3d, 0xff, 0xee,
+	0xd9, 0x2a, 0x2c, 0x03, 0xff, 0x80, 0xd0, 0xb1, 0x4f, 0x22, 0xf8, 0x10, 0x43, 0x0c, 0x77, 0x7d,
+	0xb9, 0xae, 0x58, 0xd0, 0x91, 0xef, 0x73, 0xd9, 0xc9, 0xf4, 0x52, 0xb9, 0x2f, 0xe8, 0x96, 0xbc,
+	0xd3, 0xe9, 0xb0, 0x10, 0xa7, 0x55, 0xdb, 0x9b, 0x05, 0xe2, 0xe6, 0xaf, 0x36, 0xc9, 0x1c, 0xd4,
+	0x9b, 0x64, 0x0e, 0x5b, 0x06, 0xee, 0x23, 0x53, 0xaa, 0xd7, 0x80, 0xa4, 0xf1, 0xea, 0xee, 0xee,
+	0x85, 0xf1, 0x96, 0x50, 0x87, 0xa8, 0x21, 0xa4, 0x75, 0xe4, 0x04, 0xd3, 0x41, 0x3c, 0xc7, 0x58,
+	0x39, 0x78, 0x4e, 0x30, 0x15, 0xbb, 0x53, 0xd7, 0xc5, 0xbe, 0x17, 0x2d, 0xa9, 0x4b, 0xa8, 0x76,
+	0xeb, 0x78, 0x0b, 0x8b, 0xca, 0x5e, 0x76, 0x10, 0x2e, 0x27, 0x9b, 0x44, 0xf9, 0x82, 0x55, 0x70,
+	0x79, 0x96, 0xc7, 0xa2, 0x1e, 0x86, 0x0e, 0x75, 0xb8, 0x1c, 0x8f, 0x3d, 0xe6, 0x03, 0x7e, 0xa8,
+	0xc8, 0x9c, 0x6a, 0xc8, 0xbb, 0xbc, 0x44, 0x8b, 0xba, 0xe8, 0xa3, 0xcd, 0x73, 0xe2, 0x4c, 0x97,
+	0x46, 0x39, 0x03, 0x6f, 0x76, 0xc1, 0xb2, 0x28, 0x8f, 0xb5, 0x45, 0xab, 0x26, 0xcb, 0xc0, 0xa7,
+	0xa2, 0x06, 0xb2, 0x48, 0xd2, 0xaa, 0xd6, 0x80, 0x6e, 0x59, 0x9a, 0xd1, 0x81, 0xe8, 0xb9, 0xf2,
+	0x25, 0x59, 0xf7, 0x36, 0x6d, 0x6a, 0x6f, 0x4d, 0xf9, 0x68, 0xc2, 0x76, 0x3c, 0x89, 0x5c, 0xea,
+	0x4d, 0x20, 0x2b, 0xe0, 0x48, 0xbd, 0x6b, 0x56, 0xad, 0x35, 0x05, 0x7f, 0xf0, 0x1f, 0xfc, 0x17,
+	0xda, 0xca, 0x5d, 0xc7, 0xc9, 0x48, 0xbe, 0xa3, 0xb5, 0x9b, 0x76, 0x8d, 0xd9, 0xfc, 0x26, 0x35,
+	0x17, 0xd0, 0x8b, 0xdc, 0x6d, 0x08, 0xb4, 0xe3, 0x5f, 0x8a, 0xe0, 0xa7, 0x68, 0xcb, 0xf6, 0xe6,
+	0xb1, 0x5f, 0xea, 0xbc, 0xdb, 0x6a, 0x91, 0xa7, 0xe6, 0xc4, 0xdc, 0xd5, 0x37, 0x3d, 0xc3, 0x45,
+	0x6b, 0x5c, 0xeb, 0xc9, 0x1b, 0x1e, 0x0c, 0x29, 0x21, 0x5f, 0xf2, 0x27, 0xb5, 0xcd, 0x1b, 0x47,
+	0x0f, 0x58, 0x89, 0x9b, 0x3b, 0x5a, 0x46, 0xe7, 0xe9, 0x9f, 0x4f, 0x66, 0x1e, 0xbb, 0x88, 0x27,
+	0x7b, 0x2e, 0x99, 0xef, 0xb7, 0xdb, 0x6e, 0xb0, 0x9f, 0xfe, 0x21, 0xb0, 0x2f, 0x28, 0x93, 0x7b,
+	0xe2, 0x9f, 0x82, 0xf6, 0x3f, 0x03, 0x00, 0x11, 0x55, 0x88, 0x72, 0xc9, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -149,6 +161,8 @@ type Chain33Client interface {
 	WalletTransactionList(ctx context.Context, in *ReqWalletTransactionList, opts ...grpc.CallOption) (*WalletTxDetails, error)
 	//导入钱包私钥
 	ImportPrivkey(ctx context.Context, in *ReqWalletImportPrivkey, opts ...grpc.CallOption) (*WalletAccount, error)
+	//导入只读账户
+	ImportWatchOnly(ctx context.Context, in *ReqWalletImportWatchOnly, opts ...grpc.CallOption) (*WalletAccount, error)
 	// 发送交易
 	SendToAddress(ctx context.Context, in *ReqWalletSendToAddress, opts ...grpc.CallOption) (*ReplyHash, error)
 	//设置交易手续费
@@ -196,6 +210,14 @@ type Chain33Client interface {
 	DumpPrivkeysFile(ctx context.Context, in *ReqPrivkeysFile, opts ...grpc.CallOption) (*Reply, error)
 	// 从文件中批量导入私钥
 	ImportPrivkeysFile(ctx context.Context, in *ReqPrivkeysFile, opts ...grpc.CallOption) (*Reply, error)
+	// 导出账户私钥到keystore v3文件
+	ExportKeystore(ctx context.Context, in *ReqKeystoreFile, opts ...grpc.CallOption) (*ReplyStrings, error)
+	// 从keystore v3文件导入私钥
+	ImportKeystore(ctx context.Context, in *ReqKeystoreFile, opts ...grpc.CallOption) (*ReplyImportKeystore, error)
+	// 通过seed恢复链上使用过的账户
+	RecoverAccounts(ctx context.Context, in *ReqRecoverAccounts, opts ...grpc.CallOption) (*HDAccounts, error)
+	// 获取通过seed生成的账户和生成路径
+	GetHDAccounts(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*HDAccounts, error)
 	//获取程序版本
 	Version(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*VersionInfo, error)
 	//是否同步
@@ -235,6 +257,14 @@ type Chain33Client interface {
 	GetParaTxByHeight(ctx context.Context, in *ReqParaTxByHeight, opts ...grpc.CallOption) (*ParaTxDetails, error)
 	//获取区块头信息
 	GetHeaders(ctx context.Context, in *ReqBlocks, opts ...grpc.CallOption) (*Headers, error)
+	//流式订阅区块sequence, 区块回滚时推送del类型的sequence
+	SubscribeBlockSeqs(ctx context.Context, in *ReqSubscribeBlockSeqs, opts ...grpc.CallOption) (Chain33_SubscribeBlockSeqsClient, error)
+	//流式订阅指定合约或地址的交易回执
+	SubscribeTxReceipts(ctx context.Context, in *ReqSubscribeTxReceipts, opts ...grpc.CallOption) (Chain33_SubscribeTxReceiptsClient, error)
+	//在最新状态上模拟执行交易, 返回回执但不提交
+	SimulateTransaction(ctx context.Context, in *ReqSimulateTx, opts ...grpc.CallOption) (*ReplySimulateTx, error)
+	//获取指定状态下keys的value及mavl证明
+	GetStateProof(ctx context.Context, in *StoreGet, opts ...grpc.CallOption) (*ReplyStateProof, error)
 }
 
 type chain33Client struct {
@@ -371,6 +401,15 @@ func (c *chain33Client) ImportPrivkey(ctx context.Context, in *ReqWalletImportPr
 	return out, nil
 }
 
+func (c *chain33Client) ImportWatchOnly(ctx context.Context, in *ReqWalletImportWatchOnly, opts ...grpc.CallOption) (*WalletAccount, error) {
+	out := new(WalletAccount)
+	err := c.cc.Invoke(ctx, "/types.chain33/ImportWatchOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chain33Client) SendToAddress(ctx context.Context, in *ReqWalletSendToAddress, opts ...grpc.CallOption) (*ReplyHash, error) {
 	out := new(ReplyHash)
 	err := c.cc.Invoke(ctx, "/types.chain33/SendToAddress", in, out, opts...)
@@ -596,6 +635,42 @@ func (c *chain33Client) ImportPrivkeysFile(ctx context.Context, in *ReqPrivkeysF
 	return out, nil
 }
 
+func (c *chain33Client) ExportKeystore(ctx context.Context, in *ReqKeystoreFile, opts ...grpc.CallOption) (*ReplyStrings, error) {
+	out := new(ReplyStrings)
+	err := c.cc.Invoke(ctx, "/types.chain33/ExportKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) ImportKeystore(ctx context.Context, in *ReqKeystoreFile, opts ...grpc.CallOption) (*ReplyImportKeystore, error) {
+	out := new(ReplyImportKeystore)
+	err := c.cc.Invoke(ctx, "/types.chain33/ImportKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) RecoverAccounts(ctx context.Context, in *ReqRecoverAccounts, opts ...grpc.CallOption) (*HDAccounts, error) {
+	out := new(HDAccounts)
+	err := c.cc.Invoke(ctx, "/types.chain33/RecoverAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetHDAccounts(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*HDAccounts, error) {
+	out := new(HDAccounts)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetHDAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chain33Client) Version(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*VersionInfo, error) {
 	out := new(VersionInfo)
 	err := c.cc.Invoke(ctx, "/types.chain33/Version", in, out, opts...)
@@ -785,6 +860,88 @@ func (c *chain33Client) GetHeaders(ctx context.Context, in *ReqBlocks, opts ...g
 	return out, nil
 }
 
+func (c *chain33Client) SubscribeBlockSeqs(ctx context.Context, in *ReqSubscribeBlockSeqs, opts ...grpc.CallOption) (Chain33_SubscribeBlockSeqsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[0], "/types.chain33/SubscribeBlockSeqs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chain33SubscribeBlockSeqsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chain33_SubscribeBlockSeqsClient interface {
+	Recv() (*BlockSeq, error)
+	grpc.ClientStream
+}
+
+type chain33SubscribeBlockSeqsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chain33SubscribeBlockSeqsClient) Recv() (*BlockSeq, error) {
+	m := new(BlockSeq)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chain33Client) SubscribeTxReceipts(ctx context.Context, in *ReqSubscribeTxReceipts, opts ...grpc.CallOption) (Chain33_SubscribeTxReceiptsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[1], "/types.chain33/SubscribeTxReceipts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chain33SubscribeTxReceiptsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chain33_SubscribeTxReceiptsClient interface {
+	Recv() (*TxReceipts4SubscribePerBlk, error)
+	grpc.ClientStream
+}
+
+type chain33SubscribeTxReceiptsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chain33SubscribeTxReceiptsClient) Recv() (*TxReceipts4SubscribePerBlk, error) {
+	m := new(TxReceipts4SubscribePerBlk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chain33Client) SimulateTransaction(ctx context.Context, in *ReqSimulateTx, opts ...grpc.CallOption) (*ReplySimulateTx, error) {
+	out := new(ReplySimulateTx)
+	err := c.cc.Invoke(ctx, "/types.chain33/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetStateProof(ctx context.Context, in *StoreGet, opts ...grpc.CallOption) (*ReplyStateProof, error) {
+	out := new(ReplyStateProof)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Chain33Server is the server API for Chain33 service.
 type Chain33Server interface {
 	// chain33 对外提供服务的接口
@@ -816,6 +973,8 @@ type Chain33Server interface {
 	WalletTransactionList(context.Context, *ReqWalletTransactionList) (*WalletTxDetails, error)
 	//导入钱包私钥
 	ImportPrivkey(context.Context, *ReqWalletImportPrivkey) (*WalletAccount, error)
+	//导入只读账户
+	ImportWatchOnly(context.Context, *ReqWalletImportWatchOnly) (*WalletAccount, error)
 	// 发送交易
 	SendToAddress(context.Context, *ReqWalletSendToAddress) (*ReplyHash, error)
 	//设置交易手续费
@@ -863,6 +1022,14 @@ type Chain33Server interface {
 	DumpPrivkeysFile(context.Context, *ReqPrivkeysFile) (*Reply, error)
 	// 从文件中批量导入私钥
 	ImportPrivkeysFile(context.Context, *ReqPrivkeysFile) (*Reply, error)
+	// 导出账户私钥到keystore v3文件
+	ExportKeystore(context.Context, *ReqKeystoreFile) (*ReplyStrings, error)
+	// 从keystore v3文件导入私钥
+	ImportKeystore(context.Context, *ReqKeystoreFile) (*ReplyImportKeystore, error)
+	// 通过seed恢复链上使用过的账户
+	RecoverAccounts(context.Context, *ReqRecoverAccounts) (*HDAccounts, error)
+	// 获取通过seed生成的账户和生成路径
+	GetHDAccounts(context.Context, *ReqNil) (*HDAccounts, error)
 	//获取程序版本
 	Version(context.Context, *ReqNil) (*VersionInfo, error)
 	//是否同步
@@ -902,6 +1069,14 @@ type Chain33Server interface {
 	GetParaTxByHeight(context.Context, *ReqParaTxByHeight) (*ParaTxDetails, error)
 	//获取区块头信息
 	GetHeaders(context.Context, *ReqBlocks) (*Headers, error)
+	//流式订阅区块sequence, 区块回滚时推送del类型的sequence
+	SubscribeBlockSeqs(*ReqSubscribeBlockSeqs, Chain33_SubscribeBlockSeqsServer) error
+	//流式订阅指定合约或地址的交易回执
+	SubscribeTxReceipts(*ReqSubscribeTxReceipts, Chain33_SubscribeTxReceiptsServer) error
+	//在最新状态上模拟执行交易, 返回回执但不提交
+	SimulateTransaction(context.Context, *ReqSimulateTx) (*ReplySimulateTx, error)
+	//获取指定状态下keys的value及mavl证明
+	GetStateProof(context.Context, *StoreGet) (*ReplyStateProof, error)
 }
 
 // UnimplementedChain33Server can be embedded to have forward compatible implementations.
@@ -950,6 +1125,9 @@ func (*UnimplementedChain33Server) WalletTransactionList(ctx context.Context, re
 func (*UnimplementedChain33Server) ImportPrivkey(ctx context.Context, req *ReqWalletImportPrivkey) (*WalletAccount, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportPrivkey not implemented")
 }
+func (*UnimplementedChain33Server) ImportWatchOnly(ctx context.Context, req *ReqWalletImportWatchOnly) (*WalletAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWatchOnly not implemented")
+}
 func (*UnimplementedChain33Server) SendToAddress(ctx context.Context, req *ReqWalletSendToAddress) (*ReplyHash, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendToAddress not implemented")
 }
@@ -1025,6 +1203,18 @@ func (*UnimplementedChain33Server) DumpPrivkeysFile(ctx context.Context, req *Re
 func (*UnimplementedChain33Server) ImportPrivkeysFile(ctx context.Context, req *ReqPrivkeysFile) (*Reply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportPrivkeysFile not implemented")
 }
+func (*UnimplementedChain33Server) ExportKeystore(ctx context.Context, req *ReqKeystoreFile) (*ReplyStrings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportKeystore not implemented")
+}
+func (*UnimplementedChain33Server) ImportKeystore(ctx context.Context, req *ReqKeystoreFile) (*ReplyImportKeystore, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystore not implemented")
+}
+func (*UnimplementedChain33Server) RecoverAccounts(ctx context.Context, req *ReqRecoverAccounts) (*HDAccounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverAccounts not implemented")
+}
+func (*UnimplementedChain33Server) GetHDAccounts(ctx context.Context, req *ReqNil) (*HDAccounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHDAccounts not implemented")
+}
 func (*UnimplementedChain33Server) Version(ctx context.Context, req *ReqNil) (*VersionInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -1088,6 +1278,18 @@ func (*UnimplementedChain33Server) GetParaTxByHeight(ctx context.Context, req *R
 func (*UnimplementedChain33Server) GetHeaders(ctx context.Context, req *ReqBlocks) (*Headers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHeaders not implemented")
 }
+func (*UnimplementedChain33Server) SubscribeBlockSeqs(req *ReqSubscribeBlockSeqs, srv Chain33_SubscribeBlockSeqsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlockSeqs not implemented")
+}
+func (*UnimplementedChain33Server) SubscribeTxReceipts(req *ReqSubscribeTxReceipts, srv Chain33_SubscribeTxReceiptsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxReceipts not implemented")
+}
+func (*UnimplementedChain33Server) SimulateTransaction(ctx context.Context, req *ReqSimulateTx) (*ReplySimulateTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (*UnimplementedChain33Server) GetStateProof(ctx context.Context, req *StoreGet) (*ReplyStateProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProof not implemented")
+}
 
 func RegisterChain33Server(s *grpc.Server, srv Chain33Server) {
 	s.RegisterService(&_Chain33_serviceDesc, srv)
@@ -1345,6 +1547,24 @@ func _Chain33_ImportPrivkey_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_ImportWatchOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWalletImportWatchOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ImportWatchOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ImportWatchOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ImportWatchOnly(ctx, req.(*ReqWalletImportWatchOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chain33_SendToAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqWalletSendToAddress)
 	if err := dec(in); err != nil {
@@ -1795,6 +2015,78 @@ func _Chain33_ImportPrivkeysFile_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_ExportKeystore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqKeystoreFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ExportKeystore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ExportKeystore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ExportKeystore(ctx, req.(*ReqKeystoreFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_ImportKeystore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqKeystoreFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ImportKeystore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ImportKeystore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ImportKeystore(ctx, req.(*ReqKeystoreFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_RecoverAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRecoverAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).RecoverAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/RecoverAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).RecoverAccounts(ctx, req.(*ReqRecoverAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetHDAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetHDAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetHDAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetHDAccounts(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chain33_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqNil)
 	if err := dec(in); err != nil {
@@ -2173,6 +2465,84 @@ func _Chain33_GetHeaders_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_SubscribeBlockSeqs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqSubscribeBlockSeqs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Chain33Server).SubscribeBlockSeqs(m, &chain33SubscribeBlockSeqsServer{stream})
+}
+
+type Chain33_SubscribeBlockSeqsServer interface {
+	Send(*BlockSeq) error
+	grpc.ServerStream
+}
+
+type chain33SubscribeBlockSeqsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chain33SubscribeBlockSeqsServer) Send(m *BlockSeq) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chain33_SubscribeTxReceipts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqSubscribeTxReceipts)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Chain33Server).SubscribeTxReceipts(m, &chain33SubscribeTxReceiptsServer{stream})
+}
+
+type Chain33_SubscribeTxReceiptsServer interface {
+	Send(*TxReceipts4SubscribePerBlk) error
+	grpc.ServerStream
+}
+
+type chain33SubscribeTxReceiptsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chain33SubscribeTxReceiptsServer) Send(m *TxReceipts4SubscribePerBlk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chain33_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSimulateTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/SimulateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).SimulateTransaction(ctx, req.(*ReqSimulateTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetStateProof(ctx, req.(*StoreGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Chain33_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.chain33",
 	HandlerType: (*Chain33Server)(nil),
@@ -2233,6 +2603,10 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportPrivkey",
 			Handler:    _Chain33_ImportPrivkey_Handler,
 		},
+		{
+			MethodName: "ImportWatchOnly",
+			Handler:    _Chain33_ImportWatchOnly_Handler,
+		},
 		{
 			MethodName: "SendToAddress",
 			Handler:    _Chain33_SendToAddress_Handler,
@@ -2333,6 +2707,22 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportPrivkeysFile",
 			Handler:    _Chain33_ImportPrivkeysFile_Handler,
 		},
+		{
+			MethodName: "ExportKeystore",
+			Handler:    _Chain33_ExportKeystore_Handler,
+		},
+		{
+			MethodName: "ImportKeystore",
+			Handler:    _Chain33_ImportKeystore_Handler,
+		},
+		{
+			MethodName: "RecoverAccounts",
+			Handler:    _Chain33_RecoverAccounts_Handler,
+		},
+		{
+			MethodName: "GetHDAccounts",
+			Handler:    _Chain33_GetHDAccounts_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Chain33_Version_Handler,
@@ -2417,7 +2807,26 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHeaders",
 			Handler:    _Chain33_GetHeaders_Handler,
 		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _Chain33_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _Chain33_GetStateProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlockSeqs",
+			Handler:       _Chain33_SubscribeBlockSeqs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTxReceipts",
+			Handler:       _Chain33_SubscribeTxReceipts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
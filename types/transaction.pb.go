@@ -976,10 +976,12 @@ func (m *RingSignatureItem) GetSignature() [][]byte {
 // ty = 3 -> sm2
 // ty = 4 -> OnetimeED25519
 // ty = 5 -> RingBaseonED25519
+// ty = 7 -> MultiSign
 type Signature struct {
 	Ty     int32  `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
 	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	//当ty为5时，格式应该用RingSignature去解析
+	//当ty为7时，pubkey为MultiSignPubKey, signature为MultiSignSignature
 	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1032,6 +1034,190 @@ func (m *Signature) GetSignature() []byte {
 	return nil
 }
 
+// MultiSignKey 多重签名中的单个公钥
+type MultiSignKey struct {
+	Ty                   int32    `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSignKey) Reset()         { *m = MultiSignKey{} }
+func (m *MultiSignKey) String() string { return proto.CompactTextString(m) }
+func (*MultiSignKey) ProtoMessage()    {}
+func (*MultiSignKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{16}
+}
+
+func (m *MultiSignKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignKey.Unmarshal(m, b)
+}
+func (m *MultiSignKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignKey.Marshal(b, m, deterministic)
+}
+func (m *MultiSignKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignKey.Merge(m, src)
+}
+func (m *MultiSignKey) XXX_Size() int {
+	return xxx_messageInfo_MultiSignKey.Size(m)
+}
+func (m *MultiSignKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignKey proto.InternalMessageInfo
+
+func (m *MultiSignKey) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *MultiSignKey) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+// MultiSignPubKey m-of-n多重签名的公钥集合, 公钥按照字节序排序
+type MultiSignPubKey struct {
+	Threshold            int32           `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Keys                 []*MultiSignKey `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MultiSignPubKey) Reset()         { *m = MultiSignPubKey{} }
+func (m *MultiSignPubKey) String() string { return proto.CompactTextString(m) }
+func (*MultiSignPubKey) ProtoMessage()    {}
+func (*MultiSignPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{17}
+}
+
+func (m *MultiSignPubKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignPubKey.Unmarshal(m, b)
+}
+func (m *MultiSignPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignPubKey.Marshal(b, m, deterministic)
+}
+func (m *MultiSignPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignPubKey.Merge(m, src)
+}
+func (m *MultiSignPubKey) XXX_Size() int {
+	return xxx_messageInfo_MultiSignPubKey.Size(m)
+}
+func (m *MultiSignPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignPubKey proto.InternalMessageInfo
+
+func (m *MultiSignPubKey) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultiSignPubKey) GetKeys() []*MultiSignKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// MultiSignItem 单个公钥的签名, index为公钥在MultiSignPubKey中的下标
+type MultiSignItem struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSignItem) Reset()         { *m = MultiSignItem{} }
+func (m *MultiSignItem) String() string { return proto.CompactTextString(m) }
+func (*MultiSignItem) ProtoMessage()    {}
+func (*MultiSignItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{18}
+}
+
+func (m *MultiSignItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignItem.Unmarshal(m, b)
+}
+func (m *MultiSignItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignItem.Marshal(b, m, deterministic)
+}
+func (m *MultiSignItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignItem.Merge(m, src)
+}
+func (m *MultiSignItem) XXX_Size() int {
+	return xxx_messageInfo_MultiSignItem.Size(m)
+}
+func (m *MultiSignItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignItem proto.InternalMessageInfo
+
+func (m *MultiSignItem) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MultiSignItem) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MultiSignSignature 多重签名, 签名按照index排序, 达到门限之前为部分签名
+type MultiSignSignature struct {
+	Sigs                 []*MultiSignItem `protobuf:"bytes,1,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MultiSignSignature) Reset()         { *m = MultiSignSignature{} }
+func (m *MultiSignSignature) String() string { return proto.CompactTextString(m) }
+func (*MultiSignSignature) ProtoMessage()    {}
+func (*MultiSignSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{19}
+}
+
+func (m *MultiSignSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignSignature.Unmarshal(m, b)
+}
+func (m *MultiSignSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignSignature.Marshal(b, m, deterministic)
+}
+func (m *MultiSignSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignSignature.Merge(m, src)
+}
+func (m *MultiSignSignature) XXX_Size() int {
+	return xxx_messageInfo_MultiSignSignature.Size(m)
+}
+func (m *MultiSignSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignSignature proto.InternalMessageInfo
+
+func (m *MultiSignSignature) GetSigs() []*MultiSignItem {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
 type AddrOverview struct {
 	Reciver              int64    `protobuf:"varint,1,opt,name=reciver,proto3" json:"reciver,omitempty"`
 	Balance              int64    `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
@@ -1045,7 +1231,7 @@ func (m *AddrOverview) Reset()         { *m = AddrOverview{} }
 func (m *AddrOverview) String() string { return proto.CompactTextString(m) }
 func (*AddrOverview) ProtoMessage()    {}
 func (*AddrOverview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{16}
+	return fileDescriptor_2cc4e03d2c28c490, []int{20}
 }
 
 func (m *AddrOverview) XXX_Unmarshal(b []byte) error {
@@ -1104,7 +1290,7 @@ func (m *ReqAddr) Reset()         { *m = ReqAddr{} }
 func (m *ReqAddr) String() string { return proto.CompactTextString(m) }
 func (*ReqAddr) ProtoMessage()    {}
 func (*ReqAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{17}
+	return fileDescriptor_2cc4e03d2c28c490, []int{21}
 }
 
 func (m *ReqAddr) XXX_Unmarshal(b []byte) error {
@@ -1178,7 +1364,7 @@ func (m *HexTx) Reset()         { *m = HexTx{} }
 func (m *HexTx) String() string { return proto.CompactTextString(m) }
 func (*HexTx) ProtoMessage()    {}
 func (*HexTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{18}
+	return fileDescriptor_2cc4e03d2c28c490, []int{22}
 }
 
 func (m *HexTx) XXX_Unmarshal(b []byte) error {
@@ -1220,7 +1406,7 @@ func (m *ReplyTxInfo) Reset()         { *m = ReplyTxInfo{} }
 func (m *ReplyTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxInfo) ProtoMessage()    {}
 func (*ReplyTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{19}
+	return fileDescriptor_2cc4e03d2c28c490, []int{23}
 }
 
 func (m *ReplyTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1280,7 +1466,7 @@ func (m *ReqTxList) Reset()         { *m = ReqTxList{} }
 func (m *ReqTxList) String() string { return proto.CompactTextString(m) }
 func (*ReqTxList) ProtoMessage()    {}
 func (*ReqTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{20}
+	return fileDescriptor_2cc4e03d2c28c490, []int{24}
 }
 
 func (m *ReqTxList) XXX_Unmarshal(b []byte) error {
@@ -1319,7 +1505,7 @@ func (m *ReplyTxList) Reset()         { *m = ReplyTxList{} }
 func (m *ReplyTxList) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxList) ProtoMessage()    {}
 func (*ReplyTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{21}
+	return fileDescriptor_2cc4e03d2c28c490, []int{25}
 }
 
 func (m *ReplyTxList) XXX_Unmarshal(b []byte) error {
@@ -1358,7 +1544,7 @@ func (m *ReqGetMempool) Reset()         { *m = ReqGetMempool{} }
 func (m *ReqGetMempool) String() string { return proto.CompactTextString(m) }
 func (*ReqGetMempool) ProtoMessage()    {}
 func (*ReqGetMempool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{22}
+	return fileDescriptor_2cc4e03d2c28c490, []int{26}
 }
 
 func (m *ReqGetMempool) XXX_Unmarshal(b []byte) error {
@@ -1398,7 +1584,7 @@ func (m *ReqProperFee) Reset()         { *m = ReqProperFee{} }
 func (m *ReqProperFee) String() string { return proto.CompactTextString(m) }
 func (*ReqProperFee) ProtoMessage()    {}
 func (*ReqProperFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{23}
+	return fileDescriptor_2cc4e03d2c28c490, []int{27}
 }
 
 func (m *ReqProperFee) XXX_Unmarshal(b []byte) error {
@@ -1444,7 +1630,7 @@ func (m *ReplyProperFee) Reset()         { *m = ReplyProperFee{} }
 func (m *ReplyProperFee) String() string { return proto.CompactTextString(m) }
 func (*ReplyProperFee) ProtoMessage()    {}
 func (*ReplyProperFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{24}
+	return fileDescriptor_2cc4e03d2c28c490, []int{28}
 }
 
 func (m *ReplyProperFee) XXX_Unmarshal(b []byte) error {
@@ -1485,7 +1671,7 @@ func (m *TxHashList) Reset()         { *m = TxHashList{} }
 func (m *TxHashList) String() string { return proto.CompactTextString(m) }
 func (*TxHashList) ProtoMessage()    {}
 func (*TxHashList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{25}
+	return fileDescriptor_2cc4e03d2c28c490, []int{29}
 }
 
 func (m *TxHashList) XXX_Unmarshal(b []byte) error {
@@ -1538,7 +1724,7 @@ func (m *ReplyTxInfos) Reset()         { *m = ReplyTxInfos{} }
 func (m *ReplyTxInfos) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxInfos) ProtoMessage()    {}
 func (*ReplyTxInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{26}
+	return fileDescriptor_2cc4e03d2c28c490, []int{30}
 }
 
 func (m *ReplyTxInfos) XXX_Unmarshal(b []byte) error {
@@ -1578,7 +1764,7 @@ func (m *ReceiptLog) Reset()         { *m = ReceiptLog{} }
 func (m *ReceiptLog) String() string { return proto.CompactTextString(m) }
 func (*ReceiptLog) ProtoMessage()    {}
 func (*ReceiptLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{27}
+	return fileDescriptor_2cc4e03d2c28c490, []int{31}
 }
 
 func (m *ReceiptLog) XXX_Unmarshal(b []byte) error {
@@ -1629,7 +1815,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{28}
+	return fileDescriptor_2cc4e03d2c28c490, []int{32}
 }
 
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
@@ -1683,7 +1869,7 @@ func (m *ReceiptData) Reset()         { *m = ReceiptData{} }
 func (m *ReceiptData) String() string { return proto.CompactTextString(m) }
 func (*ReceiptData) ProtoMessage()    {}
 func (*ReceiptData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{29}
+	return fileDescriptor_2cc4e03d2c28c490, []int{33}
 }
 
 func (m *ReceiptData) XXX_Unmarshal(b []byte) error {
@@ -1718,6 +1904,111 @@ func (m *ReceiptData) GetLogs() []*ReceiptLog {
 	return nil
 }
 
+// 模拟执行交易, 未签名的交易需要提供发送者公钥用于计算地址和手续费
+type ReqSimulateTx struct {
+	Tx                   *Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Pubkey               []byte       `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReqSimulateTx) Reset()         { *m = ReqSimulateTx{} }
+func (m *ReqSimulateTx) String() string { return proto.CompactTextString(m) }
+func (*ReqSimulateTx) ProtoMessage()    {}
+func (*ReqSimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{34}
+}
+
+func (m *ReqSimulateTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSimulateTx.Unmarshal(m, b)
+}
+func (m *ReqSimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSimulateTx.Marshal(b, m, deterministic)
+}
+func (m *ReqSimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSimulateTx.Merge(m, src)
+}
+func (m *ReqSimulateTx) XXX_Size() int {
+	return xxx_messageInfo_ReqSimulateTx.Size(m)
+}
+func (m *ReqSimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSimulateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSimulateTx proto.InternalMessageInfo
+
+func (m *ReqSimulateTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *ReqSimulateTx) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type ReplySimulateTx struct {
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	//交易需要支付的手续费
+	Fee int64 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	//模拟执行的区块高度
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplySimulateTx) Reset()         { *m = ReplySimulateTx{} }
+func (m *ReplySimulateTx) String() string { return proto.CompactTextString(m) }
+func (*ReplySimulateTx) ProtoMessage()    {}
+func (*ReplySimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{35}
+}
+
+func (m *ReplySimulateTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplySimulateTx.Unmarshal(m, b)
+}
+func (m *ReplySimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplySimulateTx.Marshal(b, m, deterministic)
+}
+func (m *ReplySimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySimulateTx.Merge(m, src)
+}
+func (m *ReplySimulateTx) XXX_Size() int {
+	return xxx_messageInfo_ReplySimulateTx.Size(m)
+}
+func (m *ReplySimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplySimulateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplySimulateTx proto.InternalMessageInfo
+
+func (m *ReplySimulateTx) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReplySimulateTx) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ReplySimulateTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type TxResult struct {
 	Height               int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Index                int32        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -1734,7 +2025,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{30}
+	return fileDescriptor_2cc4e03d2c28c490, []int{36}
 }
 
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
@@ -1819,7 +2110,7 @@ func (m *TransactionDetail) Reset()         { *m = TransactionDetail{} }
 func (m *TransactionDetail) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetail) ProtoMessage()    {}
 func (*TransactionDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{31}
+	return fileDescriptor_2cc4e03d2c28c490, []int{37}
 }
 
 func (m *TransactionDetail) XXX_Unmarshal(b []byte) error {
@@ -1935,7 +2226,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{32}
+	return fileDescriptor_2cc4e03d2c28c490, []int{38}
 }
 
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
@@ -1974,7 +2265,7 @@ func (m *ReqAddrs) Reset()         { *m = ReqAddrs{} }
 func (m *ReqAddrs) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrs) ProtoMessage()    {}
 func (*ReqAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{33}
+	return fileDescriptor_2cc4e03d2c28c490, []int{39}
 }
 
 func (m *ReqAddrs) XXX_Unmarshal(b []byte) error {
@@ -2013,7 +2304,7 @@ func (m *ReqDecodeRawTransaction) Reset()         { *m = ReqDecodeRawTransaction
 func (m *ReqDecodeRawTransaction) String() string { return proto.CompactTextString(m) }
 func (*ReqDecodeRawTransaction) ProtoMessage()    {}
 func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{34}
+	return fileDescriptor_2cc4e03d2c28c490, []int{40}
 }
 
 func (m *ReqDecodeRawTransaction) XXX_Unmarshal(b []byte) error {
@@ -2053,7 +2344,7 @@ func (m *UserWrite) Reset()         { *m = UserWrite{} }
 func (m *UserWrite) String() string { return proto.CompactTextString(m) }
 func (*UserWrite) ProtoMessage()    {}
 func (*UserWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{35}
+	return fileDescriptor_2cc4e03d2c28c490, []int{41}
 }
 
 func (m *UserWrite) XXX_Unmarshal(b []byte) error {
@@ -2101,7 +2392,7 @@ func (m *UpgradeMeta) Reset()         { *m = UpgradeMeta{} }
 func (m *UpgradeMeta) String() string { return proto.CompactTextString(m) }
 func (*UpgradeMeta) ProtoMessage()    {}
 func (*UpgradeMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{36}
+	return fileDescriptor_2cc4e03d2c28c490, []int{42}
 }
 
 func (m *UpgradeMeta) XXX_Unmarshal(b []byte) error {
@@ -2156,7 +2447,7 @@ func (m *ReqTxHashList) Reset()         { *m = ReqTxHashList{} }
 func (m *ReqTxHashList) String() string { return proto.CompactTextString(m) }
 func (*ReqTxHashList) ProtoMessage()    {}
 func (*ReqTxHashList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{37}
+	return fileDescriptor_2cc4e03d2c28c490, []int{43}
 }
 
 func (m *ReqTxHashList) XXX_Unmarshal(b []byte) error {
@@ -2205,7 +2496,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{38}
+	return fileDescriptor_2cc4e03d2c28c490, []int{44}
 }
 
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
@@ -2264,6 +2555,10 @@ func init() {
 	proto.RegisterType((*RingSignature)(nil), "types.RingSignature")
 	proto.RegisterType((*RingSignatureItem)(nil), "types.RingSignatureItem")
 	proto.RegisterType((*Signature)(nil), "types.Signature")
+	proto.RegisterType((*MultiSignKey)(nil), "types.MultiSignKey")
+	proto.RegisterType((*MultiSignPubKey)(nil), "types.MultiSignPubKey")
+	proto.RegisterType((*MultiSignItem)(nil), "types.MultiSignItem")
+	proto.RegisterType((*MultiSignSignature)(nil), "types.MultiSignSignature")
 	proto.RegisterType((*AddrOverview)(nil), "types.AddrOverview")
 	proto.RegisterType((*ReqAddr)(nil), "types.ReqAddr")
 	proto.RegisterType((*HexTx)(nil), "types.HexTx")
@@ -2278,6 +2573,8 @@ func init() {
 	proto.RegisterType((*ReceiptLog)(nil), "types.ReceiptLog")
 	proto.RegisterType((*Receipt)(nil), "types.Receipt")
 	proto.RegisterType((*ReceiptData)(nil), "types.ReceiptData")
+	proto.RegisterType((*ReqSimulateTx)(nil), "types.ReqSimulateTx")
+	proto.RegisterType((*ReplySimulateTx)(nil), "types.ReplySimulateTx")
 	proto.RegisterType((*TxResult)(nil), "types.TxResult")
 	proto.RegisterType((*TransactionDetail)(nil), "types.TransactionDetail")
 	proto.RegisterType((*TransactionDetails)(nil), "types.TransactionDetails")
@@ -2294,97 +2591,105 @@ func init() {
 }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 1465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x5f, 0x6f, 0x13, 0x49,
-	0x12, 0x97, 0x3d, 0x76, 0x62, 0x97, 0x9d, 0x1c, 0x19, 0x21, 0xb0, 0x22, 0x0e, 0x72, 0x2d, 0x90,
-	0x10, 0x42, 0x8e, 0x94, 0xf0, 0x76, 0x27, 0x1d, 0x90, 0xdc, 0x41, 0x14, 0xe0, 0xb8, 0x8e, 0x01,
-	0xe9, 0xee, 0x5e, 0x3a, 0xe3, 0x8a, 0x3d, 0xc7, 0x78, 0xda, 0xe9, 0x69, 0x87, 0xf1, 0x7e, 0x80,
-	0x7d, 0xd9, 0x7d, 0xdb, 0x0f, 0xb6, 0xfb, 0x31, 0xf6, 0x63, 0xac, 0xba, 0xba, 0x7b, 0xa6, 0x9d,
-	0x3f, 0x2b, 0x1e, 0x90, 0xf6, 0x6d, 0x7e, 0xd5, 0xe5, 0xfa, 0xfb, 0xab, 0xea, 0x36, 0x6c, 0x69,
-	0x25, 0xf2, 0x42, 0x24, 0x3a, 0x95, 0xf9, 0x70, 0xae, 0xa4, 0x96, 0x71, 0x5b, 0x2f, 0xe7, 0x58,
-	0x6c, 0xf7, 0x13, 0x39, 0x9b, 0x79, 0x21, 0x7b, 0x0b, 0x1b, 0x2f, 0x8a, 0x02, 0x75, 0xf1, 0x0a,
-	0x73, 0x2c, 0xd2, 0x22, 0xbe, 0x03, 0x6b, 0x62, 0x26, 0x17, 0xb9, 0x1e, 0x34, 0x77, 0x1a, 0x8f,
-	0x23, 0xee, 0x50, 0xfc, 0x10, 0x36, 0x14, 0xea, 0x85, 0xca, 0x5f, 0x8c, 0xc7, 0x0a, 0x8b, 0x62,
-	0x10, 0xed, 0x34, 0x1e, 0x77, 0xf9, 0xaa, 0x90, 0xfd, 0xd8, 0x80, 0xdb, 0xd6, 0xde, 0xc8, 0xf8,
-	0x3f, 0x43, 0x35, 0x92, 0xff, 0x28, 0x31, 0x89, 0xef, 0x41, 0x37, 0x91, 0x69, 0xae, 0xe5, 0x67,
-	0xcc, 0x07, 0x0d, 0xfa, 0x69, 0x2d, 0xb8, 0xd1, 0x69, 0x0c, 0xad, 0x5c, 0x6a, 0x24, 0x5f, 0x7d,
-	0x4e, 0xdf, 0xf1, 0x36, 0x74, 0xb0, 0xc4, 0xe4, 0x9d, 0x98, 0xe1, 0xa0, 0x45, 0x86, 0x2a, 0x1c,
-	0x6f, 0x42, 0x53, 0xcb, 0x41, 0x9b, 0xa4, 0x4d, 0x2d, 0xd9, 0xf7, 0x0d, 0xd8, 0xb4, 0xe1, 0x7c,
-	0x4a, 0xf5, 0x74, 0xac, 0xc4, 0x97, 0x3f, 0x28, 0x90, 0xff, 0xfb, 0x38, 0x7c, 0x59, 0xbe, 0x61,
-	0x1c, 0xd6, 0x57, 0xab, 0xf2, 0x75, 0x0c, 0x6d, 0xf2, 0x65, 0x94, 0x4d, 0x40, 0xce, 0x3a, 0x7d,
-	0x1b, 0xc3, 0xc5, 0x72, 0x76, 0x2a, 0x33, 0x32, 0xdc, 0xe5, 0x0e, 0x05, 0x0e, 0xa3, 0xd0, 0x21,
-	0xfb, 0xb5, 0x01, 0x9d, 0x03, 0x85, 0x42, 0xe3, 0xa8, 0x74, 0x9e, 0x1a, 0xde, 0xd3, 0x8d, 0x51,
-	0xde, 0x82, 0xe8, 0x0c, 0xd1, 0x59, 0x32, 0x9f, 0x55, 0xdc, 0xad, 0x20, 0xee, 0xfb, 0x00, 0x69,
-	0xd5, 0x17, 0xaa, 0x55, 0x87, 0x07, 0x92, 0x78, 0x00, 0xeb, 0x69, 0x31, 0xa2, 0xfa, 0xac, 0xd1,
-	0xa1, 0x87, 0xf1, 0x0e, 0xf4, 0xa8, 0x4c, 0x27, 0x36, 0x93, 0x75, 0x0a, 0x28, 0x14, 0xad, 0xf4,
-	0xa6, 0x73, 0xa9, 0x37, 0x77, 0x60, 0xcd, 0x7c, 0xa3, 0x1a, 0x74, 0x6d, 0x09, 0x2c, 0x62, 0x39,
-	0xf4, 0x39, 0x7e, 0x52, 0xa9, 0x46, 0x2e, 0xbe, 0xb8, 0x6c, 0xcb, 0x2a, 0x5b, 0x9f, 0x7d, 0x14,
-	0x66, 0x8f, 0xe5, 0x3c, 0x55, 0xbe, 0xfb, 0x0e, 0xf9, 0xec, 0xdb, 0x75, 0xf6, 0xb7, 0xa1, 0x9d,
-	0xe6, 0x63, 0x2c, 0x29, 0x8f, 0x36, 0xb7, 0x80, 0x3d, 0x81, 0x3b, 0xae, 0xb2, 0xf5, 0xa8, 0xbe,
-	0x52, 0x72, 0x31, 0x37, 0x16, 0x74, 0x59, 0x0c, 0x1a, 0x3b, 0xd1, 0xe3, 0x2e, 0x37, 0x9f, 0xec,
-	0x3e, 0x74, 0x3e, 0xe4, 0x45, 0x3a, 0xc9, 0x47, 0xa5, 0xa9, 0xe5, 0x58, 0x68, 0x41, 0x91, 0xf5,
-	0x39, 0x7d, 0x33, 0x05, 0xfd, 0x77, 0xf2, 0xa5, 0xc8, 0x44, 0x9e, 0xe0, 0xa8, 0xa4, 0x29, 0xd6,
-	0xe5, 0x6b, 0xac, 0x8c, 0x38, 0x64, 0x6a, 0x3a, 0x17, 0x4b, 0x33, 0xad, 0xae, 0xff, 0x1e, 0xd2,
-	0x89, 0x4a, 0x2f, 0x3e, 0xe3, 0xd2, 0xa5, 0xe8, 0xe1, 0x4d, 0x79, 0x32, 0x09, 0xbd, 0xc0, 0xa7,
-	0x49, 0x92, 0x9c, 0xb8, 0x8a, 0x59, 0xf0, 0x4d, 0x1d, 0xfe, 0xdc, 0x84, 0x5e, 0x50, 0xab, 0xa0,
-	0x91, 0xb6, 0x14, 0x0e, 0x39, 0x9f, 0x99, 0x14, 0x63, 0xf2, 0xd9, 0xe7, 0x1e, 0xc6, 0x43, 0xe8,
-	0x9a, 0x22, 0x0a, 0xbd, 0x50, 0x96, 0x9e, 0xbd, 0xbd, 0x5b, 0x43, 0x5a, 0x8b, 0xc3, 0x13, 0x2f,
-	0xe7, 0xb5, 0x8a, 0x6f, 0x65, 0xab, 0x6e, 0x65, 0x1d, 0x9b, 0xed, 0xaf, 0x6f, 0xfa, 0x6d, 0x68,
-	0xe7, 0x32, 0x4f, 0x90, 0x5a, 0x1c, 0x71, 0x0b, 0x1c, 0x65, 0xd6, 0x2b, 0xca, 0xdc, 0x07, 0x98,
-	0x98, 0x0e, 0x1f, 0xd0, 0xd0, 0x74, 0x88, 0x0d, 0x81, 0xc4, 0x58, 0x9f, 0xa2, 0x18, 0x3b, 0x6a,
-	0xf6, 0xb9, 0x43, 0x34, 0x3e, 0x58, 0xea, 0x01, 0xb8, 0xf1, 0xc1, 0x52, 0x9b, 0x05, 0x32, 0x15,
-	0xc5, 0xf4, 0x40, 0x24, 0x53, 0x1c, 0xf4, 0xe8, 0xa0, 0x16, 0x98, 0x75, 0x7d, 0xb6, 0xc8, 0xb2,
-	0xd7, 0x95, 0x46, 0x9f, 0x34, 0x56, 0x85, 0xec, 0x19, 0xf4, 0x83, 0x82, 0x16, 0xf1, 0xc3, 0x9a,
-	0x78, 0xbd, 0xbd, 0xd8, 0x55, 0x26, 0xd0, 0xb0, 0x64, 0xfc, 0x3b, 0x6c, 0xf0, 0x34, 0x9f, 0x54,
-	0x15, 0x8b, 0x87, 0xd0, 0x4e, 0x35, 0xce, 0xfc, 0x0f, 0x07, 0xee, 0x87, 0x2b, 0x4a, 0x47, 0x1a,
-	0x67, 0xdc, 0xaa, 0xb1, 0x23, 0xd8, 0xba, 0x72, 0x66, 0x72, 0x9f, 0x2f, 0x4e, 0x0d, 0x1d, 0x8c,
-	0x95, 0x3e, 0x77, 0xc8, 0xe4, 0x59, 0xf7, 0xac, 0x49, 0x47, 0xb5, 0x80, 0xfd, 0x1b, 0xba, 0x75,
-	0x1c, 0xa6, 0xdc, 0x4b, 0x22, 0x43, 0x9b, 0x37, 0xf5, 0x32, 0x30, 0x69, 0x79, 0x70, 0xad, 0x49,
-	0xbb, 0x4a, 0x03, 0x93, 0xff, 0x83, 0xbe, 0x21, 0xe8, 0xbf, 0x2e, 0x50, 0x5d, 0xa4, 0x48, 0x7b,
-	0x48, 0x61, 0x92, 0x5e, 0x38, 0x9e, 0x45, 0xdc, 0x43, 0x73, 0x72, 0x6a, 0xf9, 0xef, 0x16, 0xa0,
-	0x87, 0xe6, 0x44, 0x97, 0x07, 0xc1, 0x3e, 0xf5, 0x90, 0xfd, 0xd4, 0x80, 0x75, 0x8e, 0xe7, 0x34,
-	0x02, 0x31, 0xb4, 0x84, 0x99, 0x0c, 0xb7, 0xa0, 0x85, 0x93, 0x9d, 0x65, 0x62, 0x42, 0x06, 0xdb,
-	0x9c, 0xbe, 0x0d, 0xb9, 0x92, 0xca, 0x56, 0x9b, 0x5b, 0x60, 0xb2, 0x18, 0xa7, 0x0a, 0xa9, 0x31,
-	0x44, 0xd1, 0x36, 0xaf, 0x05, 0x96, 0x4a, 0xe9, 0x64, 0xaa, 0x3d, 0x51, 0x2d, 0x5a, 0xdd, 0x45,
-	0x91, 0xdf, 0x45, 0x77, 0xa1, 0xfd, 0x1a, 0xcb, 0xab, 0x4b, 0x8f, 0x2d, 0xa0, 0xc7, 0x71, 0x9e,
-	0x2d, 0x47, 0xe5, 0x51, 0x7e, 0x26, 0x4d, 0x74, 0x86, 0x63, 0x7e, 0xf7, 0x98, 0xef, 0xc0, 0x53,
-	0xf3, 0x7a, 0x4f, 0x51, 0xe0, 0x29, 0x7e, 0x08, 0x6b, 0x82, 0x6e, 0xc2, 0x41, 0x8b, 0xc8, 0xd2,
-	0x77, 0x64, 0xa1, 0x2b, 0x8b, 0xbb, 0x33, 0xf6, 0x17, 0xe8, 0x72, 0x3c, 0x1f, 0x95, 0x6f, 0xd2,
-	0x42, 0xd7, 0xe9, 0xdb, 0xf2, 0x5b, 0xc0, 0xf6, 0xab, 0xc8, 0x48, 0xe9, 0xeb, 0xa8, 0xfb, 0x08,
-	0x36, 0x38, 0x9e, 0xbf, 0x42, 0xfd, 0x16, 0x67, 0x73, 0x29, 0x33, 0x0a, 0xb2, 0x78, 0x91, 0x65,
-	0x64, 0xbb, 0xc3, 0x2d, 0x60, 0xcf, 0xcd, 0x55, 0x70, 0xfe, 0x5e, 0xc9, 0x39, 0xaa, 0x7f, 0xe2,
-	0x4a, 0x3b, 0x2d, 0xbb, 0x3c, 0xb4, 0x8b, 0xf6, 0x24, 0xfd, 0x0e, 0x5d, 0xc3, 0x1c, 0x62, 0x43,
-	0xd8, 0xa4, 0xe8, 0x6a, 0x1b, 0xf7, 0xa0, 0x3b, 0xf7, 0xc0, 0x65, 0x52, 0x0b, 0x18, 0x07, 0x18,
-	0x95, 0x66, 0x30, 0x29, 0x19, 0x53, 0x52, 0x51, 0x4c, 0xb1, 0xf0, 0xb3, 0x60, 0x51, 0x5d, 0x89,
-	0x66, 0x50, 0x89, 0x60, 0x27, 0x45, 0x3b, 0x51, 0xbd, 0x93, 0xd8, 0xdf, 0x4c, 0x16, 0x55, 0xef,
-	0x8a, 0xf8, 0xa9, 0xc9, 0x82, 0x3e, 0x2f, 0x95, 0x29, 0xd0, 0xe2, 0x5e, 0x85, 0x0d, 0x01, 0x38,
-	0x26, 0x98, 0xce, 0xf5, 0x1b, 0x39, 0xb9, 0x32, 0x5a, 0xb7, 0x20, 0xca, 0xe4, 0xc4, 0xcd, 0x95,
-	0xf9, 0x64, 0xc2, 0xf0, 0x9a, 0xf4, 0xaf, 0x28, 0x3f, 0x80, 0xe6, 0xf1, 0x47, 0x9a, 0xdd, 0xde,
-	0xde, 0x9f, 0x9c, 0xcf, 0x63, 0x5c, 0x7e, 0x14, 0xd9, 0x02, 0x79, 0xf3, 0xf8, 0x63, 0xfc, 0x08,
-	0x5a, 0x99, 0x9c, 0x14, 0x14, 0x7f, 0x6f, 0x6f, 0xab, 0x0a, 0xcb, 0xbb, 0xe7, 0x74, 0xcc, 0x0e,
-	0x4d, 0xcb, 0x49, 0x76, 0x28, 0xb4, 0xb8, 0xe2, 0xe6, 0x2b, 0xad, 0xfc, 0xd2, 0x80, 0xce, 0xa8,
-	0xe4, 0x58, 0x2c, 0x32, 0x1d, 0x90, 0xb7, 0x71, 0x3d, 0x79, 0x9b, 0xc1, 0x95, 0x1d, 0x33, 0x9a,
-	0x0e, 0x7b, 0x71, 0x5c, 0xc7, 0x31, 0xf3, 0x4c, 0x78, 0x06, 0x3d, 0x65, 0x5d, 0x8e, 0x85, 0x7b,
-	0xf1, 0x84, 0x95, 0xae, 0xc2, 0xe7, 0xa1, 0x9a, 0x61, 0xc7, 0x69, 0x26, 0x93, 0xcf, 0x3a, 0x9d,
-	0xf9, 0xab, 0xa5, 0x16, 0x98, 0x7b, 0xc3, 0x7a, 0xa0, 0x07, 0xcd, 0x1a, 0x4d, 0x67, 0x20, 0x61,
-	0x3f, 0x44, 0xb0, 0x15, 0xc4, 0x71, 0x88, 0x5a, 0xa4, 0x99, 0x8b, 0xb6, 0xf1, 0xbb, 0xd1, 0x3e,
-	0xa5, 0xe5, 0x66, 0xc2, 0xa0, 0x4c, 0xaf, 0x8f, 0xd4, 0xab, 0xd0, 0x42, 0x55, 0x52, 0x9e, 0xd9,
-	0x1a, 0x9b, 0x85, 0x4a, 0x28, 0xa8, 0x62, 0xeb, 0xfa, 0x2a, 0xb6, 0xc3, 0x15, 0xb0, 0x92, 0xeb,
-	0xda, 0xe5, 0x5c, 0xeb, 0x47, 0xe5, 0xfa, 0xca, 0xa3, 0x72, 0x1b, 0x3a, 0x67, 0x4a, 0xce, 0x68,
-	0x61, 0xba, 0x27, 0x9d, 0xc7, 0x97, 0xea, 0xd3, 0xbd, 0x5c, 0x9f, 0x60, 0xe9, 0xc0, 0xcd, 0x4b,
-	0x27, 0x7e, 0x02, 0x1d, 0x5d, 0xbe, 0xb7, 0xf9, 0xf5, 0x48, 0x6f, 0xd3, 0x57, 0xcd, 0x8a, 0x79,
-	0x75, 0x4e, 0xd1, 0xb8, 0xab, 0xd4, 0x5d, 0xad, 0x15, 0x66, 0xcf, 0x21, 0xbe, 0xd2, 0x0c, 0x63,
-	0x3d, 0x58, 0x50, 0x83, 0xab, 0xed, 0xb0, 0x7a, 0x76, 0x4d, 0xed, 0x40, 0xc7, 0xdd, 0x11, 0x34,
-	0xf3, 0x26, 0x47, 0xff, 0x92, 0xb3, 0x80, 0xed, 0xc2, 0x5d, 0x8e, 0xe7, 0x87, 0x98, 0xc8, 0x31,
-	0x3d, 0x57, 0x83, 0x67, 0xd1, 0xb5, 0x0f, 0x31, 0xf6, 0x57, 0xe8, 0x7e, 0x28, 0x50, 0xd1, 0xfb,
-	0x96, 0x54, 0xe4, 0x3c, 0x4d, 0x2a, 0x15, 0x03, 0xcc, 0x96, 0x4b, 0x64, 0xae, 0xd1, 0xed, 0x97,
-	0x2e, 0xf7, 0x90, 0xfd, 0x17, 0x7a, 0x1f, 0xe6, 0x13, 0x25, 0xc6, 0xf8, 0x16, 0xb5, 0x30, 0xc9,
-	0x17, 0x5a, 0x28, 0x9d, 0xe6, 0x13, 0xb7, 0x37, 0x2b, 0x6c, 0x8c, 0x5c, 0xa0, 0x2a, 0xcc, 0x9d,
-	0xe4, 0x8c, 0x38, 0x18, 0x90, 0x24, 0x0a, 0x49, 0xc2, 0x8e, 0x68, 0x27, 0xdf, 0xb8, 0xfd, 0xba,
-	0xd5, 0xf6, 0xdb, 0x81, 0x5e, 0x5a, 0x9c, 0x4c, 0xa5, 0xd2, 0x54, 0xf6, 0x26, 0x79, 0x0e, 0x45,
-	0xec, 0x04, 0xd6, 0x5d, 0xab, 0x02, 0xaa, 0x36, 0x56, 0xa8, 0xba, 0x32, 0xd8, 0x1b, 0x9e, 0x92,
-	0xdb, 0xd0, 0x51, 0x52, 0x5a, 0xbb, 0xf6, 0x41, 0x50, 0xe1, 0x97, 0x0f, 0xfe, 0xf3, 0xe7, 0x49,
-	0xaa, 0xa7, 0x8b, 0xd3, 0x61, 0x22, 0x67, 0xbb, 0xfb, 0xfb, 0x49, 0xbe, 0x9b, 0x4c, 0x45, 0x9a,
-	0xef, 0xef, 0xef, 0x52, 0x13, 0x4f, 0xd7, 0xe8, 0xaf, 0xf4, 0xfe, 0x6f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xb2, 0x93, 0xef, 0xba, 0x74, 0x0f, 0x00, 0x00,
+	// 1590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0x97, 0xbd, 0x76, 0x62, 0x1f, 0x3b, 0x69, 0xb3, 0xff, 0xaa, 0xb5, 0xa2, 0xfe, 0x5b, 0x33,
+	0x6a, 0x45, 0x54, 0x55, 0x8e, 0x94, 0x54, 0xdc, 0x80, 0xa0, 0x6d, 0x02, 0x6d, 0x94, 0xa6, 0x94,
+	0x89, 0xdb, 0x22, 0xe0, 0x66, 0xb2, 0x3e, 0xb1, 0x97, 0xac, 0x77, 0x9c, 0xdd, 0x71, 0xba, 0xe6,
+	0x01, 0xb8, 0x81, 0x3b, 0x1e, 0x0c, 0x1e, 0x83, 0xc7, 0x40, 0x73, 0x66, 0x76, 0x77, 0xec, 0xc4,
+	0x28, 0x17, 0x95, 0xb8, 0xdb, 0xdf, 0x99, 0x93, 0xf3, 0xf1, 0x3b, 0x1f, 0x33, 0x0e, 0x6c, 0xa8,
+	0x44, 0xc4, 0xa9, 0x08, 0x54, 0x28, 0xe3, 0xde, 0x24, 0x91, 0x4a, 0xfa, 0x75, 0x35, 0x9b, 0x60,
+	0xba, 0xd9, 0x0e, 0xe4, 0x78, 0x9c, 0x0b, 0xd9, 0x11, 0xac, 0x3d, 0x4b, 0x53, 0x54, 0xe9, 0x0b,
+	0x8c, 0x31, 0x0d, 0x53, 0xff, 0x36, 0xac, 0x88, 0xb1, 0x9c, 0xc6, 0xaa, 0x53, 0xed, 0x56, 0xb6,
+	0x3c, 0x6e, 0x91, 0xff, 0x00, 0xd6, 0x12, 0x54, 0xd3, 0x24, 0x7e, 0x36, 0x18, 0x24, 0x98, 0xa6,
+	0x1d, 0xaf, 0x5b, 0xd9, 0x6a, 0xf2, 0x79, 0x21, 0xfb, 0xbd, 0x02, 0xb7, 0x8c, 0xbd, 0xbe, 0xf6,
+	0x7f, 0x8a, 0x49, 0x5f, 0x7e, 0x9d, 0x61, 0xe0, 0xdf, 0x85, 0x66, 0x20, 0xc3, 0x58, 0xc9, 0x33,
+	0x8c, 0x3b, 0x15, 0xfa, 0xd3, 0x52, 0xb0, 0xd4, 0xa9, 0x0f, 0xb5, 0x58, 0x2a, 0x24, 0x5f, 0x6d,
+	0x4e, 0xdf, 0xfe, 0x26, 0x34, 0x30, 0xc3, 0xe0, 0xb5, 0x18, 0x63, 0xa7, 0x46, 0x86, 0x0a, 0xec,
+	0xaf, 0x43, 0x55, 0xc9, 0x4e, 0x9d, 0xa4, 0x55, 0x25, 0xd9, 0xaf, 0x15, 0x58, 0x37, 0xe1, 0xbc,
+	0x0f, 0xd5, 0x68, 0x90, 0x88, 0x0f, 0xff, 0x51, 0x20, 0x3f, 0xc3, 0xfa, 0x3c, 0x2d, 0x1f, 0x31,
+	0x0e, 0xe3, 0xab, 0x56, 0xf8, 0x3a, 0x84, 0x3a, 0xf9, 0xd2, 0xca, 0x3a, 0x20, 0x6b, 0x9d, 0xbe,
+	0xb5, 0xe1, 0x74, 0x36, 0x3e, 0x91, 0x11, 0x19, 0x6e, 0x72, 0x8b, 0x1c, 0x87, 0x9e, 0xeb, 0x90,
+	0xfd, 0x5d, 0x81, 0xc6, 0x5e, 0x82, 0x42, 0x61, 0x3f, 0xb3, 0x9e, 0x2a, 0xb9, 0xa7, 0xa5, 0x51,
+	0xde, 0x04, 0xef, 0x14, 0xd1, 0x5a, 0xd2, 0x9f, 0x45, 0xdc, 0x35, 0x27, 0xee, 0x7b, 0x00, 0x61,
+	0x51, 0x17, 0xe2, 0xaa, 0xc1, 0x1d, 0x89, 0xdf, 0x81, 0xd5, 0x30, 0xed, 0x13, 0x3f, 0x2b, 0x74,
+	0x98, 0x43, 0xbf, 0x0b, 0x2d, 0xa2, 0xe9, 0xd8, 0x64, 0xb2, 0x4a, 0x01, 0xb9, 0xa2, 0xb9, 0xda,
+	0x34, 0x16, 0x6a, 0x73, 0x1b, 0x56, 0xf4, 0x37, 0x26, 0x9d, 0xa6, 0xa1, 0xc0, 0x20, 0x16, 0x43,
+	0x9b, 0xe3, 0xfb, 0x24, 0x54, 0xc8, 0xc5, 0x07, 0x9b, 0x6d, 0x56, 0x64, 0x9b, 0x67, 0xef, 0xb9,
+	0xd9, 0x63, 0x36, 0x09, 0x93, 0xbc, 0xfa, 0x16, 0xe5, 0xd9, 0xd7, 0xcb, 0xec, 0x6f, 0x41, 0x3d,
+	0x8c, 0x07, 0x98, 0x51, 0x1e, 0x75, 0x6e, 0x00, 0x7b, 0x04, 0xb7, 0x2d, 0xb3, 0xe5, 0xa8, 0xbe,
+	0x48, 0xe4, 0x74, 0xa2, 0x2d, 0xa8, 0x2c, 0xed, 0x54, 0xba, 0xde, 0x56, 0x93, 0xeb, 0x4f, 0x76,
+	0x0f, 0x1a, 0x6f, 0xe3, 0x34, 0x1c, 0xc6, 0xfd, 0x4c, 0x73, 0x39, 0x10, 0x4a, 0x50, 0x64, 0x6d,
+	0x4e, 0xdf, 0x2c, 0x81, 0xf6, 0x6b, 0xf9, 0x5c, 0x44, 0x22, 0x0e, 0xb0, 0x9f, 0xd1, 0x14, 0xab,
+	0xec, 0x25, 0x16, 0x46, 0x2c, 0xd2, 0x9c, 0x4e, 0xc4, 0x4c, 0x4f, 0xab, 0xad, 0x7f, 0x0e, 0xe9,
+	0x24, 0x09, 0x2f, 0xce, 0x70, 0x66, 0x53, 0xcc, 0xe1, 0xb2, 0x3c, 0x99, 0x84, 0x96, 0xe3, 0x53,
+	0x27, 0x49, 0x4e, 0x2c, 0x63, 0x06, 0x7c, 0x54, 0x87, 0x7f, 0x56, 0xa1, 0xe5, 0x70, 0xe5, 0x14,
+	0xd2, 0x50, 0x61, 0x91, 0xf5, 0x19, 0x49, 0x31, 0x20, 0x9f, 0x6d, 0x9e, 0x43, 0xbf, 0x07, 0x4d,
+	0x4d, 0xa2, 0x50, 0xd3, 0xc4, 0xb4, 0x67, 0x6b, 0xe7, 0x66, 0x8f, 0xd6, 0x62, 0xef, 0x38, 0x97,
+	0xf3, 0x52, 0x25, 0x2f, 0x65, 0xad, 0x2c, 0x65, 0x19, 0x9b, 0xa9, 0xaf, 0x45, 0x3a, 0xfb, 0x58,
+	0xc6, 0x01, 0x52, 0x89, 0x3d, 0x6e, 0x80, 0x6d, 0x99, 0xd5, 0xa2, 0x65, 0xee, 0x01, 0x0c, 0x75,
+	0x85, 0xf7, 0x68, 0x68, 0x1a, 0xd4, 0x0d, 0x8e, 0x44, 0x5b, 0x1f, 0xa1, 0x18, 0xd8, 0xd6, 0x6c,
+	0x73, 0x8b, 0x68, 0x7c, 0x30, 0x53, 0x1d, 0xb0, 0xe3, 0x83, 0x99, 0xd2, 0x0b, 0x64, 0x24, 0xd2,
+	0xd1, 0x9e, 0x08, 0x46, 0xd8, 0x69, 0xd1, 0x41, 0x29, 0xd0, 0xeb, 0xfa, 0x74, 0x1a, 0x45, 0x2f,
+	0x0b, 0x8d, 0x36, 0x69, 0xcc, 0x0b, 0xd9, 0x13, 0x68, 0x3b, 0x84, 0xa6, 0xfe, 0x83, 0xb2, 0xf1,
+	0x5a, 0x3b, 0xbe, 0x65, 0xc6, 0xd1, 0x30, 0xcd, 0xf8, 0x15, 0xac, 0xf1, 0x30, 0x1e, 0x16, 0x8c,
+	0xf9, 0x3d, 0xa8, 0x87, 0x0a, 0xc7, 0xf9, 0x1f, 0x76, 0xec, 0x1f, 0xce, 0x29, 0x1d, 0x28, 0x1c,
+	0x73, 0xa3, 0xc6, 0x0e, 0x60, 0xe3, 0xd2, 0x99, 0xce, 0x7d, 0x32, 0x3d, 0xd1, 0xed, 0xa0, 0xad,
+	0xb4, 0xb9, 0x45, 0x3a, 0xcf, 0xb2, 0x66, 0x55, 0x3a, 0x2a, 0x05, 0xec, 0x3b, 0x68, 0x96, 0x71,
+	0x68, 0xba, 0x67, 0xd4, 0x0c, 0x75, 0x5e, 0x55, 0x33, 0xc7, 0xa4, 0xe9, 0x83, 0x2b, 0x4d, 0x9a,
+	0x55, 0xea, 0x98, 0xfc, 0x0c, 0xda, 0x47, 0xd3, 0x48, 0x85, 0xda, 0xee, 0x21, 0xce, 0xae, 0x6b,
+	0x95, 0x7d, 0x0f, 0x37, 0x8a, 0xbf, 0x7b, 0x33, 0x3d, 0x39, 0x34, 0x8e, 0xd4, 0x28, 0xc1, 0x74,
+	0x24, 0xa3, 0x81, 0xb5, 0x50, 0x0a, 0xfc, 0x4f, 0xa1, 0x76, 0x86, 0xb3, 0x94, 0x92, 0x6a, 0xed,
+	0xfc, 0xcf, 0xb2, 0xe6, 0xfa, 0xe6, 0xa4, 0xc0, 0xf6, 0x60, 0xad, 0x90, 0x12, 0x57, 0xc5, 0x42,
+	0xa9, 0x38, 0x0b, 0x65, 0x91, 0xa9, 0x85, 0xb4, 0xbe, 0x04, 0xbf, 0x30, 0x52, 0x52, 0xb6, 0x05,
+	0xb5, 0x34, 0x1c, 0xe6, 0x95, 0xbb, 0xb5, 0x18, 0x03, 0x55, 0x8d, 0x34, 0xd8, 0x4f, 0xd0, 0xd6,
+	0x73, 0xfb, 0xed, 0x05, 0x26, 0x17, 0x21, 0xd2, 0x7a, 0x4e, 0x30, 0x08, 0x2f, 0xec, 0xf8, 0x79,
+	0x3c, 0x87, 0xfa, 0xe4, 0xc4, 0xac, 0x05, 0x7b, 0x2f, 0xe4, 0x50, 0x9f, 0xa8, 0x6c, 0xcf, 0xb9,
+	0x66, 0x72, 0xc8, 0xfe, 0xa8, 0xc0, 0x2a, 0xc7, 0x73, 0xda, 0x0c, 0x3e, 0xd4, 0xc4, 0x60, 0x60,
+	0xcc, 0x36, 0x79, 0x4d, 0x58, 0xd9, 0x69, 0x24, 0x86, 0x64, 0xb0, 0xce, 0xe9, 0x5b, 0xb3, 0x10,
+	0x14, 0xb6, 0xea, 0xdc, 0x00, 0xcd, 0xc2, 0x20, 0x4c, 0x90, 0xfa, 0x95, 0x26, 0xb7, 0xce, 0x4b,
+	0x81, 0x99, 0xb0, 0x70, 0x38, 0x52, 0xf9, 0xfc, 0x1a, 0x34, 0xbf, 0xa2, 0xbd, 0x7c, 0x45, 0xdf,
+	0x81, 0xfa, 0x4b, 0xcc, 0x2e, 0xdf, 0x05, 0x6c, 0x0a, 0x2d, 0x8e, 0x93, 0x68, 0xd6, 0xcf, 0x0e,
+	0xe2, 0x53, 0xa9, 0xa3, 0xd3, 0xa3, 0x97, 0xaf, 0x64, 0xfd, 0xed, 0x78, 0xaa, 0x5e, 0xed, 0xc9,
+	0x73, 0x3c, 0xf9, 0x0f, 0x60, 0x45, 0xd0, 0x03, 0xa1, 0x53, 0xa3, 0x4a, 0xb4, 0x6d, 0x25, 0xe8,
+	0x26, 0xe7, 0xf6, 0x8c, 0x7d, 0x02, 0x4d, 0x8e, 0xe7, 0xfd, 0xec, 0x55, 0x98, 0xaa, 0x32, 0x7d,
+	0x43, 0xbf, 0x01, 0x6c, 0xb7, 0x88, 0x8c, 0x94, 0xae, 0x37, 0xd1, 0x0f, 0x61, 0x8d, 0xe3, 0xf9,
+	0x0b, 0x54, 0x47, 0x38, 0x9e, 0x48, 0x19, 0x51, 0x90, 0xe9, 0xb3, 0x28, 0x22, 0xdb, 0x0d, 0x6e,
+	0x00, 0x7b, 0xaa, 0x6f, 0xc8, 0xf3, 0x37, 0x89, 0x9c, 0x60, 0xf2, 0x0d, 0xce, 0x95, 0xd3, 0x34,
+	0x62, 0x0e, 0xcd, 0xfd, 0x73, 0x1c, 0xfe, 0x82, 0xb6, 0x60, 0x16, 0xb1, 0x1e, 0xac, 0x53, 0x74,
+	0xa5, 0x8d, 0xbb, 0xd0, 0x9c, 0xe4, 0xc0, 0x66, 0x52, 0x0a, 0x18, 0x07, 0xe8, 0x67, 0x7a, 0x5f,
+	0x51, 0x32, 0x9a, 0x52, 0x91, 0x8e, 0x30, 0xcd, 0x57, 0x84, 0x41, 0x25, 0x13, 0x55, 0x87, 0x09,
+	0x67, 0x55, 0x7b, 0x5d, 0xaf, 0x5c, 0xd5, 0xec, 0x0b, 0x68, 0x5b, 0x86, 0x74, 0xed, 0x52, 0xff,
+	0xb1, 0xce, 0x82, 0x3e, 0x17, 0x68, 0x72, 0xb4, 0x78, 0xae, 0xc2, 0x7a, 0x00, 0x1c, 0x03, 0x0c,
+	0x27, 0xea, 0x95, 0x1c, 0x5e, 0xda, 0x0d, 0x37, 0xc1, 0x8b, 0xe4, 0xd0, 0x0e, 0x9f, 0xfe, 0x64,
+	0x02, 0x56, 0xad, 0xfe, 0x25, 0xe5, 0xfb, 0x50, 0x3d, 0x7c, 0x67, 0xa7, 0xff, 0x86, 0xf5, 0x79,
+	0x88, 0xb3, 0x77, 0x22, 0x9a, 0x22, 0xaf, 0x1e, 0xbe, 0xf3, 0x1f, 0x42, 0x2d, 0x92, 0xc3, 0x94,
+	0xe2, 0x6f, 0xed, 0x6c, 0x14, 0x61, 0xe5, 0xee, 0x39, 0x1d, 0xb3, 0x7d, 0x68, 0x59, 0xd9, 0xbe,
+	0x50, 0xe2, 0x92, 0x9b, 0x6b, 0x5a, 0x39, 0xa4, 0x1e, 0x38, 0x0e, 0xc7, 0xd3, 0xc8, 0xbc, 0xf6,
+	0x58, 0xd1, 0xf3, 0x57, 0x77, 0x8e, 0x7e, 0x13, 0x2d, 0xdb, 0x85, 0x08, 0x37, 0x88, 0x3d, 0xc7,
+	0xdc, 0x16, 0xed, 0x0b, 0xed, 0xd3, 0xda, 0x5c, 0x9f, 0x8f, 0x84, 0xe7, 0xc7, 0xf9, 0xad, 0x5b,
+	0x9d, 0xbb, 0x75, 0xed, 0x2c, 0x79, 0xee, 0x2c, 0xb1, 0xbf, 0x2a, 0xd0, 0xe8, 0x67, 0x1c, 0xd3,
+	0x69, 0xa4, 0x1c, 0xa5, 0xca, 0xd5, 0x03, 0x57, 0x75, 0x97, 0xa5, 0xc9, 0xce, 0xfb, 0xd7, 0xec,
+	0x9e, 0x40, 0xcb, 0xc6, 0x34, 0x10, 0xf6, 0xf1, 0xea, 0x76, 0x47, 0x41, 0x39, 0x77, 0xd5, 0x74,
+	0x47, 0x9f, 0x44, 0x32, 0x38, 0x53, 0xe1, 0x38, 0x7f, 0x25, 0x94, 0x02, 0xfd, 0x04, 0x30, 0x1e,
+	0xe8, 0x6d, 0xba, 0x42, 0x1b, 0xc5, 0x91, 0xb0, 0xdf, 0x3c, 0xd8, 0x70, 0xe2, 0xd8, 0x47, 0x25,
+	0xc2, 0xe8, 0x5a, 0xb5, 0x78, 0x5c, 0x12, 0x5c, 0x5d, 0x1a, 0x69, 0x41, 0xb2, 0xae, 0x5c, 0x22,
+	0xe5, 0xa9, 0xe9, 0x8b, 0x36, 0xb7, 0xc8, 0x61, 0xb1, 0x76, 0x35, 0x8b, 0x75, 0x77, 0x6d, 0xcd,
+	0xe5, 0xba, 0xb2, 0x98, 0x6b, 0xf9, 0xfb, 0x60, 0x75, 0xee, 0xf7, 0xc1, 0x26, 0x34, 0x4e, 0x13,
+	0x39, 0xa6, 0x25, 0x6f, 0x5f, 0xe7, 0x39, 0x5e, 0xe0, 0xa7, 0xb9, 0xc8, 0x8f, 0xb3, 0x28, 0x61,
+	0xf9, 0xa2, 0xf4, 0x1f, 0x41, 0x43, 0x65, 0x6f, 0x4c, 0x7e, 0xad, 0xae, 0xe7, 0x74, 0x5b, 0xdf,
+	0x88, 0x79, 0x71, 0x4e, 0xd1, 0xd8, 0x57, 0x91, 0x7d, 0x25, 0x15, 0x98, 0x3d, 0x05, 0xff, 0x52,
+	0x31, 0xb4, 0x75, 0x67, 0xa9, 0x76, 0x2e, 0x97, 0xc3, 0xe8, 0x99, 0xd5, 0xda, 0x85, 0x86, 0xbd,
+	0xd7, 0x68, 0x4f, 0xe9, 0x1c, 0xf3, 0x47, 0xb9, 0x01, 0x6c, 0x1b, 0xee, 0x70, 0x3c, 0xdf, 0xc7,
+	0x40, 0x0e, 0xe8, 0x97, 0x47, 0x69, 0xe7, 0xea, 0x37, 0x35, 0xfb, 0x1c, 0x9a, 0x6f, 0x53, 0x4c,
+	0xe8, 0xa7, 0x0a, 0xa9, 0xc8, 0x49, 0x18, 0x14, 0x2a, 0x1a, 0xe8, 0xcd, 0x1c, 0xc8, 0x58, 0xa1,
+	0xdd, 0x89, 0x4d, 0x9e, 0x43, 0xf6, 0x23, 0xb4, 0xde, 0x4e, 0x86, 0x89, 0x18, 0xe0, 0x11, 0x2a,
+	0xa1, 0x93, 0x4f, 0x95, 0x48, 0x54, 0x18, 0x0f, 0xed, 0xae, 0x2f, 0xb0, 0x36, 0x72, 0x81, 0x49,
+	0xaa, 0xef, 0x51, 0x6b, 0xc4, 0xc2, 0xa5, 0xf3, 0x78, 0x40, 0x3b, 0x64, 0xe9, 0xc6, 0x6e, 0x16,
+	0x1b, 0xbb, 0x0b, 0xad, 0x30, 0x3d, 0x1e, 0xc9, 0x44, 0x11, 0xed, 0x55, 0xf2, 0xec, 0x8a, 0xd8,
+	0x31, 0xac, 0xda, 0x52, 0x39, 0xad, 0x5a, 0x99, 0x6b, 0xd5, 0xb9, 0xc1, 0x5e, 0xcb, 0x5b, 0x72,
+	0x13, 0x1a, 0x89, 0x94, 0xc6, 0xae, 0x79, 0xdb, 0x15, 0xf8, 0xf9, 0xfd, 0x1f, 0xfe, 0x3f, 0x0c,
+	0xd5, 0x68, 0x7a, 0xd2, 0x0b, 0xe4, 0x78, 0x7b, 0x77, 0x37, 0x88, 0xb7, 0x83, 0x91, 0x08, 0xe3,
+	0xdd, 0xdd, 0x6d, 0x2a, 0xe2, 0xc9, 0x0a, 0xfd, 0x57, 0x64, 0xf7, 0x9f, 0x01, 0x00, 0x77, 0xdb,
+	0x04, 0xb7, 0x3f, 0x11, 0x00, 0x00,
 }
@@ -481,16 +481,10 @@ func (tx *Transaction) CheckSign() bool {
 
 //txgroup 的情况
 func (tx *Transaction) checkSign() bool {
-	copytx := *tx
-	copytx.Signature = nil
-	//如果新版本扩展了字段，使用旧的数据结构解析，新字段将放在未识别字段中，导致验签失败，需要置空
-	copytx.XXX_unrecognized = nil
-	copytx.UnsetCacheHash()
-	data := Encode(&copytx)
 	if tx.GetSignature() == nil {
 		return false
 	}
-	return CheckSign(data, string(tx.Execer), tx.GetSignature())
+	return CheckSign(tx.signData(), string(tx.Execer), tx.GetSignature())
 }
 
 //Check 交易检测
@@ -506,6 +500,12 @@ func (tx *Transaction) Check(cfg *Chain33Config, height, minfee, maxFee int64) e
 }
 
 func (tx *Transaction) check(cfg *Chain33Config, height, minfee, maxFee int64) error {
+	if tx.GetSignature().GetTy() == MultiSign && !cfg.IsFork(height, "ForkTxMultiSign") {
+		return ErrMultiSignNotActive
+	}
+	if CountBLSSign(tx.GetSignature()) > 0 && !cfg.IsFork(height, "ForkTxBLSSign") {
+		return ErrBLSSignNotActive
+	}
 	if minfee == 0 {
 		return nil
 	}
@@ -598,8 +598,11 @@ func (tx *Transaction) GetTxFee() int64 {
 	return group.Txs[0].Fee
 }
 
-//From 交易from地址
+//From 交易from地址, 多重签名交易为多重签名地址
 func (tx *Transaction) From() string {
+	if tx.GetSignature().GetTy() == MultiSign {
+		return address.MultiSignAddress(tx.GetSignature().GetPubkey())
+	}
 	return address.PubKeyToAddr(tx.GetSignature().GetPubkey())
 }
 
@@ -188,10 +188,15 @@ func (m *WalletTxDetails) GetTxDetails() []*WalletTxDetail {
 //	 addr :账户地址
 //	 timeStamp :创建账户时的时标
 type WalletAccountStore struct {
-	Privkey              string   `protobuf:"bytes,1,opt,name=privkey,proto3" json:"privkey,omitempty"`
-	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	TimeStamp            string   `protobuf:"bytes,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	Privkey   string `protobuf:"bytes,1,opt,name=privkey,proto3" json:"privkey,omitempty"`
+	Label     string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Addr      string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	TimeStamp string `protobuf:"bytes,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	//通过seed生成的账户的bip44路径, 导入的账户为空
+	HdPath string `protobuf:"bytes,5,opt,name=hdPath,proto3" json:"hdPath,omitempty"`
+	//只读账户没有私钥, 只能查询余额和交易, 不能签名
+	WatchOnly            bool     `protobuf:"varint,6,opt,name=watchOnly,proto3" json:"watchOnly,omitempty"`
+	Pubkey               string   `protobuf:"bytes,7,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -250,9 +255,31 @@ func (m *WalletAccountStore) GetTimeStamp() string {
 	return ""
 }
 
-//钱包模块通过一个随机值对钱包密码加密
-// 	 pwHash : 对钱包密码和一个随机值组合进行哈希计算
-//	 randstr :对钱包密码加密的一个随机值
+func (m *WalletAccountStore) GetHdPath() string {
+	if m != nil {
+		return m.HdPath
+	}
+	return ""
+}
+
+func (m *WalletAccountStore) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+func (m *WalletAccountStore) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+// 钱包模块通过一个随机值对钱包密码加密
+//
+//	pwHash : 对钱包密码和一个随机值组合进行哈希计算
+//	randstr :对钱包密码加密的一个随机值
 type WalletPwHash struct {
 	PwHash               []byte   `protobuf:"bytes,1,opt,name=pwHash,proto3" json:"pwHash,omitempty"`
 	Randstr              string   `protobuf:"bytes,2,opt,name=randstr,proto3" json:"randstr,omitempty"`
@@ -410,6 +437,8 @@ func (m *WalletAccounts) GetWallets() []*WalletAccount {
 type WalletAccount struct {
 	Acc                  *Account `protobuf:"bytes,1,opt,name=acc,proto3" json:"acc,omitempty"`
 	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	HdPath               string   `protobuf:"bytes,3,opt,name=hdPath,proto3" json:"hdPath,omitempty"`
+	WatchOnly            bool     `protobuf:"varint,4,opt,name=watchOnly,proto3" json:"watchOnly,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -454,10 +483,25 @@ func (m *WalletAccount) GetLabel() string {
 	return ""
 }
 
-//钱包解锁
-// 	 passwd : 钱包密码
-//	 timeout :钱包解锁时间，0，一直解锁，非0值，超时之后继续锁定
-//	 walletOrTicket :解锁整个钱包还是只解锁挖矿买票功能，1只解锁挖矿买票，0解锁整个钱包
+func (m *WalletAccount) GetHdPath() string {
+	if m != nil {
+		return m.HdPath
+	}
+	return ""
+}
+
+func (m *WalletAccount) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+// 钱包解锁
+//
+//	passwd : 钱包密码
+//	timeout :钱包解锁时间，0，一直解锁，非0值，超时之后继续锁定
+//	walletOrTicket :解锁整个钱包还是只解锁挖矿买票功能，1只解锁挖矿买票，0解锁整个钱包
 type WalletUnLock struct {
 	Passwd               string   `protobuf:"bytes,1,opt,name=passwd,proto3" json:"passwd,omitempty"`
 	Timeout              int64    `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
@@ -728,7 +772,9 @@ func (m *ReqWalletSetPasswd) GetNewPass() string {
 }
 
 type ReqNewAccount struct {
-	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	//指定生成账户的bip44路径, 为空时使用下一个索引
+	HdPath               string   `protobuf:"bytes,2,opt,name=hdPath,proto3" json:"hdPath,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -766,7 +812,14 @@ func (m *ReqNewAccount) GetLabel() string {
 	return ""
 }
 
-//根据label获取账户地址
+func (m *ReqNewAccount) GetHdPath() string {
+	if m != nil {
+		return m.HdPath
+	}
+	return ""
+}
+
+// 根据label获取账户地址
 type ReqGetAccount struct {
 	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -915,11 +968,72 @@ func (m *ReqWalletImportPrivkey) GetLabel() string {
 	return ""
 }
 
-//发送交易
-// 	 from : 打出地址
-//	 to :接受地址
-// 	 amount : 转账额度
-//	 note :转账备注
+// 导入只读账户, 地址和公钥至少指定一个, 同时指定时必须对应
+//
+//	addr : 账户地址
+//	pubkey : 账户公钥, hex格式
+//	label : 账户标签
+type ReqWalletImportWatchOnly struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Pubkey               string   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqWalletImportWatchOnly) Reset()         { *m = ReqWalletImportWatchOnly{} }
+func (m *ReqWalletImportWatchOnly) String() string { return proto.CompactTextString(m) }
+func (*ReqWalletImportWatchOnly) ProtoMessage()    {}
+func (*ReqWalletImportWatchOnly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{17}
+}
+
+func (m *ReqWalletImportWatchOnly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Unmarshal(m, b)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Marshal(b, m, deterministic)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqWalletImportWatchOnly.Merge(m, src)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Size() int {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Size(m)
+}
+func (m *ReqWalletImportWatchOnly) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqWalletImportWatchOnly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqWalletImportWatchOnly proto.InternalMessageInfo
+
+func (m *ReqWalletImportWatchOnly) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqWalletImportWatchOnly) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *ReqWalletImportWatchOnly) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// 发送交易
+//
+//	from : 打出地址
+//	to :接受地址
+//	amount : 转账额度
+//	note :转账备注
 type ReqWalletSendToAddress struct {
 	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
@@ -936,7 +1050,7 @@ func (m *ReqWalletSendToAddress) Reset()         { *m = ReqWalletSendToAddress{}
 func (m *ReqWalletSendToAddress) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSendToAddress) ProtoMessage()    {}
 func (*ReqWalletSendToAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{17}
+	return fileDescriptor_b88fd140af4deb6f, []int{18}
 }
 
 func (m *ReqWalletSendToAddress) XXX_Unmarshal(b []byte) error {
@@ -1010,7 +1124,7 @@ func (m *ReqWalletSetFee) Reset()         { *m = ReqWalletSetFee{} }
 func (m *ReqWalletSetFee) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSetFee) ProtoMessage()    {}
 func (*ReqWalletSetFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{18}
+	return fileDescriptor_b88fd140af4deb6f, []int{19}
 }
 
 func (m *ReqWalletSetFee) XXX_Unmarshal(b []byte) error {
@@ -1050,7 +1164,7 @@ func (m *ReqWalletSetLabel) Reset()         { *m = ReqWalletSetLabel{} }
 func (m *ReqWalletSetLabel) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSetLabel) ProtoMessage()    {}
 func (*ReqWalletSetLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{19}
+	return fileDescriptor_b88fd140af4deb6f, []int{20}
 }
 
 func (m *ReqWalletSetLabel) XXX_Unmarshal(b []byte) error {
@@ -1096,7 +1210,7 @@ func (m *ReqWalletMergeBalance) Reset()         { *m = ReqWalletMergeBalance{} }
 func (m *ReqWalletMergeBalance) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletMergeBalance) ProtoMessage()    {}
 func (*ReqWalletMergeBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{20}
+	return fileDescriptor_b88fd140af4deb6f, []int{21}
 }
 
 func (m *ReqWalletMergeBalance) XXX_Unmarshal(b []byte) error {
@@ -1141,7 +1255,7 @@ func (m *ReqTokenPreCreate) Reset()         { *m = ReqTokenPreCreate{} }
 func (m *ReqTokenPreCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenPreCreate) ProtoMessage()    {}
 func (*ReqTokenPreCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{21}
+	return fileDescriptor_b88fd140af4deb6f, []int{22}
 }
 
 func (m *ReqTokenPreCreate) XXX_Unmarshal(b []byte) error {
@@ -1224,7 +1338,7 @@ func (m *ReqTokenFinishCreate) Reset()         { *m = ReqTokenFinishCreate{} }
 func (m *ReqTokenFinishCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenFinishCreate) ProtoMessage()    {}
 func (*ReqTokenFinishCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{22}
+	return fileDescriptor_b88fd140af4deb6f, []int{23}
 }
 
 func (m *ReqTokenFinishCreate) XXX_Unmarshal(b []byte) error {
@@ -1279,7 +1393,7 @@ func (m *ReqTokenRevokeCreate) Reset()         { *m = ReqTokenRevokeCreate{} }
 func (m *ReqTokenRevokeCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenRevokeCreate) ProtoMessage()    {}
 func (*ReqTokenRevokeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{23}
+	return fileDescriptor_b88fd140af4deb6f, []int{24}
 }
 
 func (m *ReqTokenRevokeCreate) XXX_Unmarshal(b []byte) error {
@@ -1335,7 +1449,7 @@ func (m *ReqModifyConfig) Reset()         { *m = ReqModifyConfig{} }
 func (m *ReqModifyConfig) String() string { return proto.CompactTextString(m) }
 func (*ReqModifyConfig) ProtoMessage()    {}
 func (*ReqModifyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{24}
+	return fileDescriptor_b88fd140af4deb6f, []int{25}
 }
 
 func (m *ReqModifyConfig) XXX_Unmarshal(b []byte) error {
@@ -1397,7 +1511,11 @@ type ReqSignRawTx struct {
 	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
 	Fee   int64  `protobuf:"varint,8,opt,name=fee,proto3" json:"fee,omitempty"`
 	// bytes  newExecer = 9;
-	NewToAddr            string   `protobuf:"bytes,10,opt,name=newToAddr,proto3" json:"newToAddr,omitempty"`
+	NewToAddr string `protobuf:"bytes,10,opt,name=newToAddr,proto3" json:"newToAddr,omitempty"`
+	// 多重签名的公钥集合(hex)和门限, 设置后对交易做m-of-n多重签名
+	// 交易中已经有部分签名时可以不设置, 追加签名时不修改交易内容
+	MultiSignPubkeys     []string `protobuf:"bytes,11,rep,name=multiSignPubkeys,proto3" json:"multiSignPubkeys,omitempty"`
+	MultiSignThreshold   int32    `protobuf:"varint,12,opt,name=multiSignThreshold,proto3" json:"multiSignThreshold,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1407,7 +1525,7 @@ func (m *ReqSignRawTx) Reset()         { *m = ReqSignRawTx{} }
 func (m *ReqSignRawTx) String() string { return proto.CompactTextString(m) }
 func (*ReqSignRawTx) ProtoMessage()    {}
 func (*ReqSignRawTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{25}
+	return fileDescriptor_b88fd140af4deb6f, []int{26}
 }
 
 func (m *ReqSignRawTx) XXX_Unmarshal(b []byte) error {
@@ -1484,6 +1602,20 @@ func (m *ReqSignRawTx) GetNewToAddr() string {
 	return ""
 }
 
+func (m *ReqSignRawTx) GetMultiSignPubkeys() []string {
+	if m != nil {
+		return m.MultiSignPubkeys
+	}
+	return nil
+}
+
+func (m *ReqSignRawTx) GetMultiSignThreshold() int32 {
+	if m != nil {
+		return m.MultiSignThreshold
+	}
+	return 0
+}
+
 type ReplySignRawTx struct {
 	TxHex                string   `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1495,7 +1627,7 @@ func (m *ReplySignRawTx) Reset()         { *m = ReplySignRawTx{} }
 func (m *ReplySignRawTx) String() string { return proto.CompactTextString(m) }
 func (*ReplySignRawTx) ProtoMessage()    {}
 func (*ReplySignRawTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{26}
+	return fileDescriptor_b88fd140af4deb6f, []int{27}
 }
 
 func (m *ReplySignRawTx) XXX_Unmarshal(b []byte) error {
@@ -1536,7 +1668,7 @@ func (m *ReportErrEvent) Reset()         { *m = ReportErrEvent{} }
 func (m *ReportErrEvent) String() string { return proto.CompactTextString(m) }
 func (*ReportErrEvent) ProtoMessage()    {}
 func (*ReportErrEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{27}
+	return fileDescriptor_b88fd140af4deb6f, []int{28}
 }
 
 func (m *ReportErrEvent) XXX_Unmarshal(b []byte) error {
@@ -1589,7 +1721,7 @@ func (m *Int32) Reset()         { *m = Int32{} }
 func (m *Int32) String() string { return proto.CompactTextString(m) }
 func (*Int32) ProtoMessage()    {}
 func (*Int32) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{28}
+	return fileDescriptor_b88fd140af4deb6f, []int{29}
 }
 
 func (m *Int32) XXX_Unmarshal(b []byte) error {
@@ -1628,7 +1760,7 @@ func (m *ReqAccountList) Reset()         { *m = ReqAccountList{} }
 func (m *ReqAccountList) String() string { return proto.CompactTextString(m) }
 func (*ReqAccountList) ProtoMessage()    {}
 func (*ReqAccountList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{29}
+	return fileDescriptor_b88fd140af4deb6f, []int{30}
 }
 
 func (m *ReqAccountList) XXX_Unmarshal(b []byte) error {
@@ -1668,7 +1800,7 @@ func (m *ReqPrivkeysFile) Reset()         { *m = ReqPrivkeysFile{} }
 func (m *ReqPrivkeysFile) String() string { return proto.CompactTextString(m) }
 func (*ReqPrivkeysFile) ProtoMessage()    {}
 func (*ReqPrivkeysFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{30}
+	return fileDescriptor_b88fd140af4deb6f, []int{31}
 }
 
 func (m *ReqPrivkeysFile) XXX_Unmarshal(b []byte) error {
@@ -1703,6 +1835,312 @@ func (m *ReqPrivkeysFile) GetPasswd() string {
 	return ""
 }
 
+// 通过seed恢复链上使用过的账户, 连续gapLimit个地址没有交易时停止
+type ReqRecoverAccounts struct {
+	GapLimit             int32    `protobuf:"varint,1,opt,name=gapLimit,proto3" json:"gapLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqRecoverAccounts) Reset()         { *m = ReqRecoverAccounts{} }
+func (m *ReqRecoverAccounts) String() string { return proto.CompactTextString(m) }
+func (*ReqRecoverAccounts) ProtoMessage()    {}
+func (*ReqRecoverAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{32}
+}
+
+func (m *ReqRecoverAccounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRecoverAccounts.Unmarshal(m, b)
+}
+func (m *ReqRecoverAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRecoverAccounts.Marshal(b, m, deterministic)
+}
+func (m *ReqRecoverAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRecoverAccounts.Merge(m, src)
+}
+func (m *ReqRecoverAccounts) XXX_Size() int {
+	return xxx_messageInfo_ReqRecoverAccounts.Size(m)
+}
+func (m *ReqRecoverAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRecoverAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRecoverAccounts proto.InternalMessageInfo
+
+func (m *ReqRecoverAccounts) GetGapLimit() int32 {
+	if m != nil {
+		return m.GapLimit
+	}
+	return 0
+}
+
+// HD钱包账户和生成账户的路径
+type HDAccount struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	HdPath               string   `protobuf:"bytes,3,opt,name=hdPath,proto3" json:"hdPath,omitempty"`
+	Index                uint32   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HDAccount) Reset()         { *m = HDAccount{} }
+func (m *HDAccount) String() string { return proto.CompactTextString(m) }
+func (*HDAccount) ProtoMessage()    {}
+func (*HDAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{33}
+}
+
+func (m *HDAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDAccount.Unmarshal(m, b)
+}
+func (m *HDAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDAccount.Marshal(b, m, deterministic)
+}
+func (m *HDAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDAccount.Merge(m, src)
+}
+func (m *HDAccount) XXX_Size() int {
+	return xxx_messageInfo_HDAccount.Size(m)
+}
+func (m *HDAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HDAccount proto.InternalMessageInfo
+
+func (m *HDAccount) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *HDAccount) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *HDAccount) GetHdPath() string {
+	if m != nil {
+		return m.HdPath
+	}
+	return ""
+}
+
+func (m *HDAccount) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type HDAccounts struct {
+	Accounts             []*HDAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *HDAccounts) Reset()         { *m = HDAccounts{} }
+func (m *HDAccounts) String() string { return proto.CompactTextString(m) }
+func (*HDAccounts) ProtoMessage()    {}
+func (*HDAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{34}
+}
+
+func (m *HDAccounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDAccounts.Unmarshal(m, b)
+}
+func (m *HDAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDAccounts.Marshal(b, m, deterministic)
+}
+func (m *HDAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDAccounts.Merge(m, src)
+}
+func (m *HDAccounts) XXX_Size() int {
+	return xxx_messageInfo_HDAccounts.Size(m)
+}
+func (m *HDAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HDAccounts proto.InternalMessageInfo
+
+func (m *HDAccounts) GetAccounts() []*HDAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// keystore v3 文件的导入导出
+type ReqKeystoreFile struct {
+	//导出时为保存keystore文件的目录, 导入时为keystore文件或者目录
+	FileName string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	// keystore文件的密码
+	Passwd string `protobuf:"bytes,2,opt,name=passwd,proto3" json:"passwd,omitempty"`
+	//导出指定地址的账户, 为空时导出全部账户
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	//导入单个文件时指定的标签, 为空时使用文件中的标签
+	Label                string   `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqKeystoreFile) Reset()         { *m = ReqKeystoreFile{} }
+func (m *ReqKeystoreFile) String() string { return proto.CompactTextString(m) }
+func (*ReqKeystoreFile) ProtoMessage()    {}
+func (*ReqKeystoreFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{35}
+}
+
+func (m *ReqKeystoreFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqKeystoreFile.Unmarshal(m, b)
+}
+func (m *ReqKeystoreFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqKeystoreFile.Marshal(b, m, deterministic)
+}
+func (m *ReqKeystoreFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqKeystoreFile.Merge(m, src)
+}
+func (m *ReqKeystoreFile) XXX_Size() int {
+	return xxx_messageInfo_ReqKeystoreFile.Size(m)
+}
+func (m *ReqKeystoreFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqKeystoreFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqKeystoreFile proto.InternalMessageInfo
+
+func (m *ReqKeystoreFile) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *ReqKeystoreFile) GetPasswd() string {
+	if m != nil {
+		return m.Passwd
+	}
+	return ""
+}
+
+func (m *ReqKeystoreFile) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqKeystoreFile) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// 导入keystore文件的结果, 目录中导入失败的文件记录在failed中
+type ReplyImportKeystore struct {
+	Wallets              []*WalletAccount     `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Failed               []*KeystoreFileError `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReplyImportKeystore) Reset()         { *m = ReplyImportKeystore{} }
+func (m *ReplyImportKeystore) String() string { return proto.CompactTextString(m) }
+func (*ReplyImportKeystore) ProtoMessage()    {}
+func (*ReplyImportKeystore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{36}
+}
+
+func (m *ReplyImportKeystore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyImportKeystore.Unmarshal(m, b)
+}
+func (m *ReplyImportKeystore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyImportKeystore.Marshal(b, m, deterministic)
+}
+func (m *ReplyImportKeystore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyImportKeystore.Merge(m, src)
+}
+func (m *ReplyImportKeystore) XXX_Size() int {
+	return xxx_messageInfo_ReplyImportKeystore.Size(m)
+}
+func (m *ReplyImportKeystore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyImportKeystore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyImportKeystore proto.InternalMessageInfo
+
+func (m *ReplyImportKeystore) GetWallets() []*WalletAccount {
+	if m != nil {
+		return m.Wallets
+	}
+	return nil
+}
+
+func (m *ReplyImportKeystore) GetFailed() []*KeystoreFileError {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+type KeystoreFileError struct {
+	FileName             string   `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeystoreFileError) Reset()         { *m = KeystoreFileError{} }
+func (m *KeystoreFileError) String() string { return proto.CompactTextString(m) }
+func (*KeystoreFileError) ProtoMessage()    {}
+func (*KeystoreFileError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{37}
+}
+
+func (m *KeystoreFileError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeystoreFileError.Unmarshal(m, b)
+}
+func (m *KeystoreFileError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeystoreFileError.Marshal(b, m, deterministic)
+}
+func (m *KeystoreFileError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeystoreFileError.Merge(m, src)
+}
+func (m *KeystoreFileError) XXX_Size() int {
+	return xxx_messageInfo_KeystoreFileError.Size(m)
+}
+func (m *KeystoreFileError) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeystoreFileError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeystoreFileError proto.InternalMessageInfo
+
+func (m *KeystoreFileError) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *KeystoreFileError) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*WalletTxDetail)(nil), "types.WalletTxDetail")
 	proto.RegisterType((*WalletTxDetails)(nil), "types.WalletTxDetails")
@@ -1721,6 +2159,7 @@ func init() {
 	proto.RegisterType((*ReqGetAccount)(nil), "types.ReqGetAccount")
 	proto.RegisterType((*ReqWalletTransactionList)(nil), "types.ReqWalletTransactionList")
 	proto.RegisterType((*ReqWalletImportPrivkey)(nil), "types.ReqWalletImportPrivkey")
+	proto.RegisterType((*ReqWalletImportWatchOnly)(nil), "types.ReqWalletImportWatchOnly")
 	proto.RegisterType((*ReqWalletSendToAddress)(nil), "types.ReqWalletSendToAddress")
 	proto.RegisterType((*ReqWalletSetFee)(nil), "types.ReqWalletSetFee")
 	proto.RegisterType((*ReqWalletSetLabel)(nil), "types.ReqWalletSetLabel")
@@ -1735,6 +2174,12 @@ func init() {
 	proto.RegisterType((*Int32)(nil), "types.Int32")
 	proto.RegisterType((*ReqAccountList)(nil), "types.ReqAccountList")
 	proto.RegisterType((*ReqPrivkeysFile)(nil), "types.ReqPrivkeysFile")
+	proto.RegisterType((*ReqRecoverAccounts)(nil), "types.ReqRecoverAccounts")
+	proto.RegisterType((*HDAccount)(nil), "types.HDAccount")
+	proto.RegisterType((*HDAccounts)(nil), "types.HDAccounts")
+	proto.RegisterType((*ReqKeystoreFile)(nil), "types.ReqKeystoreFile")
+	proto.RegisterType((*ReplyImportKeystore)(nil), "types.ReplyImportKeystore")
+	proto.RegisterType((*KeystoreFileError)(nil), "types.KeystoreFileError")
 }
 
 func init() {
@@ -1742,83 +2187,98 @@ func init() {
 }
 
 var fileDescriptor_b88fd140af4deb6f = []byte{
-	// 1234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6e, 0x1b, 0x37,
-	0x10, 0xc6, 0x4a, 0x96, 0x6d, 0xd1, 0xb2, 0x93, 0x10, 0x49, 0x20, 0xa4, 0x4d, 0xa3, 0xb0, 0x48,
-	0xea, 0x02, 0x85, 0x03, 0x44, 0x2f, 0x45, 0x81, 0x02, 0x71, 0x7e, 0x1c, 0x07, 0x70, 0x52, 0x83,
-	0x52, 0x51, 0xa0, 0x2f, 0x05, 0xbd, 0x3b, 0x92, 0x08, 0xaf, 0xc8, 0x35, 0x97, 0xb2, 0xa4, 0x9b,
-	0xf4, 0x00, 0x3d, 0x42, 0xcf, 0xd0, 0xf7, 0xde, 0xa8, 0xe0, 0x90, 0xd4, 0xee, 0xba, 0xce, 0x43,
-	0xd1, 0x37, 0x7e, 0xc3, 0xe1, 0xfc, 0x7c, 0xc3, 0x19, 0x92, 0xf4, 0x96, 0x22, 0xcf, 0xc1, 0x1e,
-	0x15, 0x46, 0x5b, 0x4d, 0x3b, 0x76, 0x5d, 0x40, 0xf9, 0xe8, 0x9e, 0x35, 0x42, 0x95, 0x22, 0xb5,
-	0x52, 0x2b, 0xbf, 0xf3, 0x68, 0x5f, 0xa4, 0xa9, 0x5e, 0xa8, 0xa0, 0xc8, 0xfe, 0x6c, 0x91, 0x83,
-	0x5f, 0xf0, 0xe4, 0x78, 0xf5, 0x16, 0xac, 0x90, 0x39, 0x65, 0xa4, 0x65, 0x57, 0xfd, 0x64, 0x90,
-	0x1c, 0xee, 0xbd, 0xa4, 0x47, 0x68, 0xe8, 0x68, 0x5c, 0xd9, 0xe1, 0x2d, 0xbb, 0xa2, 0xdf, 0x91,
-	0x1d, 0x03, 0x29, 0xc8, 0xc2, 0xf6, 0x5b, 0x0d, 0x45, 0xee, 0xa5, 0x6f, 0x85, 0x15, 0x3c, 0xaa,
-	0xd0, 0x87, 0x64, 0x7b, 0x06, 0x72, 0x3a, 0xb3, 0xfd, 0xf6, 0x20, 0x39, 0x6c, 0xf3, 0x80, 0xe8,
-	0x7d, 0xd2, 0x91, 0x2a, 0x83, 0x55, 0x7f, 0x0b, 0xc5, 0x1e, 0xd0, 0x2f, 0x49, 0xf7, 0x22, 0xd7,
-	0xe9, 0xa5, 0x95, 0x73, 0xe8, 0x77, 0x70, 0xa7, 0x12, 0x38, 0x5b, 0x62, 0xee, 0x12, 0xe8, 0x6f,
-	0x7b, 0x5b, 0x1e, 0xd1, 0x47, 0x64, 0x77, 0x62, 0xf4, 0x5c, 0x64, 0x99, 0xe9, 0xef, 0x0c, 0x92,
-	0xc3, 0x2e, 0xdf, 0x60, 0x77, 0xc6, 0xae, 0x66, 0xa2, 0x9c, 0xf5, 0x77, 0x07, 0xc9, 0x61, 0x8f,
-	0x07, 0x44, 0xbf, 0x22, 0xc4, 0xe7, 0xf4, 0x49, 0xcc, 0xa1, 0xdf, 0xc5, 0x53, 0x35, 0x09, 0xed,
-	0x93, 0x9d, 0x42, 0xac, 0x73, 0x2d, 0xb2, 0x3e, 0xc1, 0x83, 0x11, 0xb2, 0x13, 0x72, 0xa7, 0xc9,
-	0x5a, 0x49, 0x87, 0xa4, 0x6b, 0x23, 0xe8, 0x27, 0x83, 0xf6, 0xe1, 0xde, 0xcb, 0x07, 0x81, 0x94,
-	0xa6, 0x2a, 0xaf, 0xf4, 0xd8, 0x35, 0xa1, 0x7e, 0xf3, 0xd8, 0x57, 0x65, 0x64, 0xb5, 0xf1, 0x7e,
-	0x8d, 0xbc, 0xbe, 0x84, 0x35, 0x96, 0xa1, 0xcb, 0x23, 0x74, 0x8c, 0xe5, 0xe2, 0x02, 0x72, 0x64,
-	0xbd, 0xcb, 0x3d, 0xa0, 0x94, 0x6c, 0x61, 0xde, 0x6d, 0x14, 0xe2, 0xda, 0xb1, 0xe8, 0xf8, 0x1a,
-	0x59, 0x31, 0x2f, 0x90, 0xdf, 0x2e, 0xaf, 0x04, 0xec, 0x15, 0xe9, 0x79, 0xbf, 0xe7, 0xcb, 0x53,
-	0xc7, 0xc4, 0x43, 0xb2, 0x5d, 0xe0, 0x0a, 0x1d, 0xf6, 0x78, 0x40, 0x2e, 0x12, 0x23, 0x54, 0x56,
-	0x5a, 0x13, 0x3c, 0x46, 0xc8, 0x7e, 0x4f, 0xa2, 0x89, 0x91, 0x15, 0x76, 0x51, 0x52, 0x46, 0x7a,
-	0xb2, 0xf4, 0x92, 0x33, 0x9d, 0x5e, 0xa2, 0xa1, 0x5d, 0xde, 0x90, 0x79, 0x9d, 0xe3, 0x85, 0xd5,
-	0x1f, 0xa5, 0x92, 0x6a, 0x8a, 0x36, 0x51, 0xa7, 0x92, 0xb9, 0xc0, 0x65, 0x79, 0x2a, 0xca, 0x11,
-	0x40, 0x86, 0x19, 0xed, 0xf2, 0x4a, 0xe0, 0x2d, 0x8c, 0x65, 0x7a, 0x19, 0xbc, 0x6c, 0x45, 0x0b,
-	0x95, 0x8c, 0xbd, 0x8a, 0x57, 0x3a, 0x90, 0x5a, 0xd2, 0x23, 0xb2, 0xe3, 0xdb, 0x23, 0x56, 0xe6,
-	0x7e, 0xa3, 0x32, 0x41, 0x8f, 0x47, 0x25, 0xf6, 0x9e, 0xec, 0x37, 0x76, 0xe8, 0x80, 0xb4, 0x45,
-	0x9a, 0x86, 0xa6, 0x38, 0x08, 0x87, 0xe3, 0x31, 0xb7, 0x75, 0x7b, 0x65, 0xd8, 0x2c, 0x92, 0xf4,
-	0xb3, 0x42, 0x02, 0x1c, 0xcf, 0xa2, 0x2c, 0x97, 0x59, 0x28, 0x6c, 0x40, 0x8e, 0x67, 0x57, 0x1c,
-	0xbd, 0xf0, 0xfd, 0xd4, 0xe6, 0x11, 0xd2, 0xe7, 0xe4, 0xc0, 0x47, 0xf5, 0x93, 0xf1, 0x29, 0x06,
-	0x4e, 0x6e, 0x48, 0xd9, 0x53, 0xb2, 0xf7, 0x1e, 0x94, 0xe3, 0xe8, 0x4c, 0xa8, 0xa9, 0xbb, 0x12,
-	0xb9, 0x50, 0x53, 0x74, 0xd3, 0xe1, 0xb8, 0x66, 0xcf, 0x9c, 0x8a, 0x75, 0x2a, 0xaf, 0xd7, 0xe7,
-	0xcb, 0xcf, 0xc5, 0xc2, 0x7e, 0x20, 0xbd, 0x91, 0xb8, 0x86, 0x8d, 0x1e, 0x25, 0x5b, 0xa5, 0xab,
-	0x85, 0xd7, 0xc2, 0x75, 0xed, 0x6c, 0xab, 0x71, 0xf6, 0x09, 0xe9, 0x72, 0x28, 0xf2, 0x35, 0xd6,
-	0xea, 0x96, 0x83, 0xec, 0x94, 0x50, 0x0e, 0x57, 0xe1, 0xe2, 0x80, 0x3d, 0xdf, 0xa4, 0xaf, 0xf3,
-	0xcc, 0x81, 0x78, 0xe1, 0x03, 0x74, 0x3b, 0x0a, 0x96, 0xb8, 0x13, 0x2e, 0x60, 0x80, 0xec, 0x19,
-	0xd9, 0xe7, 0x70, 0xf5, 0x09, 0x96, 0xb1, 0x46, 0x9b, 0x0a, 0x24, 0xf5, 0x0a, 0x78, 0xb5, 0xf7,
-	0x55, 0x29, 0x6f, 0x57, 0x9b, 0x90, 0xfe, 0x26, 0xae, 0xda, 0xb0, 0x3b, 0x93, 0x25, 0x8e, 0x2f,
-	0x37, 0x4a, 0xc6, 0xab, 0xd8, 0x1c, 0x1e, 0x39, 0x4b, 0x68, 0x12, 0x23, 0xeb, 0x70, 0x0f, 0xdc,
-	0xfd, 0xcd, 0xa4, 0x01, 0x3c, 0x8e, 0xb5, 0xea, 0xf0, 0x4a, 0xc0, 0x4e, 0xc9, 0xc3, 0x8d, 0x9f,
-	0x0f, 0xf3, 0x42, 0x1b, 0x7b, 0x1e, 0x5a, 0xfb, 0x3f, 0x36, 0x3d, 0xfb, 0x23, 0xa9, 0x99, 0x1a,
-	0x81, 0xca, 0xc6, 0xfa, 0x38, 0xcb, 0x0c, 0x94, 0xa5, 0x23, 0xde, 0x85, 0x18, 0x89, 0x77, 0x6b,
-	0x7a, 0x40, 0x5a, 0x56, 0x07, 0x0b, 0x2d, 0xab, 0x6b, 0x73, 0xb4, 0xdd, 0x98, 0xa3, 0x94, 0x6c,
-	0x29, 0x6d, 0x21, 0x8c, 0x0c, 0x5c, 0xbb, 0xd0, 0x64, 0x39, 0xd6, 0x97, 0xa0, 0x70, 0x1e, 0xef,
-	0xf2, 0x08, 0xe9, 0x80, 0xec, 0x59, 0xb7, 0x18, 0xad, 0xe7, 0x17, 0x3a, 0xc7, 0x91, 0xdc, 0xe5,
-	0x75, 0x11, 0xfb, 0x96, 0xdc, 0xa9, 0x17, 0xfc, 0x04, 0xea, 0x23, 0x3c, 0xa9, 0xbb, 0x66, 0x3f,
-	0x92, 0x7b, 0x75, 0xd5, 0xb3, 0xc6, 0x6c, 0x4b, 0x6a, 0xb3, 0xed, 0x76, 0x42, 0xbe, 0x21, 0x0f,
-	0x36, 0xc7, 0x3f, 0x82, 0x99, 0xc2, 0x6b, 0x91, 0x0b, 0x95, 0x42, 0x48, 0x3d, 0x89, 0xa9, 0xb3,
-	0xbf, 0x13, 0x74, 0x84, 0x19, 0x9c, 0x1b, 0x78, 0x63, 0x40, 0x58, 0xa0, 0x4f, 0x49, 0x2f, 0x75,
-	0x2b, 0x6d, 0x7e, 0xab, 0x39, 0xdc, 0x0b, 0x32, 0x47, 0x2d, 0x72, 0xe3, 0x5e, 0x8a, 0x56, 0xe0,
-	0x46, 0xf8, 0xf7, 0xa8, 0xf4, 0xc9, 0xfb, 0xe9, 0x1b, 0x10, 0x0e, 0x2a, 0x65, 0x8d, 0xce, 0x16,
-	0xfe, 0x26, 0x78, 0x3e, 0x1b, 0x32, 0xfa, 0x98, 0x10, 0xbd, 0x54, 0x10, 0x1c, 0x76, 0xfc, 0x90,
-	0x46, 0xc9, 0x71, 0x48, 0xd3, 0x6a, 0x2b, 0xf2, 0xf0, 0xd2, 0x79, 0xe0, 0xa4, 0x85, 0x91, 0x29,
-	0xe0, 0x2b, 0xd7, 0xe6, 0x1e, 0x30, 0x43, 0xee, 0xc7, 0x94, 0x4e, 0xa4, 0x92, 0xe5, 0x2c, 0x64,
-	0xf5, 0x35, 0xd9, 0x9f, 0x20, 0x86, 0x46, 0x5a, 0xbd, 0x28, 0x3c, 0x0e, 0xef, 0x63, 0xc8, 0xa1,
-	0xd5, 0xc8, 0xa1, 0x19, 0x5f, 0xfb, 0x46, 0x7c, 0xac, 0xa8, 0x7c, 0x72, 0xb8, 0xd6, 0x97, 0x35,
-	0x26, 0x0d, 0xe2, 0x26, 0x93, 0x41, 0xf6, 0x7f, 0x3c, 0x02, 0x5e, 0xa6, 0x8f, 0x3a, 0x93, 0x93,
-	0xf5, 0x1b, 0xad, 0x26, 0x72, 0x4a, 0xef, 0x92, 0x76, 0xd5, 0x32, 0x6e, 0xe9, 0xca, 0xad, 0x8b,
-	0x78, 0xd3, 0x75, 0xe1, 0x08, 0xbb, 0x16, 0xf9, 0x02, 0x82, 0x39, 0x0f, 0xdc, 0x7f, 0x61, 0xee,
-	0xec, 0x48, 0x30, 0xa1, 0x36, 0x1b, 0xcc, 0xfe, 0x4a, 0x48, 0x8f, 0xc3, 0xd5, 0x48, 0x4e, 0x15,
-	0x17, 0xcb, 0xf1, 0xea, 0xd6, 0x4b, 0x58, 0xeb, 0xd7, 0xd6, 0xbf, 0xfa, 0xd5, 0xae, 0x4e, 0x61,
-	0x15, 0x1d, 0x22, 0x70, 0x29, 0xc3, 0xaa, 0x90, 0x26, 0xb6, 0x56, 0x40, 0xd5, 0x27, 0xa8, 0xe3,
-	0xa7, 0x88, 0xff, 0x04, 0x61, 0xed, 0x5d, 0xc3, 0xed, 0x04, 0x1b, 0xd8, 0x6e, 0x77, 0x49, 0x7b,
-	0x02, 0x80, 0xbf, 0x98, 0x36, 0x77, 0x4b, 0x37, 0x6d, 0x14, 0x2c, 0x7d, 0xeb, 0xe3, 0x27, 0xa5,
-	0xcb, 0x2b, 0x01, 0x7b, 0x4e, 0x0e, 0xfc, 0x38, 0xde, 0x64, 0xb2, 0x89, 0x2d, 0xa9, 0xc5, 0xc6,
-	0x2e, 0x50, 0x4f, 0x1b, 0xfb, 0xce, 0x98, 0x77, 0xd7, 0xa0, 0xac, 0xfb, 0x1a, 0xb9, 0xb1, 0x31,
-	0xd7, 0xd9, 0x22, 0x87, 0xa0, 0x5c, 0x93, 0x38, 0xfa, 0xac, 0x0e, 0xbb, 0x3e, 0xfd, 0x0d, 0x76,
-	0x3e, 0xc0, 0x18, 0x1d, 0xeb, 0xe7, 0x01, 0xfb, 0x82, 0x74, 0x3e, 0x28, 0x3b, 0x7c, 0xe9, 0xc8,
-	0xcc, 0x84, 0x15, 0xf1, 0x69, 0x72, 0x6b, 0xf6, 0xbd, 0x0b, 0xe0, 0x2a, 0x8c, 0x68, 0x1c, 0xba,
-	0xee, 0xdd, 0x93, 0x76, 0xa6, 0x17, 0x36, 0xb4, 0x71, 0xf8, 0x50, 0xdc, 0x90, 0xb2, 0x77, 0x78,
-	0x25, 0xc2, 0x10, 0x2d, 0x4f, 0xa4, 0x8f, 0x6d, 0x22, 0x73, 0xc0, 0x4f, 0x5d, 0x12, 0xbe, 0x82,
-	0x01, 0x7f, 0xee, 0xe1, 0x7a, 0xfd, 0xe4, 0xd7, 0xc7, 0x53, 0x69, 0x67, 0x8b, 0x8b, 0xa3, 0x54,
-	0xcf, 0x5f, 0x0c, 0x87, 0xa9, 0x7a, 0x91, 0xce, 0x84, 0x54, 0xc3, 0xe1, 0x0b, 0x7c, 0xec, 0x2f,
-	0xb6, 0xf1, 0xbf, 0x3c, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x15, 0xd2, 0xd3, 0x68, 0x0b,
-	0x00, 0x00,
+	// 1481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x6e, 0x1b, 0xb7,
+	0x12, 0xc6, 0x6a, 0x2d, 0x5b, 0xa2, 0x65, 0xc7, 0xe1, 0x49, 0x82, 0x85, 0xcf, 0xc9, 0x89, 0xc2,
+	0x83, 0xe4, 0xb8, 0x45, 0xe0, 0x04, 0xf1, 0x4d, 0x11, 0x20, 0x40, 0x9c, 0xc4, 0x8e, 0x83, 0x3a,
+	0x89, 0x41, 0xa9, 0x08, 0x50, 0x14, 0x28, 0xe8, 0x5d, 0x4a, 0x22, 0xbc, 0x5a, 0xae, 0xb9, 0x94,
+	0x25, 0x5d, 0xf4, 0x3d, 0x7a, 0x5d, 0xf4, 0x11, 0xfa, 0x08, 0x7d, 0x81, 0xbe, 0x51, 0x31, 0xfc,
+	0xd9, 0x1f, 0xc7, 0x0e, 0x9a, 0xf6, 0x8e, 0xdf, 0xec, 0x70, 0xc8, 0xf9, 0x66, 0x38, 0x33, 0x8b,
+	0x7a, 0x73, 0x96, 0xa6, 0x5c, 0xef, 0xe6, 0x4a, 0x6a, 0x89, 0xdb, 0x7a, 0x99, 0xf3, 0x62, 0xfb,
+	0xa6, 0x56, 0x2c, 0x2b, 0x58, 0xac, 0x85, 0xcc, 0xec, 0x97, 0xed, 0x0d, 0x16, 0xc7, 0x72, 0x96,
+	0x39, 0x45, 0xf2, 0x5b, 0x0b, 0x6d, 0x7e, 0x34, 0x3b, 0x87, 0x8b, 0xd7, 0x5c, 0x33, 0x91, 0x62,
+	0x82, 0x5a, 0x7a, 0x11, 0x05, 0xfd, 0x60, 0x67, 0xfd, 0x29, 0xde, 0x35, 0x86, 0x76, 0x87, 0x95,
+	0x1d, 0xda, 0xd2, 0x0b, 0xfc, 0x08, 0xad, 0x29, 0x1e, 0x73, 0x91, 0xeb, 0xa8, 0xd5, 0x50, 0xa4,
+	0x56, 0xfa, 0x9a, 0x69, 0x46, 0xbd, 0x0a, 0xbe, 0x83, 0x56, 0x27, 0x5c, 0x8c, 0x27, 0x3a, 0x0a,
+	0xfb, 0xc1, 0x4e, 0x48, 0x1d, 0xc2, 0xb7, 0x50, 0x5b, 0x64, 0x09, 0x5f, 0x44, 0x2b, 0x46, 0x6c,
+	0x01, 0xfe, 0x0f, 0xea, 0x9e, 0xa6, 0x32, 0x3e, 0xd3, 0x62, 0xca, 0xa3, 0xb6, 0xf9, 0x52, 0x09,
+	0xc0, 0x16, 0x9b, 0x82, 0x03, 0xd1, 0xaa, 0xb5, 0x65, 0x11, 0xde, 0x46, 0x9d, 0x91, 0x92, 0x53,
+	0x96, 0x24, 0x2a, 0x5a, 0xeb, 0x07, 0x3b, 0x5d, 0x5a, 0x62, 0xd8, 0xa3, 0x17, 0x13, 0x56, 0x4c,
+	0xa2, 0x4e, 0x3f, 0xd8, 0xe9, 0x51, 0x87, 0xf0, 0x7f, 0x11, 0xb2, 0x3e, 0xbd, 0x67, 0x53, 0x1e,
+	0x75, 0xcd, 0xae, 0x9a, 0x04, 0x47, 0x68, 0x2d, 0x67, 0xcb, 0x54, 0xb2, 0x24, 0x42, 0x66, 0xa3,
+	0x87, 0xe4, 0x10, 0xdd, 0x68, 0xb2, 0x56, 0xe0, 0x3d, 0xd4, 0xd5, 0x1e, 0x44, 0x41, 0x3f, 0xdc,
+	0x59, 0x7f, 0x7a, 0xdb, 0x91, 0xd2, 0x54, 0xa5, 0x95, 0x1e, 0xf9, 0x3d, 0x40, 0xd8, 0x7e, 0xdd,
+	0xb7, 0x61, 0x19, 0x68, 0xa9, 0xec, 0xc1, 0x4a, 0x5c, 0x9c, 0xf1, 0xa5, 0x89, 0x43, 0x97, 0x7a,
+	0x08, 0x94, 0xa5, 0xec, 0x94, 0xa7, 0x86, 0xf6, 0x2e, 0xb5, 0x00, 0x63, 0xb4, 0x62, 0x1c, 0x0f,
+	0x8d, 0xd0, 0xac, 0x81, 0x46, 0x20, 0x6c, 0xa0, 0xd9, 0x34, 0x37, 0x04, 0x77, 0x69, 0x25, 0x30,
+	0x21, 0x49, 0x4e, 0x98, 0x9e, 0x18, 0x86, 0xbb, 0xd4, 0x21, 0xd8, 0x35, 0x67, 0x3a, 0x9e, 0x7c,
+	0xc8, 0xd2, 0xa5, 0x61, 0xb8, 0x43, 0x2b, 0x01, 0xec, 0xca, 0x67, 0xa7, 0x70, 0x2d, 0x4b, 0xb1,
+	0x43, 0xe4, 0x05, 0xea, 0x59, 0x2f, 0x4e, 0xe6, 0x47, 0x40, 0x2c, 0xe8, 0x99, 0x95, 0xb9, 0x7e,
+	0x8f, 0x3a, 0x04, 0x7e, 0x29, 0x96, 0x25, 0x85, 0x56, 0xee, 0xfe, 0x1e, 0x92, 0x9f, 0x03, 0x6f,
+	0x62, 0xa0, 0x99, 0x9e, 0x15, 0x98, 0xa0, 0x9e, 0x28, 0xac, 0xe4, 0x58, 0xc6, 0x67, 0xc6, 0x50,
+	0x87, 0x36, 0x64, 0x56, 0x67, 0x7f, 0xa6, 0xe5, 0x3b, 0x91, 0x89, 0x6c, 0x1c, 0xb5, 0xbc, 0x4e,
+	0x25, 0x03, 0x87, 0x44, 0x71, 0xc4, 0x8a, 0x01, 0xe7, 0x89, 0xe1, 0xa7, 0x43, 0x2b, 0x81, 0xb5,
+	0x30, 0x14, 0xf1, 0x99, 0x3b, 0x65, 0xc5, 0x5b, 0xa8, 0x64, 0xe4, 0x05, 0xda, 0x6c, 0x84, 0xa8,
+	0xc0, 0xbb, 0x68, 0xcd, 0xbe, 0x36, 0x1f, 0xe8, 0x5b, 0x8d, 0x40, 0x3b, 0x3d, 0xea, 0x95, 0xc8,
+	0x4f, 0x68, 0xa3, 0xf1, 0x05, 0xf7, 0x51, 0xc8, 0xe2, 0xd8, 0xbd, 0xb1, 0x4d, 0xb7, 0xd9, 0x6f,
+	0x83, 0x4f, 0xd7, 0xc4, 0xb9, 0x8a, 0x5a, 0x78, 0x7d, 0xd4, 0x56, 0x2e, 0x45, 0x8d, 0x4c, 0x3c,
+	0xb5, 0xdf, 0x65, 0x86, 0x36, 0x88, 0x0e, 0x2b, 0x8a, 0x79, 0xe2, 0x92, 0xcb, 0x21, 0x88, 0x0e,
+	0x24, 0x88, 0x9c, 0xd9, 0x47, 0x1d, 0x52, 0x0f, 0xf1, 0x43, 0xb4, 0x69, 0x7d, 0xf9, 0xa0, 0x2c,
+	0x31, 0x8e, 0xc9, 0x4b, 0x52, 0x72, 0x1f, 0xad, 0xbf, 0xe1, 0x19, 0x30, 0x7b, 0xcc, 0xb2, 0x31,
+	0xa4, 0x65, 0xca, 0xb2, 0xb1, 0x39, 0xa6, 0x4d, 0xcd, 0x9a, 0x3c, 0x00, 0x15, 0x0d, 0x2a, 0x2f,
+	0x97, 0x27, 0xf3, 0xeb, 0xee, 0x42, 0x9e, 0xa1, 0xde, 0x80, 0x5d, 0xf0, 0x52, 0x0f, 0xa3, 0x95,
+	0x82, 0x73, 0xaf, 0x65, 0xd6, 0xb5, 0xbd, 0xad, 0xc6, 0xde, 0x7b, 0xa8, 0x4b, 0x79, 0x9e, 0x2e,
+	0x4d, 0x84, 0xaf, 0xd8, 0x48, 0x8e, 0x10, 0xa6, 0xfc, 0xdc, 0xa5, 0x1b, 0xd7, 0x27, 0xa5, 0xfb,
+	0x32, 0x4d, 0x00, 0xf8, 0x47, 0xe7, 0x20, 0x7c, 0xc9, 0xf8, 0xdc, 0x7c, 0x71, 0x69, 0xeb, 0x20,
+	0x79, 0x8e, 0x36, 0x28, 0x3f, 0x7f, 0xcf, 0xe7, 0x3e, 0xb2, 0x65, 0xdc, 0x82, 0xab, 0xe3, 0xd6,
+	0xaa, 0xc7, 0x8d, 0x3c, 0x30, 0xdb, 0xdf, 0x70, 0xfd, 0xd9, 0xed, 0x64, 0x84, 0xa2, 0xf2, 0xbe,
+	0xb5, 0x4a, 0x7c, 0x2c, 0x0a, 0x53, 0x5b, 0xa1, 0xce, 0x0d, 0x17, 0xfe, 0xa9, 0x59, 0x04, 0x96,
+	0x8c, 0x49, 0x73, 0x62, 0x9b, 0x5a, 0x00, 0x89, 0x92, 0x08, 0xc5, 0xcd, 0x76, 0x13, 0xc3, 0x36,
+	0xad, 0x04, 0xe4, 0x08, 0xdd, 0x29, 0xcf, 0x79, 0x3b, 0xcd, 0xa5, 0xd2, 0x27, 0xae, 0xec, 0x7c,
+	0x61, 0x41, 0x22, 0x3f, 0xa0, 0xe8, 0x92, 0xa5, 0x8f, 0x65, 0x11, 0xf1, 0xc5, 0x2a, 0xa8, 0x15,
+	0xab, 0xaa, 0xb0, 0xb4, 0xea, 0x85, 0xa5, 0xb2, 0x1e, 0xd6, 0xad, 0xff, 0x1a, 0xd4, 0x2e, 0x3a,
+	0xe0, 0x59, 0x32, 0x94, 0xfb, 0x49, 0xa2, 0x78, 0x51, 0x80, 0x71, 0x20, 0xc0, 0x1b, 0x87, 0x35,
+	0xde, 0x44, 0x2d, 0x2d, 0x9d, 0xe1, 0x96, 0x96, 0xb5, 0x16, 0x12, 0x36, 0x5a, 0x08, 0x46, 0x2b,
+	0x99, 0xd4, 0xdc, 0x15, 0x4b, 0xb3, 0x06, 0xc7, 0x45, 0x31, 0x94, 0x67, 0x3c, 0x33, 0x85, 0xb2,
+	0x43, 0x3d, 0xc4, 0x7d, 0xb4, 0xae, 0x61, 0x31, 0x58, 0x4e, 0x4f, 0x65, 0x6a, 0x6a, 0x65, 0x97,
+	0xd6, 0x45, 0xe4, 0x2b, 0x74, 0xa3, 0x9e, 0x66, 0x87, 0xbc, 0xde, 0xbd, 0x82, 0xfa, 0xd1, 0xe4,
+	0x39, 0xba, 0x59, 0x57, 0x3d, 0x6e, 0x54, 0xf5, 0x3a, 0x51, 0x57, 0xd3, 0xfd, 0x7f, 0x74, 0xbb,
+	0xdc, 0xfe, 0x8e, 0xab, 0x31, 0x7f, 0xc9, 0x52, 0x96, 0xc5, 0xdc, 0xb9, 0x1e, 0x78, 0xd7, 0xc9,
+	0x1f, 0x81, 0x39, 0xc8, 0x78, 0x70, 0xa2, 0xf8, 0x2b, 0xc5, 0x99, 0xe6, 0xf8, 0x3e, 0xea, 0xc5,
+	0xb0, 0x92, 0xea, 0xc7, 0xda, 0x81, 0xeb, 0x4e, 0x06, 0xd4, 0x1a, 0x6e, 0xa0, 0x49, 0xb6, 0x1c,
+	0x37, 0xcc, 0xb6, 0xe2, 0xc2, 0x3a, 0xef, 0xaa, 0x91, 0x45, 0xa6, 0xa8, 0x66, 0x5a, 0xc9, 0x64,
+	0x66, 0xf3, 0xcc, 0xf2, 0xd9, 0x90, 0xe1, 0xbb, 0x08, 0xc9, 0x79, 0xc6, 0xdd, 0x81, 0xb6, 0x07,
+	0x75, 0x8d, 0x64, 0xdf, 0xb9, 0xa9, 0xa5, 0x66, 0xa9, 0x6b, 0xf2, 0x16, 0x80, 0x34, 0x57, 0x22,
+	0xe6, 0xa6, 0xfb, 0x84, 0xd4, 0x02, 0xa2, 0xd0, 0x2d, 0xef, 0xd2, 0xa1, 0xc8, 0x44, 0x31, 0x71,
+	0x5e, 0xfd, 0x0f, 0x6d, 0x8c, 0x0c, 0xe6, 0x0d, 0xb7, 0x7a, 0x5e, 0xb8, 0xef, 0x12, 0xcf, 0xf9,
+	0xd0, 0x6a, 0xf8, 0xd0, 0xbc, 0x5f, 0x78, 0xe9, 0x7e, 0x24, 0xaf, 0xce, 0xa4, 0xfc, 0x42, 0x9e,
+	0xd5, 0x98, 0x54, 0x06, 0x37, 0x99, 0x74, 0xb2, 0x7f, 0x72, 0x22, 0x37, 0xc9, 0xf4, 0x4e, 0x26,
+	0x62, 0xb4, 0x7c, 0x25, 0xb3, 0x91, 0x18, 0xe3, 0x2d, 0x14, 0x56, 0x0f, 0x12, 0x96, 0x10, 0x6e,
+	0x99, 0xfb, 0x4c, 0x97, 0x39, 0x10, 0x76, 0xc1, 0xd2, 0x19, 0xf7, 0xcf, 0xc7, 0x00, 0x18, 0x95,
+	0xa6, 0x60, 0x47, 0x70, 0xe5, 0x62, 0x53, 0x62, 0xf2, 0x4b, 0x0b, 0xf5, 0x28, 0x3f, 0x1f, 0x88,
+	0x71, 0x46, 0xd9, 0x7c, 0xb8, 0xb8, 0x32, 0x09, 0x6b, 0xd5, 0xa0, 0xf5, 0x49, 0x35, 0xd0, 0x8b,
+	0x23, 0xbe, 0xf0, 0x07, 0x1a, 0x00, 0x2e, 0xf3, 0x45, 0x2e, 0x94, 0x7f, 0x5a, 0x0e, 0x55, 0xf3,
+	0x5f, 0xdb, 0xd6, 0x28, 0x03, 0x6c, 0xec, 0xe1, 0xc1, 0xad, 0x39, 0x1b, 0x00, 0xc0, 0xd9, 0x11,
+	0xe7, 0x66, 0x80, 0x0b, 0x29, 0x2c, 0xa1, 0x96, 0x65, 0x7c, 0x6e, 0x9f, 0xbe, 0x99, 0xcf, 0xba,
+	0xb4, 0x12, 0xe0, 0xaf, 0xd1, 0xd6, 0x74, 0x96, 0x6a, 0x01, 0x9e, 0x9c, 0x98, 0x62, 0x52, 0x44,
+	0xeb, 0xfd, 0x70, 0xa7, 0x4b, 0x3f, 0x91, 0xe3, 0x5d, 0x84, 0x4b, 0xd9, 0x70, 0xa2, 0x78, 0x31,
+	0x91, 0x69, 0x12, 0xf5, 0xcc, 0xa5, 0xae, 0xf8, 0x42, 0x1e, 0xa2, 0x4d, 0xdb, 0x60, 0x4a, 0x96,
+	0x4a, 0xbf, 0x83, 0x9a, 0xdf, 0xe4, 0xd4, 0xe8, 0x49, 0xa5, 0x0f, 0x94, 0x3a, 0xb8, 0xe0, 0x99,
+	0x86, 0x89, 0x13, 0x4a, 0xd2, 0x54, 0x26, 0xb3, 0x94, 0x3b, 0xe5, 0x9a, 0x04, 0x42, 0xa3, 0xa5,
+	0xfb, 0x6a, 0xa9, 0x2d, 0x31, 0x9c, 0xc1, 0x95, 0x92, 0x3e, 0x37, 0x2c, 0x20, 0xff, 0x46, 0xed,
+	0xb7, 0x99, 0xde, 0x7b, 0x0a, 0x81, 0x4a, 0x98, 0x66, 0xbe, 0xd9, 0xc2, 0x9a, 0x7c, 0x03, 0x17,
+	0x38, 0x77, 0xcd, 0xc5, 0xb4, 0x0b, 0xe8, 0xe4, 0x42, 0x4f, 0xe4, 0x4c, 0xbb, 0x12, 0xe1, 0x06,
+	0xab, 0x4b, 0x52, 0x72, 0x60, 0xd2, 0xcd, 0x95, 0xff, 0xe2, 0x50, 0xd8, 0xbb, 0x8d, 0x44, 0xca,
+	0xcd, 0xac, 0x1c, 0xb8, 0x09, 0xdb, 0xe1, 0x6b, 0x5b, 0xf1, 0x13, 0xd3, 0x69, 0x29, 0x8f, 0xe5,
+	0x05, 0x57, 0xe5, 0xfc, 0xb4, 0x8d, 0x3a, 0x63, 0x96, 0x1f, 0x8b, 0xa9, 0xd0, 0xee, 0xba, 0x25,
+	0x26, 0x31, 0xea, 0x1e, 0xbd, 0x76, 0x9a, 0x7f, 0xbd, 0x02, 0x5e, 0x3b, 0x19, 0x35, 0x7e, 0x31,
+	0x36, 0x5c, 0x8a, 0x91, 0x67, 0x08, 0x95, 0x87, 0x14, 0xf8, 0x11, 0xea, 0xb8, 0x9f, 0x22, 0x3f,
+	0xcf, 0x6d, 0xb9, 0x91, 0xac, 0x54, 0xa2, 0xa5, 0x06, 0x91, 0x86, 0x99, 0x6f, 0xf9, 0xb2, 0x80,
+	0x51, 0xfd, 0xef, 0x32, 0x73, 0xe5, 0xc8, 0x5e, 0xba, 0xb6, 0x52, 0x2f, 0xee, 0x73, 0xf4, 0x2f,
+	0x93, 0x6d, 0xb6, 0x8f, 0xfa, 0x83, 0xbf, 0x74, 0x08, 0xc5, 0x4f, 0xd0, 0xea, 0x88, 0x89, 0x94,
+	0xc3, 0x45, 0x40, 0x3d, 0x72, 0xea, 0x75, 0x4f, 0x0e, 0x20, 0xa5, 0xa8, 0xd3, 0x23, 0xfb, 0xe8,
+	0xe6, 0x27, 0x1f, 0x3f, 0xeb, 0xeb, 0x16, 0x0a, 0xb9, 0xf2, 0xa3, 0x3d, 0x2c, 0x5f, 0xde, 0xfb,
+	0xfe, 0xee, 0x58, 0xe8, 0xc9, 0xec, 0x74, 0x37, 0x96, 0xd3, 0xc7, 0x7b, 0x7b, 0x71, 0xf6, 0x38,
+	0x9e, 0x30, 0x91, 0xed, 0xed, 0x3d, 0x36, 0xa7, 0x9f, 0xae, 0x9a, 0xdf, 0xd0, 0xbd, 0x3f, 0x07,
+	0x00, 0xd1, 0xc0, 0x3c, 0x31, 0xbf, 0x0e, 0x00, 0x00,
 }
@@ -18,8 +18,10 @@ import (
 	"net/http"
 	_ "net/http/pprof" //
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime"
+	"syscall"
 
 	"github.com/33cn/chain33/p2p"
 
@@ -181,6 +183,9 @@ func RunChain33(name, defCfg string) {
 	//jsonrpc, grpc, channel 三种模式
 	rpcapi := rpc.New(chain33Cfg)
 	rpcapi.SetQueueClient(q.Client())
+	if *configPath != "" {
+		go reloadRPCAuth(*configPath, defCfg)
+	}
 
 	log.Info("loading wallet module")
 	walletm := wallet.New(chain33Cfg)
@@ -233,6 +238,25 @@ func RunChain33(name, defCfg string) {
 	q.Start()
 }
 
+//reloadRPCAuth 收到SIGHUP信号时重新读取配置文件, 更新rpc的api key配置
+func reloadRPCAuth(path, defCfg string) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	for range c {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Error("reload rpc auth", "err", r)
+				}
+			}()
+			cfg, _ := types.InitCfgString(types.MergeCfg(types.ReadFile(path), defCfg))
+			if err := rpc.InitAPIKeys(cfg.RPC); err != nil {
+				log.Error("reload rpc auth", "err", err)
+			}
+		}()
+	}
+}
+
 func createFile(filename string) (*os.File, error) {
 	f, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
 	if err != nil {
@@ -80,6 +80,7 @@ func Run(RPCAddr, ParaName, name string) {
 
 	rootCmd.AddCommand(
 		commands.CertCmd(),
+		commands.APITokenCmd(),
 		commands.AccountCmd(),
 		commands.BlockCmd(),
 		commands.CoinsCmd(),
@@ -91,6 +92,7 @@ func Run(RPCAddr, ParaName, name string) {
 		commands.TxCmd(),
 		commands.WalletCmd(),
 		commands.VersionCmd(),
+		commands.DiscoverCmd(),
 		commands.OneStepSendCmd(),
 		closeCmd,
 		commands.AssetCmd(),
@@ -350,7 +350,10 @@ func PreExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block,
 			if errReturn { //认为这个是一个错误的区块
 				return nil, nil, types.ErrBlockExec
 			}
-			deltxs = append(deltxs, errTx)
+			//区块gas不够的交易不打包, 也不从mempool删除, 等待下一个区块
+			if !isBlockGasLimitReceipt(receipt) {
+				deltxs = append(deltxs, errTx)
+			}
 			continue
 		}
 		block.Txs[index] = block.Txs[i]
@@ -416,6 +419,15 @@ func PreExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block,
 	return &detail, deltxs, nil
 }
 
+func isBlockGasLimitReceipt(receipt *types.Receipt) bool {
+	for _, l := range receipt.GetLogs() {
+		if l.Ty == types.TyLogErr && string(l.Log) == types.ErrBlockGasLimit.Error() {
+			return true
+		}
+	}
+	return false
+}
+
 // ExecBlockUpgrade : just exec block
 func ExecBlockUpgrade(client queue.Client, prevStateRoot []byte, block *types.Block, sync bool) error {
 	//发送执行交易给execs模块
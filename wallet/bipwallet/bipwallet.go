@@ -7,6 +7,8 @@ package bipwallet
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/33cn/chain33/common/address"
@@ -73,10 +75,56 @@ type HDWallet struct {
 	KeyType   uint32
 }
 
+// DerivationPath 钱包通过索引生成秘钥对使用的bip44路径 m/44'/coin'/0'/0/index
+func DerivationPath(coinType, index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/0'/0/%d", coinType-bip32.FirstHardenedChild, index)
+}
+
+// ParseDerivationPath 解析bip44路径 m/44'/coin'/account'/change/index, 返回的coin和account带有hardened标志
+func ParseDerivationPath(path string) (coin, account, change, index uint32, err error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) != 6 || parts[0] != "m" || parts[1] != "44'" {
+		return 0, 0, 0, 0, types.ErrDerivationPath
+	}
+	var values [4]uint32
+	for i, part := range parts[2:] {
+		//coin和account必须是hardened, change和index不能是hardened
+		hardened := strings.HasSuffix(part, "'")
+		if hardened != (i < 2) {
+			return 0, 0, 0, 0, types.ErrDerivationPath
+		}
+		v, err := strconv.ParseUint(strings.TrimSuffix(part, "'"), 10, 31)
+		if err != nil {
+			return 0, 0, 0, 0, types.ErrDerivationPath
+		}
+		values[i] = uint32(v)
+	}
+	if values[2] > 1 {
+		return 0, 0, 0, 0, types.ErrDerivationPath
+	}
+	return values[0] + bip32.FirstHardenedChild, values[1] + bip32.FirstHardenedChild, values[2], values[3], nil
+}
+
 // NewKeyPair 通过索引生成新的秘钥对
 func (w *HDWallet) NewKeyPair(index uint32) (priv, pub []byte, err error) {
+	return w.newKeyPair(bip32.FirstHardenedChild, 0, index)
+}
+
+// NewKeyPairByPath 通过bip44路径生成秘钥对, 路径中的币种必须和钱包的币种相同
+func (w *HDWallet) NewKeyPairByPath(path string) (priv, pub []byte, err error) {
+	coin, account, change, index, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if coin != w.CoinType {
+		return nil, nil, types.ErrDerivationPath
+	}
+	return w.newKeyPair(account, change, index)
+}
+
+func (w *HDWallet) newKeyPair(account, change, index uint32) (priv, pub []byte, err error) {
 	//bip44 标准 32字节私钥
-	key, err := bip44.NewKeyFromMasterKey(w.MasterKey, w.CoinType, bip32.FirstHardenedChild, 0, index)
+	key, err := bip44.NewKeyFromMasterKey(w.MasterKey, w.CoinType, account, change, index)
 	if err != nil {
 		return nil, nil, err
 	}
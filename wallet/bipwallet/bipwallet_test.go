@@ -99,3 +99,34 @@ func TestSm2PrivPub(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, tpub, pub)
 }
+
+func TestDerivationPath(t *testing.T) {
+	path := DerivationPath(TypeBty, 5)
+	assert.Equal(t, "m/44'/13107'/0'/0/5", path)
+	coin, account, change, index, err := ParseDerivationPath(path)
+	assert.Nil(t, err)
+	assert.Equal(t, TypeBty, coin)
+	assert.Equal(t, uint32(0x80000000), account)
+	assert.Equal(t, uint32(0), change)
+	assert.Equal(t, uint32(5), index)
+
+	for _, bad := range []string{"", "m/44'/13107'/0'/0", "m/44/13107'/0'/0/5", "m/44'/13107/0'/0/5", "m/44'/13107'/0'/0'/5", "m/44'/13107'/0'/2/5", "m/44'/13107'/0'/0/x"} {
+		_, _, _, _, err = ParseDerivationPath(bad)
+		assert.Equal(t, types.ErrDerivationPath, err, bad)
+	}
+
+	//默认路径和索引生成的秘钥对相同
+	wallet, err := NewWalletFromMnemonic(TypeYcc, types.SECP256K1, mnem)
+	assert.Nil(t, err)
+	priv, pub, err := wallet.NewKeyPair(3)
+	assert.Nil(t, err)
+	priv2, pub2, err := wallet.NewKeyPairByPath(DerivationPath(TypeYcc, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, priv, priv2)
+	assert.Equal(t, pub, pub2)
+	_, pub3, err := wallet.NewKeyPairByPath("m/44'/13108'/1'/0/3")
+	assert.Nil(t, err)
+	assert.NotEqual(t, pub, pub3)
+	_, _, err = wallet.NewKeyPairByPath(DerivationPath(TypeBty, 3))
+	assert.Equal(t, types.ErrDerivationPath, err)
+}
@@ -7,9 +7,167 @@ package common
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/33cn/chain33/types"
+	"golang.org/x/crypto/scrypt"
+)
+
+/*
+私钥的加密存储格式:
+旧格式: aes cbc加密, 密钥由password截断或者补0得到, 没有版本号, 长度是分组长度的整数倍
+版本1: version(1) | salt(16) | nonce(12) | aes-gcm密文和tag, 密钥由scrypt(password, salt)派生,
+version和salt作为gcm的附加数据. 私钥长度为32或者64字节, 加密后的长度不是分组长度的整数倍, 据此和旧格式区分
+*/
+
+const (
+	privkeyVersion  = 1
+	privkeySaltLen  = 16
+	privkeyNonceLen = 12
+	privkeyHeadLen  = 1 + privkeySaltLen + privkeyNonceLen
+	//scrypt参数, 派生一次约需要32M内存
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	//缓存的派生密钥数量上限
+	maxKdfCache = 256
 )
 
-// CBCEncrypterPrivkey 使用钱包的password对私钥进行aes cbc加密,返回加密后的privkey
+//scrypt派生密钥比较耗时, 缓存已经派生的密钥, 钱包锁定或者修改密码时清空
+var kdfCache = struct {
+	sync.Mutex
+	keys map[[32]byte][]byte
+}{keys: make(map[[32]byte][]byte)}
+
+func deriveKey(password, salt []byte) ([]byte, error) {
+	return scryptKey(password, salt, scryptN, scryptR, scryptP, scryptKeyLen)
+}
+
+func scryptKey(password, salt []byte, n, r, p, keyLen int) ([]byte, error) {
+	id := sha256.Sum256([]byte(fmt.Sprintf("%d:%d:%d:%d:%x:%x", n, r, p, keyLen, salt, password)))
+	kdfCache.Lock()
+	defer kdfCache.Unlock()
+	if key, ok := kdfCache.keys[id]; ok {
+		return key, nil
+	}
+	key, err := scrypt.Key(password, salt, n, r, p, keyLen)
+	if err != nil {
+		return nil, err
+	}
+	if len(kdfCache.keys) >= maxKdfCache {
+		kdfCache.keys = make(map[[32]byte][]byte)
+	}
+	kdfCache.keys[id] = key
+	return key, nil
+}
+
+//ClearKDFCache 清空缓存的派生密钥, 钱包锁定或者修改密码后调用
+func ClearKDFCache() {
+	kdfCache.Lock()
+	defer kdfCache.Unlock()
+	kdfCache.keys = make(map[[32]byte][]byte)
+}
+
+func randomBytes(size int) ([]byte, error) {
+	b := make([]byte, size)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+//PrivkeyEncrypter 使用同一个随机salt派生的密钥加密一批私钥, 修改密码或者迁移时每次调用生成新的salt
+type PrivkeyEncrypter struct {
+	salt []byte
+	aead cipher.AEAD
+}
+
+//NewPrivkeyEncrypter 生成随机salt并派生加密私钥的密钥
+func NewPrivkeyEncrypter(password []byte) (*PrivkeyEncrypter, error) {
+	salt, err := randomBytes(privkeySaltLen)
+	if err != nil {
+		return nil, err
+	}
+	key, err := deriveKey(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivkeyEncrypter{salt: salt, aead: aead}, nil
+}
+
+//Encrypt 加密私钥, 返回版本1格式的密文, 每次使用随机的nonce
+func (e *PrivkeyEncrypter) Encrypt(privkey []byte) ([]byte, error) {
+	head := make([]byte, privkeyHeadLen, privkeyHeadLen+len(privkey)+e.aead.Overhead())
+	head[0] = privkeyVersion
+	copy(head[1:], e.salt)
+	if _, err := io.ReadFull(rand.Reader, head[1+privkeySaltLen:]); err != nil {
+		return nil, err
+	}
+	encrypted := e.aead.Seal(head, head[1+privkeySaltLen:], privkey, head[:1+privkeySaltLen])
+	if IsLegacyPrivkey(encrypted) {
+		return nil, types.ErrPrivateKeyLen
+	}
+	return encrypted, nil
+}
+
+// EncryptPrivkey 使用钱包的password对私钥进行加密, 每个私钥使用新的随机salt, 返回版本1格式的密文
+func EncryptPrivkey(password []byte, privkey []byte) ([]byte, error) {
+	e, err := NewPrivkeyEncrypter(password)
+	if err != nil {
+		return nil, err
+	}
+	return e.Encrypt(privkey)
+}
+
+// DecryptPrivkey 使用钱包的password解密私钥, 兼容旧格式. 新格式在密码错误或者数据被篡改时返回错误
+func DecryptPrivkey(password []byte, encrypted []byte) ([]byte, error) {
+	if IsLegacyPrivkey(encrypted) {
+		if len(encrypted) == 0 {
+			return nil, types.ErrDecryptPrivkey
+		}
+		return CBCDecrypterPrivkey(password, encrypted), nil
+	}
+	if len(encrypted) < privkeyHeadLen || encrypted[0] != privkeyVersion {
+		return nil, types.ErrDecryptPrivkey
+	}
+	key, err := deriveKey(password, encrypted[1:1+privkeySaltLen])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	privkey, err := aead.Open(nil, encrypted[1+privkeySaltLen:privkeyHeadLen], encrypted[privkeyHeadLen:], encrypted[:1+privkeySaltLen])
+	if err != nil {
+		return nil, types.ErrDecryptPrivkey
+	}
+	return privkey, nil
+}
+
+// IsLegacyPrivkey 是否是旧的aes cbc格式加密的私钥, 需要迁移到新的格式
+func IsLegacyPrivkey(encrypted []byte) bool {
+	return len(encrypted)%aes.BlockSize == 0
+}
+
+// CBCEncrypterPrivkey 使用钱包的password对私钥进行aes cbc加密,返回加密后的privkey, 只用于兼容旧的存储格式
 func CBCEncrypterPrivkey(password []byte, privkey []byte) []byte {
 	key := make([]byte, 32)
 	Encrypted := make([]byte, len(privkey))
@@ -31,7 +189,7 @@ func CBCEncrypterPrivkey(password []byte, privkey []byte) []byte {
 	return Encrypted
 }
 
-// CBCDecrypterPrivkey 使用钱包的password对私钥进行aes cbc解密,返回解密后的privkey
+// CBCDecrypterPrivkey 使用钱包的password对私钥进行aes cbc解密,返回解密后的privkey, 只用于兼容旧的存储格式
 func CBCDecrypterPrivkey(password []byte, privkey []byte) []byte {
 	key := make([]byte, 32)
 	if len(password) > 32 {
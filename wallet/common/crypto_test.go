@@ -0,0 +1,103 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptPrivkey(t *testing.T) {
+	priv, _ := hex.DecodeString("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	password := []byte("password123")
+	encrypted, err := EncryptPrivkey(password, priv)
+	require.Nil(t, err)
+	assert.False(t, IsLegacyPrivkey(encrypted))
+	decrypted, err := DecryptPrivkey(password, encrypted)
+	require.Nil(t, err)
+	assert.Equal(t, priv, decrypted)
+
+	//每次加密使用新的随机salt
+	encrypted2, err := EncryptPrivkey(password, priv)
+	require.Nil(t, err)
+	assert.False(t, bytes.Equal(encrypted[1:1+privkeySaltLen], encrypted2[1:1+privkeySaltLen]))
+
+	//同一个encrypter加密的私钥共用salt, nonce不同
+	e, err := NewPrivkeyEncrypter(password)
+	require.Nil(t, err)
+	enc1, err := e.Encrypt(priv)
+	require.Nil(t, err)
+	enc2, err := e.Encrypt(priv)
+	require.Nil(t, err)
+	assert.Equal(t, enc1[1:1+privkeySaltLen], enc2[1:1+privkeySaltLen])
+	assert.False(t, bytes.Equal(enc1, enc2))
+	decrypted, err = DecryptPrivkey(password, enc2)
+	require.Nil(t, err)
+	assert.Equal(t, priv, decrypted)
+}
+
+func TestDecryptPrivkeyError(t *testing.T) {
+	priv, _ := hex.DecodeString("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	password := []byte("password123")
+	encrypted, err := EncryptPrivkey(password, priv)
+	require.Nil(t, err)
+
+	//密码错误
+	_, err = DecryptPrivkey([]byte("password124"), encrypted)
+	assert.Equal(t, types.ErrDecryptPrivkey, err)
+
+	//篡改版本, salt, nonce或者密文
+	for _, i := range []int{0, 1, 1 + privkeySaltLen, privkeyHeadLen, len(encrypted) - 1} {
+		tampered := make([]byte, len(encrypted))
+		copy(tampered, encrypted)
+		tampered[i] ^= 1
+		_, err = DecryptPrivkey(password, tampered)
+		assert.Equal(t, types.ErrDecryptPrivkey, err, "index %d", i)
+	}
+	_, err = DecryptPrivkey(password, encrypted[:privkeyHeadLen-1])
+	assert.Equal(t, types.ErrDecryptPrivkey, err)
+	_, err = DecryptPrivkey(password, nil)
+	assert.Equal(t, types.ErrDecryptPrivkey, err)
+}
+
+func TestLegacyPrivkey(t *testing.T) {
+	priv, _ := hex.DecodeString("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	password := []byte("password123")
+	legacy := CBCEncrypterPrivkey(password, priv)
+	assert.True(t, IsLegacyPrivkey(legacy))
+	decrypted, err := DecryptPrivkey(password, legacy)
+	require.Nil(t, err)
+	assert.Equal(t, priv, decrypted)
+
+	encrypted, err := EncryptPrivkey(password, priv)
+	require.Nil(t, err)
+	assert.False(t, IsLegacyPrivkey(encrypted))
+	//64字节的私钥加密后也不会被当作旧格式
+	encrypted, err = EncryptPrivkey(password, append(priv, priv...))
+	require.Nil(t, err)
+	assert.False(t, IsLegacyPrivkey(encrypted))
+}
+
+func TestClearKDFCache(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	key, err := deriveKey([]byte("password123"), salt)
+	require.Nil(t, err)
+	kdfCache.Lock()
+	assert.NotEqual(t, 0, len(kdfCache.keys))
+	kdfCache.Unlock()
+
+	ClearKDFCache()
+	kdfCache.Lock()
+	assert.Equal(t, 0, len(kdfCache.keys))
+	kdfCache.Unlock()
+	key2, err := deriveKey([]byte("password123"), salt)
+	require.Nil(t, err)
+	assert.Equal(t, key, key2)
+}
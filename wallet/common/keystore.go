@@ -0,0 +1,199 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/33cn/chain33/common/crypto/sha3"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+/*
+keystore v3 json文件格式, 和以太坊等工具兼容:
+私钥使用aes-128-ctr加密, 密钥由scrypt或者pbkdf2派生, mac = keccak256(derivedKey[16:32] + ciphertext)
+address, label和signType为chain33的账户信息, 其他工具会忽略不认识的字段
+*/
+
+const (
+	keystoreVersion = 3
+	keystoreCipher  = "aes-128-ctr"
+	keystoreSaltLen = 32
+	//和geth的标准参数相同, 派生一次约需要256M内存
+	keystoreScryptN = 1 << 18
+	keystoreScryptR = 8
+	keystoreScryptP = 1
+	keystoreKeyLen  = 32
+	//导入时允许的最大参数
+	maxKeystoreKeyLen    = 64
+	maxKeystoreScryptMem = 1 << 30
+	maxKeystoreScryptP   = 16
+	maxKeystorePbkdf2C   = 1 << 24
+)
+
+// Keystore keystore v3 文件
+type Keystore struct {
+	Address  string         `json:"address"`
+	Crypto   KeystoreCrypto `json:"crypto"`
+	ID       string         `json:"id"`
+	Version  int            `json:"version"`
+	Label    string         `json:"label,omitempty"`
+	SignType string         `json:"signType,omitempty"`
+}
+
+// KeystoreCrypto keystore中加密的私钥和加密参数
+type KeystoreCrypto struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams KeystoreCipherParams   `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+// KeystoreCipherParams 加密参数
+type KeystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// EncryptKeystore 使用password加密私钥生成keystore, 每个keystore文件使用新的随机salt
+func EncryptKeystore(password []byte, privkey []byte, addr, label, signType string) (*Keystore, error) {
+	salt, err := randomBytes(keystoreSaltLen)
+	if err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key(password, salt, keystoreScryptN, keystoreScryptR, keystoreScryptP, keystoreKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+	ciphertext, err := aesCTR(key[:16], iv, privkey)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return nil, err
+	}
+	//uuid version 4
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return &Keystore{
+		Address: addr,
+		Crypto: KeystoreCrypto{
+			Cipher:       keystoreCipher,
+			CipherText:   hex.EncodeToString(ciphertext),
+			CipherParams: KeystoreCipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          "scrypt",
+			KDFParams: map[string]interface{}{
+				"n":     keystoreScryptN,
+				"r":     keystoreScryptR,
+				"p":     keystoreScryptP,
+				"dklen": keystoreKeyLen,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(keystoreMac(key, ciphertext)),
+		},
+		ID:       fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Version:  keystoreVersion,
+		Label:    label,
+		SignType: signType,
+	}, nil
+}
+
+// DecryptKeystore 解析keystore v3 json文件并使用password解密私钥, 支持scrypt和pbkdf2两种密钥派生方式
+func DecryptKeystore(password []byte, data []byte) (*Keystore, []byte, error) {
+	var ks Keystore
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, nil, types.ErrKeystoreFormat
+	}
+	if ks.Version != keystoreVersion || ks.Crypto.Cipher != keystoreCipher {
+		return nil, nil, types.ErrKeystoreFormat
+	}
+	ciphertext, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, nil, types.ErrKeystoreFormat
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, nil, types.ErrKeystoreFormat
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, nil, types.ErrKeystoreFormat
+	}
+	key, err := keystoreKDF(password, ks.Crypto.KDF, ks.Crypto.KDFParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hmac.Equal(mac, keystoreMac(key, ciphertext)) {
+		return nil, nil, types.ErrKeystorePasswd
+	}
+	privkey, err := aesCTR(key[:16], iv, ciphertext)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ks, privkey, nil
+}
+
+func keystoreKDF(password []byte, kdf string, params map[string]interface{}) ([]byte, error) {
+	getInt := func(name string) int {
+		//json解析的数字为float64
+		v, _ := params[name].(float64)
+		return int(v)
+	}
+	saltHex, _ := params["salt"].(string)
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil || len(salt) == 0 {
+		return nil, types.ErrKeystoreFormat
+	}
+	dklen := getInt("dklen")
+	if dklen < keystoreKeyLen || dklen > maxKeystoreKeyLen {
+		return nil, types.ErrKeystoreFormat
+	}
+	switch kdf {
+	case "scrypt":
+		//限制参数大小, 防止导入的文件消耗过多的内存
+		n, r, p := getInt("n"), getInt("r"), getInt("p")
+		if r <= 0 || n > maxKeystoreScryptMem/128/r || p > maxKeystoreScryptP {
+			return nil, types.ErrKeystoreFormat
+		}
+		return scryptKey(password, salt, n, r, p, dklen)
+	case "pbkdf2":
+		if prf, _ := params["prf"].(string); prf != "hmac-sha256" || getInt("c") <= 0 || getInt("c") > maxKeystorePbkdf2C {
+			return nil, types.ErrKeystoreFormat
+		}
+		return pbkdf2.Key(password, salt, getInt("c"), dklen, sha256.New), nil
+	}
+	return nil, types.ErrKeystoreFormat
+}
+
+func keystoreMac(key, ciphertext []byte) []byte {
+	mac := sha3.KeccakSum256(append(append([]byte{}, key[16:32]...), ciphertext...))
+	return mac[:]
+}
+
+func aesCTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
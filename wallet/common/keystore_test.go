@@ -0,0 +1,67 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//web3 secret storage 文档中的测试数据
+var keystoreVectors = []string{
+	`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+	`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+}
+
+func TestDecryptKeystoreVector(t *testing.T) {
+	for _, vector := range keystoreVectors {
+		_, priv, err := DecryptKeystore([]byte("testpassword"), []byte(vector))
+		require.Nil(t, err)
+		assert.Equal(t, "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d", hex.EncodeToString(priv))
+	}
+	_, _, err := DecryptKeystore([]byte("wrong"), []byte(keystoreVectors[0]))
+	assert.Equal(t, types.ErrKeystorePasswd, err)
+	_, _, err = DecryptKeystore([]byte("testpassword"), []byte(`{"version":1}`))
+	assert.Equal(t, types.ErrKeystoreFormat, err)
+	//限制派生密钥的长度
+	for _, dklen := range []string{`"dklen":16`, `"dklen":65`, `"dklen":100000000`} {
+		vector := strings.Replace(keystoreVectors[0], `"dklen":32`, dklen, 1)
+		_, _, err = DecryptKeystore([]byte("testpassword"), []byte(vector))
+		assert.Equal(t, types.ErrKeystoreFormat, err, dklen)
+	}
+}
+
+func TestEncryptKeystore(t *testing.T) {
+	priv, _ := hex.DecodeString("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	ks, err := EncryptKeystore([]byte("pass"), priv, "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4", "label", "secp256k1")
+	require.Nil(t, err)
+	data, err := json.Marshal(ks)
+	require.Nil(t, err)
+
+	ks2, priv2, err := DecryptKeystore([]byte("pass"), data)
+	require.Nil(t, err)
+	assert.Equal(t, priv, priv2)
+	assert.Equal(t, ks.ID, ks2.ID)
+	assert.Equal(t, "label", ks2.Label)
+	assert.Equal(t, "secp256k1", ks2.SignType)
+	assert.Equal(t, "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4", ks2.Address)
+
+	//每个keystore文件使用不同的salt
+	ks3, err := EncryptKeystore([]byte("pass"), priv, "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4", "label", "secp256k1")
+	require.Nil(t, err)
+	assert.NotEqual(t, ks.Crypto.KDFParams["salt"], ks3.Crypto.KDFParams["salt"])
+
+	//修改密文后mac校验失败
+	ks.Crypto.CipherText = "00" + ks.Crypto.CipherText[2:]
+	data, _ = json.Marshal(ks)
+	_, _, err = DecryptKeystore([]byte("pass"), data)
+	assert.Equal(t, types.ErrKeystorePasswd, err)
+}
@@ -38,6 +38,14 @@ var (
 // BACKUPKEYINDEX 备份索引Key值
 const BACKUPKEYINDEX = "backupkeyindex"
 
+const (
+	//通过seed恢复账户时默认的gap limit, 和bip44建议的值相同
+	defaultHDGapLimit = 20
+	maxHDGapLimit     = 1000
+	//bip44路径中的index不能是hardened
+	maxHDIndex = 0x80000000
+)
+
 // CreateSeed 通过指定语言类型生成seed种子，传入语言类型以及
 //lang = 0 通过英语单词生成种子
 //lang = 1 通过中文生成种子
@@ -116,28 +124,40 @@ func GetSeed(db dbm.DB, password string) (string, error) {
 
 //GetPrivkeyBySeed 通过seed生成子私钥十六进制字符串
 func GetPrivkeyBySeed(db dbm.DB, seed string, specificIndex uint32, SignType int, coinType uint32) (string, error) {
-	var backupindex uint32
-	var Hexsubprivkey string
-	var err error
 	var index uint32
-	signType := uint32(SignType)
 	//通过主私钥随机生成child私钥十六进制字符串
 	if specificIndex == 0 {
-		backuppubkeyindex, err := db.Get([]byte(BACKUPKEYINDEX))
-		if backuppubkeyindex == nil || err != nil {
-			index = 0
-		} else {
-			if err = json.Unmarshal(backuppubkeyindex, &backupindex); err != nil {
-				return "", err
-			}
+		backupindex, ok, err := getBackupKeyIndex(db)
+		if err != nil {
+			return "", err
+		}
+		if ok {
 			index = backupindex + 1
 		}
 	} else {
 		index = specificIndex
 	}
+
+	priv, err := GetPrivkeyBySeedPath(seed, bipwallet.DerivationPath(coinType, index), SignType, coinType)
+	if err != nil {
+		return "", err
+	}
+
+	// back up index in db
+	if specificIndex == 0 {
+		if err := setBackupKeyIndex(db, index); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(priv), nil
+}
+
+//GetPrivkeyBySeedPath 通过seed和bip44路径生成子私钥, 不修改钱包记录的索引
+func GetPrivkeyBySeedPath(seed string, path string, SignType int, coinType uint32) ([]byte, error) {
+	signType := uint32(SignType)
 	cryptoName := crypto.GetName(SignType)
 	if cryptoName == "unknown" {
-		return "", types.ErrNotSupport
+		return nil, types.ErrNotSupport
 	}
 
 	wallet, err := bipwallet.NewWalletFromMnemonic(coinType, signType, seed)
@@ -146,45 +166,57 @@ func GetPrivkeyBySeed(db dbm.DB, seed string, specificIndex uint32, SignType int
 		wallet, err = bipwallet.NewWalletFromSeed(coinType, signType, []byte(seed))
 		if err != nil {
 			seedlog.Error("GetPrivkeyBySeed NewWalletFromSeed", "err", err)
-			return "", types.ErrNewWalletFromSeed
+			return nil, types.ErrNewWalletFromSeed
 		}
 	}
 
-	//通过索引生成Key pair
-	priv, pub, err := wallet.NewKeyPair(index)
+	//通过路径生成Key pair
+	priv, pub, err := wallet.NewKeyPairByPath(path)
+	if err == types.ErrDerivationPath {
+		return nil, err
+	}
 	if err != nil {
 		seedlog.Error("GetPrivkeyBySeed NewKeyPair", "err", err)
-		return "", types.ErrNewKeyPair
+		return nil, types.ErrNewKeyPair
 	}
 
-	Hexsubprivkey = hex.EncodeToString(priv)
-
 	public, err := bipwallet.PrivkeyToPub(coinType, signType, priv)
 	if err != nil {
 		seedlog.Error("GetPrivkeyBySeed PrivkeyToPub", "err", err)
-		return "", types.ErrPrivkeyToPub
+		return nil, types.ErrPrivkeyToPub
 	}
 	if !bytes.Equal(pub, public) {
 		seedlog.Error("GetPrivkeyBySeed NewKeyPair pub  != PrivkeyToPub", "err", err)
-		return "", types.ErrSubPubKeyVerifyFail
+		return nil, types.ErrSubPubKeyVerifyFail
 	}
+	return priv, nil
+}
 
-	// back up index in db
-	if specificIndex == 0 {
-		var pubkeyindex []byte
-		pubkeyindex, err = json.Marshal(index)
-		if err != nil {
-			seedlog.Error("GetPrivkeyBySeed", "Marshal err ", err)
-			return "", types.ErrMarshal
-		}
+//getBackupKeyIndex 获取已经通过seed生成的最大索引, 没有生成过私钥时返回false
+func getBackupKeyIndex(db dbm.DB) (uint32, bool, error) {
+	backuppubkeyindex, err := db.Get([]byte(BACKUPKEYINDEX))
+	if backuppubkeyindex == nil || err != nil {
+		return 0, false, nil
+	}
+	var backupindex uint32
+	if err = json.Unmarshal(backuppubkeyindex, &backupindex); err != nil {
+		return 0, false, err
+	}
+	return backupindex, true, nil
+}
 
-		err = db.SetSync([]byte(BACKUPKEYINDEX), pubkeyindex)
-		if err != nil {
-			seedlog.Error("GetPrivkeyBySeed", "SetSync err ", err)
-			return "", err
-		}
+func setBackupKeyIndex(db dbm.DB, index uint32) error {
+	pubkeyindex, err := json.Marshal(index)
+	if err != nil {
+		seedlog.Error("GetPrivkeyBySeed", "Marshal err ", err)
+		return types.ErrMarshal
+	}
+	err = db.SetSync([]byte(BACKUPKEYINDEX), pubkeyindex)
+	if err != nil {
+		seedlog.Error("GetPrivkeyBySeed", "SetSync err ", err)
+		return err
 	}
-	return Hexsubprivkey, nil
+	return nil
 }
 
 //AesgcmEncrypter 使用钱包的password对seed进行aesgcm加密,返回加密后的seed
@@ -61,6 +61,9 @@ func (wallet *Wallet) getAllPrivKeys() ([]crypto.PrivKey, error) {
 	}
 	var privs []crypto.PrivKey
 	for _, acc := range accounts {
+		if acc.GetWatchOnly() {
+			continue
+		}
 		priv, err := wallet.getPrivKeyByAddr(acc.Addr)
 		if err != nil {
 			return nil, err
@@ -332,6 +332,10 @@ func (wallet *Wallet) getPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
 		walletlog.Error("getPrivKeyByAddr", "GetAccountByAddr err:", err)
 		return nil, err
 	}
+	if Accountstor.GetWatchOnly() {
+		walletlog.Error("getPrivKeyByAddr", "addr", addr, "err", types.ErrWatchOnlyAccount)
+		return nil, types.ErrWatchOnlyAccount
+	}
 
 	//通过password解密存储的私钥
 	prikeybyte, err := common.FromHex(Accountstor.GetPrivkey())
@@ -340,7 +344,11 @@ func (wallet *Wallet) getPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
 		return nil, err
 	}
 
-	privkey := wcom.CBCDecrypterPrivkey([]byte(wallet.Password), prikeybyte)
+	privkey, err := wcom.DecryptPrivkey([]byte(wallet.Password), prikeybyte)
+	if err != nil {
+		walletlog.Error("getPrivKeyByAddr", "DecryptPrivkey err", err)
+		return nil, err
+	}
 	//通过privkey生成一个pubkey然后换算成对应的addr
 	cr, err := crypto.New(types.GetSignName("", wallet.SignType))
 	if err != nil {
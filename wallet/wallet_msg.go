@@ -64,6 +64,15 @@ func (wallet *Wallet) On_WalletImportPrivkey(req *types.ReqWalletImportPrivkey)
 	return reply, err
 }
 
+// On_WalletImportWatchOnly 响应导入只读账户
+func (wallet *Wallet) On_WalletImportWatchOnly(req *types.ReqWalletImportWatchOnly) (types.Message, error) {
+	reply, err := wallet.ProcImportWatchOnly(req)
+	if err != nil {
+		walletlog.Error("ProcImportWatchOnly", "err", err.Error())
+	}
+	return reply, err
+}
+
 // On_WalletSendToAddress 响应钱包想地址转账
 func (wallet *Wallet) On_WalletSendToAddress(req *types.ReqWalletSendToAddress) (types.Message, error) {
 	reply, err := wallet.ProcSendToAddress(req)
@@ -319,6 +328,42 @@ func (wallet *Wallet) On_DumpPrivkeysFile(req *types.ReqPrivkeysFile) (types.Mes
 	return reply, err
 }
 
+// On_RecoverAccounts 通过seed恢复账户
+func (wallet *Wallet) On_RecoverAccounts(req *types.ReqRecoverAccounts) (types.Message, error) {
+	reply, err := wallet.ProcRecoverAccounts(req)
+	if err != nil {
+		walletlog.Error("ProcRecoverAccounts", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_GetHDAccounts 获取通过seed生成的账户
+func (wallet *Wallet) On_GetHDAccounts(req *types.ReqNil) (types.Message, error) {
+	reply, err := wallet.ProcGetHDAccounts()
+	if err != nil {
+		walletlog.Error("ProcGetHDAccounts", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_ExportKeystore 处理导出keystore文件
+func (wallet *Wallet) On_ExportKeystore(req *types.ReqKeystoreFile) (types.Message, error) {
+	reply, err := wallet.ProcExportKeystore(req)
+	if err != nil {
+		walletlog.Error("ProcExportKeystore", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_ImportKeystore 处理导入keystore文件
+func (wallet *Wallet) On_ImportKeystore(req *types.ReqKeystoreFile) (types.Message, error) {
+	reply, err := wallet.ProcImportKeystore(req)
+	if err != nil {
+		walletlog.Error("ProcImportKeystore", "err", err.Error())
+	}
+	return reply, err
+}
+
 //On_ImportPrivkeysFile 响应导入多个私钥
 func (wallet *Wallet) On_ImportPrivkeysFile(req *types.ReqPrivkeysFile) (types.Message, error) {
 	reply := &types.Reply{
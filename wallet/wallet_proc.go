@@ -5,10 +5,14 @@
 package wallet
 
 import (
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -87,6 +91,10 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 	if err != nil {
 		return "", err
 	}
+	//追加多重签名时交易内容已经确定, 修改交易会使之前的部分签名失效
+	if tx.GetSignature().GetTy() == types.MultiSign {
+		return wallet.signMulti(unsigned, &tx, key)
+	}
 
 	if unsigned.NewToAddr != "" {
 		tx.To = unsigned.NewToAddr
@@ -126,11 +134,17 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 		return "", err
 	}
 	if group == nil {
+		if len(unsigned.GetMultiSignPubkeys()) > 0 {
+			return wallet.signMulti(unsigned, &tx, key)
+		}
 		tx.Sign(int32(wallet.SignType), key)
 		txHex := types.Encode(&tx)
 		signedTx := hex.EncodeToString(txHex)
 		return signedTx, nil
 	}
+	if len(unsigned.GetMultiSignPubkeys()) > 0 {
+		return "", types.ErrNotSupport
+	}
 	if int(index) > len(group.GetTxs()) {
 		return "", types.ErrIndex
 	}
@@ -160,6 +174,34 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 	return signedTx, nil
 }
 
+// signMulti 对交易做多重签名, 公钥集合优先使用请求中的参数, 否则使用交易中已有的部分签名.
+// 返回的交易可能只有部分签名, 可以继续签名或者和其他部分签名合并
+func (wallet *Wallet) signMulti(unsigned *types.ReqSignRawTx, tx *types.Transaction, key crypto.PrivKey) (string, error) {
+	pub := &types.MultiSignPubKey{}
+	if len(unsigned.GetMultiSignPubkeys()) > 0 {
+		keys := make([]*types.MultiSignKey, 0, len(unsigned.MultiSignPubkeys))
+		for _, hexkey := range unsigned.MultiSignPubkeys {
+			pubkey, err := common.FromHex(hexkey)
+			if err != nil {
+				return "", err
+			}
+			keys = append(keys, &types.MultiSignKey{Ty: int32(wallet.SignType), Pubkey: pubkey})
+		}
+		var err error
+		pub, err = types.NewMultiSignPubKey(unsigned.GetMultiSignThreshold(), keys)
+		if err != nil {
+			return "", err
+		}
+	} else if err := types.Decode(tx.GetSignature().GetPubkey(), pub); err != nil {
+		return "", err
+	}
+	err := tx.SignMulti(pub, int32(wallet.SignType), key)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(types.Encode(tx)), nil
+}
+
 // ProcGetAccount 通过地址标签获取账户地址
 func (wallet *Wallet) ProcGetAccount(req *types.ReqGetAccount) (*types.WalletAccount, error) {
 	wallet.mtx.Lock()
@@ -173,7 +215,7 @@ func (wallet *Wallet) ProcGetAccount(req *types.ReqGetAccount) (*types.WalletAcc
 	if err != nil {
 		return nil, err
 	}
-	return &types.WalletAccount{Label: accStore.GetLabel(), Acc: accs[0]}, nil
+	return &types.WalletAccount{Label: accStore.GetLabel(), Acc: accs[0], HdPath: accStore.GetHdPath(), WatchOnly: accStore.GetWatchOnly()}, nil
 
 }
 
@@ -228,6 +270,8 @@ func (wallet *Wallet) ProcGetAccountList(req *types.ReqAccountList) (*types.Wall
 		}
 		WalletAccount.Acc = Account
 		WalletAccount.Label = WalletAccStores[index].GetLabel()
+		WalletAccount.HdPath = WalletAccStores[index].GetHdPath()
+		WalletAccount.WatchOnly = WalletAccStores[index].GetWatchOnly()
 		WalletAccounts.Wallets[index] = &WalletAccount
 	}
 	return &WalletAccounts, nil
@@ -245,6 +289,8 @@ func makeAccountWithoutBalance(accountStores []*types.WalletAccountStore) (*type
 		}
 		WalletAccount.Acc = &types.Account{Addr: account.Addr}
 		WalletAccount.Label = account.GetLabel()
+		WalletAccount.HdPath = account.GetHdPath()
+		WalletAccount.WatchOnly = account.GetWatchOnly()
 		WalletAccounts.Wallets[index] = &WalletAccount
 	}
 	return &WalletAccounts, nil
@@ -299,16 +345,32 @@ func (wallet *Wallet) ProcCreateNewAccount(Label *types.ReqNewAccount) (*types.W
 		return nil, err
 	}
 
+	var hdPath string
 	for {
-		privkeyhex, err := GetPrivkeyBySeed(wallet.walletStore.GetDB(), seed, 0, wallet.SignType, wallet.CoinType)
-		if err != nil {
-			walletlog.Error("ProcCreateNewAccount", "GetPrivkeyBySeed err", err)
-			return nil, err
-		}
-		privkeybyte, err = common.FromHex(privkeyhex)
-		if err != nil || len(privkeybyte) == 0 {
-			walletlog.Error("ProcCreateNewAccount", "FromHex err", err)
-			return nil, err
+		if len(Label.GetHdPath()) != 0 {
+			//使用指定的路径生成私钥
+			hdPath = Label.GetHdPath()
+			privkeybyte, err = GetPrivkeyBySeedPath(seed, hdPath, wallet.SignType, wallet.CoinType)
+			if err != nil {
+				walletlog.Error("ProcCreateNewAccount", "hdPath", hdPath, "GetPrivkeyBySeedPath err", err)
+				return nil, err
+			}
+		} else {
+			privkeyhex, err := GetPrivkeyBySeed(wallet.walletStore.GetDB(), seed, 0, wallet.SignType, wallet.CoinType)
+			if err != nil {
+				walletlog.Error("ProcCreateNewAccount", "GetPrivkeyBySeed err", err)
+				return nil, err
+			}
+			privkeybyte, err = common.FromHex(privkeyhex)
+			if err != nil || len(privkeybyte) == 0 {
+				walletlog.Error("ProcCreateNewAccount", "FromHex err", err)
+				return nil, err
+			}
+			index, _, err := getBackupKeyIndex(wallet.walletStore.GetDB())
+			if err != nil {
+				return nil, err
+			}
+			hdPath = bipwallet.DerivationPath(wallet.CoinType, index)
 		}
 
 		pub, err := bipwallet.PrivkeyToPub(cointype, uint32(wallet.SignType), privkeybyte)
@@ -327,6 +389,10 @@ func (wallet *Wallet) ProcCreateNewAccount(Label *types.ReqNewAccount) (*types.W
 		if account == nil || err != nil {
 			break
 		}
+		if len(Label.GetHdPath()) != 0 {
+			walletlog.Error("ProcCreateNewAccount account is exist in wallet!", "hdPath", hdPath)
+			return nil, types.ErrPrivkeyExist
+		}
 	}
 
 	Account.Addr = addr
@@ -336,12 +402,18 @@ func (wallet *Wallet) ProcCreateNewAccount(Label *types.ReqNewAccount) (*types.W
 
 	walletAccount.Acc = &Account
 	walletAccount.Label = Label.GetLabel()
+	walletAccount.HdPath = hdPath
 
-	//使用钱包的password对私钥加密 aes cbc
-	Encrypted := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
+	//使用钱包的password对私钥加密
+	Encrypted, err := wcom.EncryptPrivkey([]byte(wallet.Password), privkeybyte)
+	if err != nil {
+		walletlog.Error("ProcCreateNewAccount", "EncryptPrivkey err", err)
+		return nil, err
+	}
 	WalletAccStore.Privkey = common.ToHex(Encrypted)
 	WalletAccStore.Label = Label.GetLabel()
 	WalletAccStore.Addr = addr
+	WalletAccStore.HdPath = hdPath
 
 	//存储账户信息到wallet数据库中
 	err = wallet.walletStore.SetWalletAccount(false, Account.Addr, &WalletAccStore)
@@ -371,6 +443,157 @@ func (wallet *Wallet) ProcCreateNewAccount(Label *types.ReqNewAccount) (*types.W
 	return &walletAccount, nil
 }
 
+//ProcRecoverAccounts 通过seed按照bip44的默认路径依次生成地址, 从区块链的地址索引中查询地址是否有过交易,
+//导入有交易的地址, 连续gapLimit个地址没有交易时停止
+func (wallet *Wallet) ProcRecoverAccounts(req *types.ReqRecoverAccounts) (*types.HDAccounts, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	ok, err := wallet.checkWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	gapLimit := req.GetGapLimit()
+	if gapLimit <= 0 {
+		gapLimit = wallet.cfg.HDGapLimit
+	}
+	if gapLimit <= 0 {
+		gapLimit = defaultHDGapLimit
+	}
+	if gapLimit > maxHDGapLimit {
+		return nil, types.ErrInvalidParam
+	}
+	seed, err := wallet.getSeed(wallet.Password)
+	if err != nil {
+		walletlog.Error("ProcRecoverAccounts", "getSeed err", err)
+		return nil, err
+	}
+
+	reply := &types.HDAccounts{}
+	lastUsed := int64(-1)
+	for index, misses := uint32(0), int32(0); misses < gapLimit && index < maxHDIndex; index++ {
+		hdPath := bipwallet.DerivationPath(wallet.CoinType, index)
+		privkeybyte, err := GetPrivkeyBySeedPath(seed, hdPath, wallet.SignType, wallet.CoinType)
+		if err != nil {
+			walletlog.Error("ProcRecoverAccounts", "hdPath", hdPath, "GetPrivkeyBySeedPath err", err)
+			return nil, err
+		}
+		pub, err := bipwallet.PrivkeyToPub(wallet.CoinType, uint32(wallet.SignType), privkeybyte)
+		if err != nil {
+			return nil, types.ErrPrivkeyToPub
+		}
+		addr, err := bipwallet.PubToAddress(pub)
+		if err != nil {
+			return nil, types.ErrPrivkeyToPub
+		}
+		//已经在钱包中的地址也算作使用过的地址
+		if account, err := wallet.walletStore.GetAccountByAddr(addr); account != nil && err == nil {
+			misses = 0
+			lastUsed = int64(index)
+			continue
+		}
+		overview, err := wallet.api.GetAddrOverview(&types.ReqAddr{Addr: addr})
+		if err != nil {
+			walletlog.Error("ProcRecoverAccounts", "addr", addr, "GetAddrOverview err", err)
+			return nil, err
+		}
+		if overview.GetTxCount() == 0 && overview.GetReciver() == 0 {
+			misses++
+			continue
+		}
+		misses = 0
+		lastUsed = int64(index)
+		hdAccount, err := wallet.saveHDAccount(addr, hdPath, index, privkeybyte)
+		if err != nil {
+			return nil, err
+		}
+		reply.Accounts = append(reply.Accounts, hdAccount)
+	}
+
+	//NewAccount从最后一个使用过的索引之后继续生成
+	db := wallet.walletStore.GetDB()
+	backupIndex, ok, err := getBackupKeyIndex(db)
+	if err != nil {
+		return nil, err
+	}
+	if lastUsed >= 0 && (!ok || uint32(lastUsed) > backupIndex) {
+		if err := setBackupKeyIndex(db, uint32(lastUsed)); err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
+}
+
+// unusedLabel 标签已经被使用时依次添加_2, _3...后缀, 直到找到没有被使用的标签
+func (wallet *Wallet) unusedLabel(label string) string {
+	newLabel := label
+	for i := 2; ; i++ {
+		acc, err := wallet.walletStore.GetAccountByLabel(newLabel)
+		if acc == nil || err != nil {
+			return newLabel
+		}
+		newLabel = fmt.Sprintf("%s_%d", label, i)
+	}
+}
+
+func (wallet *Wallet) saveHDAccount(addr, hdPath string, index uint32, privkeybyte []byte) (*types.HDAccount, error) {
+	label := fmt.Sprintf("hd-%d", index)
+	for i := 2; ; i++ {
+		acc, err := wallet.walletStore.GetAccountByLabel(label)
+		if acc == nil || err != nil {
+			break
+		}
+		label = fmt.Sprintf("hd-%d_%d", index, i)
+	}
+	Encrypted, err := wcom.EncryptPrivkey([]byte(wallet.Password), privkeybyte)
+	if err != nil {
+		walletlog.Error("saveHDAccount", "EncryptPrivkey err", err)
+		return nil, err
+	}
+	WalletAccStore := &types.WalletAccountStore{
+		Privkey: common.ToHex(Encrypted),
+		Label:   label,
+		Addr:    addr,
+		HdPath:  hdPath,
+	}
+	err = wallet.walletStore.SetWalletAccount(false, addr, WalletAccStore)
+	if err != nil {
+		return nil, err
+	}
+	//从blockchain模块同步地址对应的所有交易详细信息
+	for _, policy := range wcom.PolicyContainer {
+		policy.OnImportPrivateKey(&types.Account{Addr: addr})
+	}
+	return &types.HDAccount{Addr: addr, Label: label, HdPath: hdPath, Index: index}, nil
+}
+
+//ProcGetHDAccounts 获取通过seed生成的账户, 按照路径的索引排序
+func (wallet *Wallet) ProcGetHDAccounts() (*types.HDAccounts, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	WalletAccStores, err := wallet.walletStore.GetAccountByPrefix("Account")
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.HDAccounts{}
+	for _, AccStore := range WalletAccStores {
+		if len(AccStore.GetHdPath()) == 0 {
+			continue
+		}
+		_, _, _, index, err := bipwallet.ParseDerivationPath(AccStore.GetHdPath())
+		if err != nil {
+			walletlog.Error("ProcGetHDAccounts", "addr", AccStore.Addr, "hdPath", AccStore.HdPath, "err", err)
+			continue
+		}
+		reply.Accounts = append(reply.Accounts, &types.HDAccount{Addr: AccStore.Addr, Label: AccStore.Label, HdPath: AccStore.HdPath, Index: index})
+	}
+	sort.SliceStable(reply.Accounts, func(i, j int) bool {
+		return reply.Accounts[i].Index < reply.Accounts[j].Index
+	})
+	return reply, nil
+}
+
 // ProcWalletTxList 处理获取钱包交易列表
 //input:
 //type ReqWalletTransactionList struct {
@@ -441,13 +664,6 @@ func (wallet *Wallet) procImportPrivKey(PrivKey *types.ReqWalletImportPrivkey) (
 		return nil, types.ErrInvalidParam
 	}
 
-	//校验label是否已经被使用
-	Account, err := wallet.walletStore.GetAccountByLabel(PrivKey.GetLabel())
-	if Account != nil && err == nil {
-		walletlog.Error("ProcImportPrivKey Label is exist in wallet!")
-		return nil, types.ErrLabelHasUsed
-	}
-
 	cointype := wallet.GetCoinType()
 
 	privkeybyte, err := common.FromHex(PrivKey.Privkey)
@@ -468,27 +684,50 @@ func (wallet *Wallet) procImportPrivKey(PrivKey *types.ReqWalletImportPrivkey) (
 		return nil, types.ErrPrivkeyToPub
 	}
 
-	//对私钥加密
-	Encryptered := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
-	Encrypteredstr := common.ToHex(Encryptered)
-	//校验PrivKey对应的addr是否已经存在钱包中
+	//校验label是否已经被使用, 只读账户导入私钥时可以使用原来的label
+	Account, err := wallet.walletStore.GetAccountByLabel(PrivKey.GetLabel())
+	if Account != nil && err == nil && !(Account.GetWatchOnly() && Account.Addr == addr) {
+		walletlog.Error("ProcImportPrivKey Label is exist in wallet!")
+		return nil, types.ErrLabelHasUsed
+	}
+
+	//校验PrivKey对应的addr是否已经存在钱包中, 加密使用了随机数, 需要解密后比较
+	var watchOnly *types.WalletAccountStore
 	Account, err = wallet.walletStore.GetAccountByAddr(addr)
-	if Account != nil && err == nil {
-		if Account.Privkey == Encrypteredstr {
+	if Account != nil && err == nil && Account.GetWatchOnly() {
+		watchOnly = Account
+	} else if Account != nil && err == nil {
+		storekey, err := common.FromHex(Account.Privkey)
+		if err == nil {
+			storekey, err = wcom.DecryptPrivkey([]byte(wallet.Password), storekey)
+		}
+		if err == nil && bytes.Equal(storekey, privkeybyte) {
 			walletlog.Error("ProcImportPrivKey Privkey is exist in wallet!")
 			return nil, types.ErrPrivkeyExist
 		}
-		walletlog.Error("ProcImportPrivKey!", "Account.Privkey", Account.Privkey, "input Privkey", PrivKey.Privkey)
+		walletlog.Error("ProcImportPrivKey!", "addr", addr, "err", err)
 		return nil, types.ErrPrivkey
 	}
 
+	//对私钥加密
+	Encryptered, err := wcom.EncryptPrivkey([]byte(wallet.Password), privkeybyte)
+	if err != nil {
+		walletlog.Error("ProcImportPrivKey", "EncryptPrivkey err", err)
+		return nil, err
+	}
+	Encrypteredstr := common.ToHex(Encryptered)
+
 	var walletaccount types.WalletAccount
 	var WalletAccStore types.WalletAccountStore
 	WalletAccStore.Privkey = Encrypteredstr //存储加密后的私钥
 	WalletAccStore.Label = PrivKey.GetLabel()
 	WalletAccStore.Addr = addr
-	//存储Addr:label+privkey+addr到数据库
-	err = wallet.walletStore.SetWalletAccount(false, addr, &WalletAccStore)
+	//存储Addr:label+privkey+addr到数据库, 只读账户升级为普通账户
+	if watchOnly != nil {
+		err = wallet.walletStore.UpgradeWatchOnlyAccount(watchOnly, &WalletAccStore)
+	} else {
+		err = wallet.walletStore.SetWalletAccount(false, addr, &WalletAccStore)
+	}
 	if err != nil {
 		walletlog.Error("ProcImportPrivKey", "SetWalletAccount err", err)
 		return nil, err
@@ -515,6 +754,64 @@ func (wallet *Wallet) procImportPrivKey(PrivKey *types.ReqWalletImportPrivkey) (
 	return &walletaccount, nil
 }
 
+// ProcImportWatchOnly 导入只读账户, 只读账户可以查询余额和交易以及构造交易, 但是不能签名
+func (wallet *Wallet) ProcImportWatchOnly(req *types.ReqWalletImportWatchOnly) (*types.WalletAccount, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.GetLabel()) == 0 || (len(req.GetAddr()) == 0 && len(req.GetPubkey()) == 0) {
+		walletlog.Error("ProcImportWatchOnly input parameter is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	addr := req.GetAddr()
+	if len(req.GetPubkey()) != 0 {
+		pubkey, err := common.FromHex(req.GetPubkey())
+		if err != nil || len(pubkey) == 0 {
+			walletlog.Error("ProcImportWatchOnly", "FromHex err", err)
+			return nil, types.ErrFromHex
+		}
+		pubAddr := address.PubKeyToAddress(pubkey).String()
+		if len(addr) != 0 && addr != pubAddr {
+			walletlog.Error("ProcImportWatchOnly pubkey not match addr", "addr", addr, "pubAddr", pubAddr)
+			return nil, types.ErrInvalidAddress
+		}
+		addr = pubAddr
+	}
+	if err := address.CheckAddress(addr); err != nil {
+		walletlog.Error("ProcImportWatchOnly", "addr", addr, "CheckAddress err", err)
+		return nil, types.ErrInvalidAddress
+	}
+
+	Account, err := wallet.walletStore.GetAccountByLabel(req.GetLabel())
+	if Account != nil && err == nil {
+		walletlog.Error("ProcImportWatchOnly Label is exist in wallet!")
+		return nil, types.ErrLabelHasUsed
+	}
+	Account, err = wallet.walletStore.GetAccountByAddr(addr)
+	if Account != nil && err == nil {
+		walletlog.Error("ProcImportWatchOnly addr is exist in wallet!", "addr", addr)
+		return nil, types.ErrAccountExist
+	}
+
+	_, err = wallet.walletStore.SetWatchOnlyAccount(addr, req.GetPubkey(), req.GetLabel())
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := wallet.accountdb.LoadAccounts(wallet.api, []string{addr})
+	if err != nil {
+		walletlog.Error("ProcImportWatchOnly", "LoadAccounts err", err)
+		return nil, err
+	}
+	if len(accounts[0].Addr) == 0 {
+		accounts[0].Addr = addr
+	}
+	//和导入私钥一样, 从blockchain模块同步地址对应的所有交易详细信息
+	for _, policy := range wcom.PolicyContainer {
+		policy.OnImportPrivateKey(accounts[0])
+	}
+	return &types.WalletAccount{Acc: accounts[0], Label: req.GetLabel(), WatchOnly: true}, nil
+}
+
 // ProcSendToAddress 响应发送到地址
 //input:
 //type ReqWalletSendToAddress struct {
@@ -543,6 +840,10 @@ func (wallet *Wallet) ProcSendToAddress(SendToAddress *types.ReqWalletSendToAddr
 	if !ok {
 		return nil, err
 	}
+	//只读账户不能签名, 需要通过CreateRawTransaction构造交易后离线签名
+	if acc, err := wallet.walletStore.GetAccountByAddr(SendToAddress.GetFrom()); err == nil && acc.GetWatchOnly() {
+		return nil, types.ErrWatchOnlyAccount
+	}
 
 	//获取from账户的余额从account模块，校验余额是否充足
 	addrs := make([]string, 1)
@@ -735,7 +1036,11 @@ func (wallet *Wallet) ProcMergeBalance(MergeBalance *types.ReqWalletMergeBalance
 			continue
 		}
 
-		privkey := wcom.CBCDecrypterPrivkey([]byte(wallet.Password), prikeybyte)
+		privkey, err := wcom.DecryptPrivkey([]byte(wallet.Password), prikeybyte)
+		if err != nil {
+			walletlog.Error("ProcMergeBalance", "DecryptPrivkey err", err, "index", index)
+			continue
+		}
 		priv, err := cr.PrivKeyFromBytes(privkey)
 		if err != nil {
 			walletlog.Error("ProcMergeBalance", "PrivKeyFromBytes err", err, "index", index)
@@ -865,6 +1170,12 @@ func (wallet *Wallet) ProcWalletSetPasswd(Passwd *types.ReqWalletSetPasswd) erro
 	if err != nil || len(WalletAccStores) == 0 {
 		walletlog.Error("ProcWalletSetPasswd", "GetAccountByPrefix:err", err)
 	}
+	//本次修改密码使用新的随机salt
+	encrypter, err := wcom.NewPrivkeyEncrypter([]byte(Passwd.NewPass))
+	if err != nil {
+		walletlog.Error("ProcWalletSetPasswd", "NewPrivkeyEncrypter err", err)
+		return err
+	}
 
 	for _, AccStore := range WalletAccStores {
 		//使用old Password解密存储的私钥
@@ -873,10 +1184,18 @@ func (wallet *Wallet) ProcWalletSetPasswd(Passwd *types.ReqWalletSetPasswd) erro
 			walletlog.Info("ProcWalletSetPasswd", "addr", AccStore.Addr, "FromHex err", err)
 			continue
 		}
-		Decrypter := wcom.CBCDecrypterPrivkey([]byte(Passwd.OldPass), storekey)
+		Decrypter, err := wcom.DecryptPrivkey([]byte(Passwd.OldPass), storekey)
+		if err != nil {
+			walletlog.Error("ProcWalletSetPasswd", "addr", AccStore.Addr, "DecryptPrivkey err", err)
+			return err
+		}
 
-		//使用新的密码重新加密私钥
-		Encrypter := wcom.CBCEncrypterPrivkey([]byte(Passwd.NewPass), Decrypter)
+		//使用新的密码重新加密私钥, 旧格式的私钥同时升级到新的格式
+		Encrypter, err := encrypter.Encrypt(Decrypter)
+		if err != nil {
+			walletlog.Error("ProcWalletSetPasswd", "addr", AccStore.Addr, "EncryptPrivkey err", err)
+			return err
+		}
 		AccStore.Privkey = common.ToHex(Encrypter)
 		err = wallet.walletStore.SetWalletAccountInBatch(true, AccStore.Addr, AccStore, newBatch)
 		if err != nil {
@@ -891,6 +1210,7 @@ func (wallet *Wallet) ProcWalletSetPasswd(Passwd *types.ReqWalletSetPasswd) erro
 	}
 	wallet.Password = Passwd.NewPass
 	wallet.EncryptFlag = 1
+	wcom.ClearKDFCache()
 	return nil
 }
 
@@ -903,6 +1223,7 @@ func (wallet *Wallet) ProcWalletLock() error {
 	}
 
 	atomic.CompareAndSwapInt32(&wallet.isWalletLocked, 0, 1)
+	wcom.ClearKDFCache()
 	for _, policy := range wcom.PolicyContainer {
 		policy.OnWalletLocked()
 	}
@@ -938,6 +1259,10 @@ func (wallet *Wallet) ProcWalletUnLock(WalletUnLock *types.WalletUnLock) error {
 	}
 	//本钱包没有设置密码加密过,只需要解锁不需要记录解锁密码
 	wallet.Password = WalletUnLock.Passwd
+	//密码已经校验过, 把旧格式加密的私钥迁移到新的格式
+	if wallet.EncryptFlag == 1 {
+		wallet.migratePrivkeys()
+	}
 	//只解锁挖矿转账
 	if !WalletUnLock.WalletOrTicket {
 		//wallet.isTicketLocked = false
@@ -953,12 +1278,71 @@ func (wallet *Wallet) ProcWalletUnLock(WalletUnLock *types.WalletUnLock) error {
 
 }
 
+//migratePrivkeys 使用新的加密格式重新加密旧格式的私钥, 解密后的私钥必须和存储的地址对应, 迁移失败不影响使用
+func (wallet *Wallet) migratePrivkeys() {
+	WalletAccStores, err := wallet.walletStore.GetAccountByPrefix("Account")
+	if err != nil || len(WalletAccStores) == 0 {
+		return
+	}
+	cointype := wallet.GetCoinType()
+	password := []byte(wallet.Password)
+	newBatch := wallet.walletStore.NewBatch(true)
+	count := 0
+	var encrypter *wcom.PrivkeyEncrypter
+	for _, AccStore := range WalletAccStores {
+		storekey, err := common.FromHex(AccStore.GetPrivkey())
+		if err != nil || !wcom.IsLegacyPrivkey(storekey) || len(storekey) == 0 {
+			continue
+		}
+		privkey := wcom.CBCDecrypterPrivkey(password, storekey)
+		pub, err := bipwallet.PrivkeyToPub(cointype, uint32(wallet.SignType), privkey)
+		if err != nil {
+			walletlog.Error("migratePrivkeys", "addr", AccStore.Addr, "PrivkeyToPub err", err)
+			continue
+		}
+		addr, err := bipwallet.PubToAddress(pub)
+		if err != nil || addr != AccStore.Addr {
+			walletlog.Error("migratePrivkeys privkey not match addr", "addr", AccStore.Addr, "err", err)
+			continue
+		}
+		//本次迁移的私钥使用同一个新的随机salt
+		if encrypter == nil {
+			encrypter, err = wcom.NewPrivkeyEncrypter(password)
+			if err != nil {
+				walletlog.Error("migratePrivkeys", "NewPrivkeyEncrypter err", err)
+				return
+			}
+		}
+		encrypted, err := encrypter.Encrypt(privkey)
+		if err != nil {
+			walletlog.Error("migratePrivkeys", "addr", AccStore.Addr, "EncryptPrivkey err", err)
+			return
+		}
+		AccStore.Privkey = common.ToHex(encrypted)
+		err = wallet.walletStore.SetWalletAccountInBatch(true, AccStore.Addr, AccStore, newBatch)
+		if err != nil {
+			walletlog.Error("migratePrivkeys", "addr", AccStore.Addr, "SetWalletAccountInBatch err", err)
+			return
+		}
+		count++
+	}
+	if count == 0 {
+		return
+	}
+	if err := newBatch.Write(); err != nil {
+		walletlog.Error("migratePrivkeys newBatch.Write", "err", err)
+		return
+	}
+	walletlog.Info("migratePrivkeys", "count", count)
+}
+
 //解锁超时处理，需要区分整个钱包的解锁或者只挖矿的解锁
 func (wallet *Wallet) resetTimeout(Timeout int64) {
 	if wallet.timeout == nil {
 		wallet.timeout = time.AfterFunc(time.Second*time.Duration(Timeout), func() {
 			//wallet.isWalletLocked = true
 			atomic.CompareAndSwapInt32(&wallet.isWalletLocked, 0, 1)
+			wcom.ClearKDFCache()
 		})
 	} else {
 		wallet.timeout.Reset(time.Second * time.Duration(Timeout))
@@ -1466,13 +1850,18 @@ func (wallet *Wallet) createNewAccountByIndex(index uint32) (string, error) {
 			Label: Label,
 		}
 
-		//使用钱包的password对私钥加密 aes cbc
-		Encrypted := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
+		//使用钱包的password对私钥加密
+		Encrypted, err := wcom.EncryptPrivkey([]byte(wallet.Password), privkeybyte)
+		if err != nil {
+			walletlog.Error("createNewAccountByIndex", "EncryptPrivkey err", err)
+			return "", err
+		}
 
 		var WalletAccStore types.WalletAccountStore
 		WalletAccStore.Privkey = common.ToHex(Encrypted)
 		WalletAccStore.Label = Label
 		WalletAccStore.Addr = addr
+		WalletAccStore.HdPath = bipwallet.DerivationPath(wallet.CoinType, index)
 
 		//存储账户信息到wallet数据库中
 		err = wallet.walletStore.SetWalletAccount(false, Account.Addr, &WalletAccStore)
@@ -1549,7 +1938,12 @@ func (wallet *Wallet) ProcDumpPrivkeysFile(fileName, passwd string) error {
 		return err
 	}
 
-	for i, acc := range accounts {
+	count := 0
+	for _, acc := range accounts {
+		//只读账户没有私钥
+		if acc.GetWatchOnly() {
+			continue
+		}
 		priv, err := wallet.getPrivKeyByAddr(acc.Addr)
 		if err != nil {
 			walletlog.Info("getPrivKeyByAddr", acc.Addr, err)
@@ -1565,11 +1959,12 @@ func (wallet *Wallet) ProcDumpPrivkeysFile(fileName, passwd string) error {
 			continue
 		}
 
-		f.WriteString(string(Encrypter))
-
-		if i < len(accounts)-1 {
+		//分隔符只写在两个私钥之间, 跳过的账户不能留下多余的分隔符
+		if count > 0 {
 			f.WriteString("&ffzm.&**&")
 		}
+		f.WriteString(string(Encrypter))
+		count++
 	}
 
 	return nil
@@ -1623,12 +2018,8 @@ func (wallet *Wallet) ProcImportPrivkeysFile(fileName, passwd string) error {
 		privKey := acc[0]
 		label := acc[1]
 
-		//校验label是否已经被使用
-		Account, err := wallet.walletStore.GetAccountByLabel(label)
-		if Account != nil && err == nil {
-			walletlog.Info("ProcImportPrivKey Label is exist in wallet, label = label + _2!")
-			label = label + "_2"
-		}
+		//校验label是否已经被使用, 被使用时添加后缀
+		label = wallet.unusedLabel(label)
 
 		PrivKey := &types.ReqWalletImportPrivkey{
 			Privkey: privKey,
@@ -1647,3 +2038,159 @@ func (wallet *Wallet) ProcImportPrivkeysFile(fileName, passwd string) error {
 
 	return nil
 }
+
+//ProcExportKeystore 导出指定账户或者全部账户的私钥到keystore v3文件, 返回生成的文件
+func (wallet *Wallet) ProcExportKeystore(req *types.ReqKeystoreFile) (*types.ReplyStrings, error) {
+	if req == nil || len(req.FileName) == 0 || len(req.Passwd) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	ok, err := wallet.checkWalletStatus()
+	if !ok {
+		return nil, err
+	}
+
+	var accounts []*types.WalletAccountStore
+	if len(req.Addr) != 0 {
+		acc, err := wallet.walletStore.GetAccountByAddr(req.Addr)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	} else {
+		accounts, err = wallet.walletStore.GetAccountByPrefix("Account")
+		if err != nil {
+			walletlog.Error("ProcExportKeystore", "GetAccountByPrefix err", err)
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(req.FileName, 0700); err != nil {
+		walletlog.Error("ProcExportKeystore MkdirAll", "dir", req.FileName, "err", err)
+		return nil, err
+	}
+
+	signType := types.GetSignName("", wallet.SignType)
+	reply := &types.ReplyStrings{}
+	for _, acc := range accounts {
+		//导出全部账户时跳过只读账户
+		if acc.GetWatchOnly() && len(req.Addr) == 0 {
+			continue
+		}
+		priv, err := wallet.getPrivKeyByAddr(acc.Addr)
+		if err != nil {
+			return nil, err
+		}
+		ks, err := wcom.EncryptKeystore([]byte(req.Passwd), priv.Bytes(), acc.Addr, acc.Label, signType)
+		if err != nil {
+			walletlog.Error("ProcExportKeystore EncryptKeystore", "addr", acc.Addr, "err", err)
+			return nil, err
+		}
+		data, err := json.MarshalIndent(ks, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		//和geth的keystore文件名格式相同
+		fileName := filepath.Join(req.FileName, fmt.Sprintf("UTC--%s--%s", time.Now().UTC().Format("2006-01-02T15-04-05.000000000Z"), acc.Addr))
+		f, err := os.OpenFile(fileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			walletlog.Error("ProcExportKeystore create file error!", "fileName", fileName, "err", err)
+			return nil, err
+		}
+		_, err = f.Write(data)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		reply.Datas = append(reply.Datas, fileName)
+	}
+	return reply, nil
+}
+
+//ProcImportKeystore 从keystore v3文件导入私钥, 文件名为目录时导入目录下的全部文件
+//目录中无法读取, 解密失败或者私钥已经存在的文件被跳过, 并在返回结果中逐个记录
+func (wallet *Wallet) ProcImportKeystore(req *types.ReqKeystoreFile) (*types.ReplyImportKeystore, error) {
+	if req == nil || len(req.FileName) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	info, err := os.Stat(req.FileName)
+	if err != nil {
+		walletlog.Error("ProcImportKeystore", "fileName", req.FileName, "err", err)
+		return nil, err
+	}
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	ok, err := wallet.checkWalletStatus()
+	if !ok {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		acc, err := wallet.importKeystoreFile(req.FileName, req.Passwd, req.Label)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ReplyImportKeystore{Wallets: []*types.WalletAccount{acc}}, nil
+	}
+
+	files, err := ioutil.ReadDir(req.FileName)
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.ReplyImportKeystore{}
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+		fileName := filepath.Join(req.FileName, file.Name())
+		acc, err := wallet.importKeystoreFile(fileName, req.Passwd, "")
+		if err != nil {
+			reply.Failed = append(reply.Failed, &types.KeystoreFileError{FileName: fileName, Err: err.Error()})
+			continue
+		}
+		reply.Wallets = append(reply.Wallets, acc)
+	}
+	return reply, nil
+}
+
+func (wallet *Wallet) importKeystoreFile(fileName, passwd, label string) (*types.WalletAccount, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		walletlog.Error("importKeystoreFile read file error", "fileName", fileName, "err", err)
+		return nil, err
+	}
+	ks, priv, err := wcom.DecryptKeystore([]byte(passwd), data)
+	if err != nil {
+		walletlog.Error("importKeystoreFile DecryptKeystore", "fileName", fileName, "err", err)
+		return nil, err
+	}
+	//钱包中的私钥都使用钱包的签名类型
+	if len(ks.SignType) != 0 && types.GetSignType("", ks.SignType) != wallet.SignType {
+		walletlog.Error("importKeystoreFile", "fileName", fileName, "signType", ks.SignType)
+		return nil, types.ErrKeystoreSignType
+	}
+	pub, err := bipwallet.PrivkeyToPub(wallet.GetCoinType(), uint32(wallet.SignType), priv)
+	if err != nil {
+		return nil, types.ErrPrivkeyToPub
+	}
+	addr, err := bipwallet.PubToAddress(pub)
+	if err != nil {
+		return nil, types.ErrPrivkeyToPub
+	}
+	//其他工具导出的文件中的地址格式不同, 只校验chain33的地址
+	if address.CheckAddress(ks.Address) == nil && ks.Address != addr {
+		walletlog.Error("importKeystoreFile address not match", "fileName", fileName, "address", ks.Address, "addr", addr)
+		return nil, types.ErrKeystoreFormat
+	}
+	if len(label) == 0 {
+		label = ks.Label
+		if len(label) == 0 {
+			label = "keystore-" + addr
+		}
+		//文件中的标签已经被使用时添加后缀
+		label = wallet.unusedLabel(label)
+	}
+	return wallet.procImportPrivKey(&types.ReqWalletImportPrivkey{Privkey: common.ToHex(priv), Label: label})
+}
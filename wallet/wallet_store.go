@@ -73,3 +73,34 @@ func (ws *walletStore) GetWalletPassword() string {
 	}
 	return string(passwordbytes)
 }
+
+// SetWatchOnlyAccount 保存只读账户, 只读账户只有地址和公钥, 不保存私钥
+func (ws *walletStore) SetWatchOnlyAccount(addr, pubkey, label string) (*types.WalletAccountStore, error) {
+	account := &types.WalletAccountStore{
+		Label:     label,
+		Addr:      addr,
+		WatchOnly: true,
+		Pubkey:    pubkey,
+	}
+	err := ws.SetWalletAccount(false, addr, account)
+	if err != nil {
+		storelog.Error("SetWatchOnlyAccount", "SetWalletAccount error", err)
+		return nil, err
+	}
+	return account, nil
+}
+
+// UpgradeWatchOnlyAccount 导入只读账户的私钥后升级为普通账户, 使用原来的Accountkey, 同时删除原来的label
+func (ws *walletStore) UpgradeWatchOnlyAccount(watchOnly, account *types.WalletAccountStore) error {
+	account.TimeStamp = watchOnly.TimeStamp
+	newbatch := ws.NewBatch(true)
+	if watchOnly.GetLabel() != account.GetLabel() {
+		newbatch.Delete(wcom.CalcLabelKey(watchOnly.GetLabel()))
+	}
+	err := ws.SetWalletAccountInBatch(true, account.Addr, account, newbatch)
+	if err != nil {
+		storelog.Error("UpgradeWatchOnlyAccount", "SetWalletAccountInBatch error", err)
+		return err
+	}
+	return newbatch.Write()
+}
@@ -6,9 +6,12 @@ package wallet
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -55,6 +58,9 @@ var (
 	AllAccountlist *types.WalletAccounts
 )
 
+//有交易记录的地址, 用于测试通过seed恢复账户
+var usedHDAddrs = make(map[string]bool)
+
 func blockchainModProc(q queue.Queue) {
 	//store
 	go func() {
@@ -95,6 +101,12 @@ func blockchainModProc(q queue.Queue) {
 					txDetails.Txs[index] = &txDetail
 				}
 				msg.Reply(client.NewMessage("rpc", types.EventTransactionDetails, &txDetails))
+			} else if msg.Ty == types.EventGetAddrOverview {
+				overview := &types.AddrOverview{}
+				if usedHDAddrs[msg.Data.(*types.ReqAddr).Addr] {
+					overview.TxCount = 1
+				}
+				msg.Reply(client.NewMessage("wallet", types.EventReplyAddrOverview, overview))
 			} else if msg.Ty == types.EventGetBlockHeight {
 				msg.Reply(client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: 1}))
 			} else if msg.Ty == types.EventIsSync {
@@ -182,6 +194,12 @@ func testAllWallet(t *testing.T) {
 
 	testProcDumpPrivkeysFile(t, wallet)
 
+	testProcKeystore(t, wallet)
+
+	testProcRecoverAccounts(t, wallet)
+
+	testProcWatchOnly(t, wallet)
+
 	//wait data sync
 	testProcWalletTxList(t, wallet)
 
@@ -774,6 +792,29 @@ func testSignRawTx(t *testing.T, wallet *Wallet) {
 	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", unsigned)
 	require.NoError(t, err)
 
+	//2-of-2多重签名, 第二个签名者在部分签名的交易上追加签名
+	_, priv1 := util.Genaddress()
+	priv0, err := wallet.getPrivKeyByAddr(FromAddr)
+	require.NoError(t, err)
+	multi := &types.ReqSignRawTx{
+		Addr:               FromAddr,
+		TxHex:              unsigned.TxHex,
+		Expire:             "0",
+		MultiSignPubkeys:   []string{common.ToHex(priv0.PubKey().Bytes()), common.ToHex(priv1.PubKey().Bytes())},
+		MultiSignThreshold: 2,
+	}
+	partial, err := wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", multi)
+	require.NoError(t, err)
+	multi = &types.ReqSignRawTx{Privkey: common.ToHex(priv1.Bytes()), TxHex: partial.(*types.ReplySignRawTx).TxHex, Expire: "0"}
+	signed, err := wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", multi)
+	require.NoError(t, err)
+	var multiTx types.Transaction
+	txbytes, err := common.FromHex(signed.(*types.ReplySignRawTx).TxHex)
+	require.NoError(t, err)
+	require.NoError(t, types.Decode(txbytes, &multiTx))
+	assert.Equal(t, int32(types.MultiSign), multiTx.Signature.Ty)
+	assert.True(t, multiTx.CheckSign())
+
 	//地址和私钥都为空
 	unsigned.Privkey = ""
 	unsigned.Addr = ""
@@ -837,6 +878,22 @@ func testWallet(t *testing.T, wallet *Wallet) {
 	priv2, err := wallet.GetPrivKeyByAddr(addr)
 	assert.NoError(t, err)
 	assert.Equal(t, priv, priv2)
+
+	//旧格式的私钥迁移到新的格式
+	wallet.migratePrivkeys()
+	was3, err := wallet.GetAccountByAddr(addr)
+	assert.NoError(t, err)
+	bpriv3, err := common.FromHex(was3.Privkey)
+	assert.NoError(t, err)
+	assert.False(t, wcom.IsLegacyPrivkey(bpriv3))
+	priv3, err := wallet.GetPrivKeyByAddr(addr)
+	assert.NoError(t, err)
+	assert.Equal(t, priv, priv3)
+	_, err = wcom.DecryptPrivkey([]byte(wallet.Password+"x"), bpriv3)
+	assert.Equal(t, types.ErrDecryptPrivkey, err)
+	bpriv3[len(bpriv3)-1]++
+	_, err = wcom.DecryptPrivkey([]byte(wallet.Password), bpriv3)
+	assert.Equal(t, types.ErrDecryptPrivkey, err)
 	_, err = wallet.GetWalletAccounts()
 	assert.NoError(t, err)
 	t.Log("password:", wallet.Password)
@@ -1076,6 +1133,83 @@ func testProcDumpPrivkeysFile(t *testing.T, wallet *Wallet) {
 	println("--------------------------")
 }
 
+func testProcKeystore(t *testing.T, wallet *Wallet) {
+	println("testProcKeystore begin")
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	//导出指定账户
+	accs, err := wallet.walletStore.GetAccountByPrefix("Account")
+	require.NoError(t, err)
+	resp, err := wallet.GetAPI().ExecWalletFunc("wallet", "ExportKeystore", &types.ReqKeystoreFile{FileName: dir, Passwd: "123456", Addr: accs[0].Addr})
+	require.NoError(t, err)
+	files := resp.(*types.ReplyStrings).Datas
+	require.Equal(t, 1, len(files))
+	data, err := ioutil.ReadFile(files[0])
+	require.NoError(t, err)
+	ks, privkey, err := wcom.DecryptKeystore([]byte("123456"), data)
+	require.NoError(t, err)
+	assert.Equal(t, accs[0].Addr, ks.Address)
+	assert.Equal(t, accs[0].Label, ks.Label)
+	priv, err := wallet.getPrivKeyByAddr(accs[0].Addr)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), privkey)
+
+	//已经存在的私钥不能重复导入
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "ImportKeystore", &types.ReqKeystoreFile{FileName: files[0], Passwd: "123456"})
+	assert.Equal(t, types.ErrPrivkeyExist, err)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "ImportKeystore", &types.ReqKeystoreFile{FileName: files[0], Passwd: "654321"})
+	assert.Equal(t, types.ErrKeystorePasswd, err)
+
+	//导入新的私钥, 目录中已经存在的私钥和无法解密的文件被跳过并记录
+	newAddr, newPriv := util.Genaddress()
+	ks, err = wcom.EncryptKeystore([]byte("123456"), newPriv.Bytes(), newAddr, "keystore-label", "secp256k1")
+	require.NoError(t, err)
+	data, err = json.Marshal(ks)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "new"), data, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "bad"), []byte("not a keystore"), 0600))
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "ImportKeystore", &types.ReqKeystoreFile{FileName: dir, Passwd: "123456"})
+	require.NoError(t, err)
+	reply := resp.(*types.ReplyImportKeystore)
+	require.Equal(t, 1, len(reply.Wallets))
+	assert.Equal(t, newAddr, reply.Wallets[0].Acc.Addr)
+	assert.Equal(t, "keystore-label", reply.Wallets[0].Label)
+	require.Equal(t, 2, len(reply.Failed))
+	failed := make(map[string]string)
+	for _, f := range reply.Failed {
+		failed[f.FileName] = f.Err
+	}
+	assert.Equal(t, types.ErrPrivkeyExist.Error(), failed[files[0]])
+	assert.NotEmpty(t, failed[filepath.Join(dir, "bad")])
+	require.NoError(t, os.Remove(filepath.Join(dir, "bad")))
+
+	//文件中的标签已经被使用时依次添加后缀
+	for i, expect := range []string{"keystore-label_2", "keystore-label_3"} {
+		addr, priv := util.Genaddress()
+		other, err := wcom.EncryptKeystore([]byte("123456"), priv.Bytes(), addr, "keystore-label", "secp256k1")
+		require.NoError(t, err)
+		data, err = json.Marshal(other)
+		require.NoError(t, err)
+		fileName := filepath.Join(dir, fmt.Sprintf("label-%d", i))
+		require.NoError(t, ioutil.WriteFile(fileName, data, 0600))
+		resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "ImportKeystore", &types.ReqKeystoreFile{FileName: fileName, Passwd: "123456"})
+		require.NoError(t, err)
+		assert.Equal(t, expect, resp.(*types.ReplyImportKeystore).Wallets[0].Label)
+	}
+
+	//签名类型和钱包不同
+	ks.SignType = "ed25519"
+	data, err = json.Marshal(ks)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "new"), data, 0600))
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "ImportKeystore", &types.ReqKeystoreFile{FileName: filepath.Join(dir, "new"), Passwd: "123456"})
+	assert.Equal(t, types.ErrKeystoreSignType, err)
+	println("testProcKeystore end")
+	println("--------------------------")
+}
+
 func testProcImportPrivkeysFile(t *testing.T, wallet *Wallet) {
 	println("testProcImportPrivkeysFile begin")
 	fileName := "Privkeys"
@@ -1151,3 +1285,130 @@ func (report WalletReport) IsTicketLocked() bool {
 func (report WalletReport) PolicyName() string {
 	return "ticket"
 }
+
+func testProcRecoverAccounts(t *testing.T, wallet *Wallet) {
+	println("testProcRecoverAccounts begin")
+	seed, err := wallet.getSeed(wallet.Password)
+	require.NoError(t, err)
+	hdAddr := func(index uint32) string {
+		privkey, err := GetPrivkeyBySeedPath(seed, bipwallet.DerivationPath(wallet.CoinType, index), wallet.SignType, wallet.CoinType)
+		require.NoError(t, err)
+		pub, err := bipwallet.PrivkeyToPub(wallet.CoinType, uint32(wallet.SignType), privkey)
+		require.NoError(t, err)
+		addr, err := bipwallet.PubToAddress(pub)
+		require.NoError(t, err)
+		return addr
+	}
+
+	//testProcCreateNewAccount创建了索引0-9的账户, 索引12和14的地址有交易记录
+	usedHDAddrs[hdAddr(12)] = true
+	usedHDAddrs[hdAddr(14)] = true
+	defer func() { usedHDAddrs = make(map[string]bool) }()
+
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "RecoverAccounts", &types.ReqRecoverAccounts{GapLimit: maxHDGapLimit + 1})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	resp, err := wallet.GetAPI().ExecWalletFunc("wallet", "RecoverAccounts", &types.ReqRecoverAccounts{GapLimit: 3})
+	require.NoError(t, err)
+	recovered := resp.(*types.HDAccounts).Accounts
+	require.Equal(t, 2, len(recovered))
+	assert.Equal(t, hdAddr(12), recovered[0].Addr)
+	assert.Equal(t, uint32(12), recovered[0].Index)
+	assert.Equal(t, "hd-12", recovered[0].Label)
+	assert.Equal(t, hdAddr(14), recovered[1].Addr)
+	assert.Equal(t, bipwallet.DerivationPath(wallet.CoinType, 14), recovered[1].HdPath)
+
+	//再次恢复时不会重复导入
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "RecoverAccounts", &types.ReqRecoverAccounts{GapLimit: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.(*types.HDAccounts).Accounts))
+
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "GetHDAccounts", &types.ReqNil{})
+	require.NoError(t, err)
+	hdAccounts := resp.(*types.HDAccounts).Accounts
+	require.Equal(t, 12, len(hdAccounts))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, uint32(i), hdAccounts[i].Index)
+		assert.Equal(t, hdAddr(uint32(i)), hdAccounts[i].Addr)
+	}
+	assert.Equal(t, uint32(14), hdAccounts[11].Index)
+
+	//新建账户从最后一个使用过的索引之后开始
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "NewAccount", &types.ReqNewAccount{Label: "hd-next"})
+	require.NoError(t, err)
+	walletAcc := resp.(*types.WalletAccount)
+	assert.Equal(t, hdAddr(15), walletAcc.Acc.Addr)
+	assert.Equal(t, bipwallet.DerivationPath(wallet.CoinType, 15), walletAcc.HdPath)
+
+	//指定路径创建账户
+	path := bipwallet.DerivationPath(wallet.CoinType, 100)
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "NewAccount", &types.ReqNewAccount{Label: "hd-path", HdPath: path})
+	require.NoError(t, err)
+	assert.Equal(t, hdAddr(100), resp.(*types.WalletAccount).Acc.Addr)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "NewAccount", &types.ReqNewAccount{Label: "hd-path2", HdPath: path})
+	assert.Equal(t, types.ErrPrivkeyExist, err)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "NewAccount", &types.ReqNewAccount{Label: "hd-path3", HdPath: "m/44'/0'/0'/0/1"})
+	assert.Equal(t, types.ErrDerivationPath, err)
+	println("testProcRecoverAccounts end")
+	println("--------------------------")
+}
+
+func testProcWatchOnly(t *testing.T, wallet *Wallet) {
+	println("testProcWatchOnly begin")
+	watchAddr, watchPriv := util.Genaddress()
+	pubkey := common.ToHex(watchPriv.PubKey().Bytes())
+
+	_, err := wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Label: "watch-only"})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: ToAddr1, Pubkey: pubkey, Label: "watch-only"})
+	assert.Equal(t, types.ErrInvalidAddress, err)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: ToAddr1, Label: "watch-only"})
+	assert.Equal(t, types.ErrAccountExist, err)
+
+	resp, err := wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Pubkey: pubkey, Label: "watch-only"})
+	require.NoError(t, err)
+	walletAcc := resp.(*types.WalletAccount)
+	assert.Equal(t, watchAddr, walletAcc.Acc.Addr)
+	assert.True(t, walletAcc.WatchOnly)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: watchAddr, Label: "watch-only2"})
+	assert.Equal(t, types.ErrAccountExist, err)
+	assert.True(t, wallet.AddrInWallet(watchAddr))
+
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletGetAccountList", &types.ReqAccountList{WithoutBalance: true})
+	require.NoError(t, err)
+	found := false
+	for _, acc := range resp.(*types.WalletAccounts).Wallets {
+		if acc.Acc.Addr == watchAddr {
+			found = true
+			assert.True(t, acc.WatchOnly)
+		}
+	}
+	assert.True(t, found)
+
+	//只读账户不能签名
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "DumpPrivkey", &types.ReqString{Data: watchAddr})
+	assert.Equal(t, types.ErrWatchOnlyAccount, err)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", &types.ReqSignRawTx{Addr: watchAddr, TxHex: "00", Expire: "0"})
+	assert.Equal(t, types.ErrWatchOnlyAccount, err)
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletSendToAddress", &types.ReqWalletSendToAddress{From: watchAddr, To: ToAddr1, Amount: 1})
+	assert.Equal(t, types.ErrWatchOnlyAccount, err)
+	privs, err := wallet.GetAllPrivKeys()
+	require.NoError(t, err)
+	for _, priv := range privs {
+		assert.False(t, priv.Equals(watchPriv))
+	}
+
+	//导入私钥后升级为普通账户, 可以使用原来的label
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportPrivkey", &types.ReqWalletImportPrivkey{Privkey: common.ToHex(watchPriv.Bytes()), Label: "watch-only"})
+	require.NoError(t, err)
+	assert.False(t, resp.(*types.WalletAccount).WatchOnly)
+	resp, err = wallet.GetAPI().ExecWalletFunc("wallet", "DumpPrivkey", &types.ReqString{Data: watchAddr})
+	require.NoError(t, err)
+	assert.Equal(t, common.ToHex(watchPriv.Bytes()), resp.(*types.ReplyString).Data)
+
+	//保留一个只读账户, 后面的合并余额, 修改密码等流程需要跳过只读账户
+	watchAddr2, _ := util.Genaddress()
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: watchAddr2, Label: "watch-only2"})
+	require.NoError(t, err)
+	println("testProcWatchOnly end")
+	println("--------------------------")
+}